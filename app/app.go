@@ -144,6 +144,7 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
+		btccheckpointtypes.ModuleName:  nil,
 	}
 )
 
@@ -343,7 +344,9 @@ func NewBabylonApp(
 		appCodec,
 		keys[btclightclienttypes.StoreKey],
 		keys[btclightclienttypes.MemStoreKey],
-		app.GetSubspace(btclightclienttypes.ModuleName))
+		app.GetSubspace(btclightclienttypes.ModuleName),
+		btcConfig.NetParams(),
+	)
 
 	app.CheckpointingKeeper =
 		checkpointingkeeper.NewKeeper(
@@ -352,8 +355,9 @@ func NewBabylonApp(
 			keys[checkpointingtypes.MemStoreKey],
 			privSigner.WrappedPV,
 			app.EpochingKeeper,
+			// the btccheckpoint keeper is created below, as it depends on the checkpointing keeper
+			&app.BtcCheckpointKeeper,
 			app.GetSubspace(checkpointingtypes.ModuleName),
-			privSigner.ClientCtx,
 		)
 
 	// TODO for now use mocks, as soon as Checkpoining and lightClient will have correct interfaces
@@ -366,6 +370,8 @@ func NewBabylonApp(
 			app.GetSubspace(btccheckpointtypes.ModuleName),
 			&btclightclientKeeper,
 			app.CheckpointingKeeper,
+			app.BankKeeper,
+			authtypes.FeeCollectorName,
 			// TODO decide on proper values for those constants, also those should be taken
 			// from some global config
 			&powLimit,
@@ -381,6 +387,11 @@ func NewBabylonApp(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(checkpointingtypes.RouteBlsDoubleSign, checkpointingkeeper.NewBlsDoubleSignHandler(
+			app.CheckpointingKeeper, &app.StakingKeeper, app.SlashingKeeper,
+		))
+	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
 	/****  Module Options ****/
@@ -422,15 +433,18 @@ func NewBabylonApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+	// NOTE: btccheckpoint's beginblocker must come after mint and before distr so that it
+	// takes its share of the block rewards before distr distributes the rest
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName,
+		btccheckpointtypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, feegrant.ModuleName,
 		paramstypes.ModuleName, vestingtypes.ModuleName,
 		epochingtypes.ModuleName,
 		btclightclienttypes.ModuleName,
-		btccheckpointtypes.ModuleName,
 		checkpointingtypes.ModuleName,
 	)
 	// TODO: there will be an architecture design on whether to modify slashing/evidence, specifically
@@ -0,0 +1,153 @@
+package blssigner
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/vigilante"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const subscriberName = "bls-signer"
+
+// Client is the subset of the Babylon API needed by the BLS signer
+type Client interface {
+	// AccumulatingCheckpoints returns the checkpoints still accumulating BLS signatures
+	AccumulatingCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error)
+	// SubscribeAccumulatingCheckpoints returns the checkpoints of the EventCheckpointAccumulating
+	// events emitted by the new blocks. The channel is closed when the context is done
+	SubscribeAccumulatingCheckpoints(ctx context.Context) (<-chan *ckpttypes.RawCheckpointWithMeta, error)
+	// ValidatorSet returns the validator set of the epoch along with the BLS public keys
+	ValidatorSet(ctx context.Context, epochNum uint64) ([]*ckpttypes.ValidatorWithBlsKey, error)
+	// AddBlsSig submits the BLS signature of the validator
+	AddBlsSig(ctx context.Context, msg *ckpttypes.MsgAddBlsSig) error
+}
+
+// CosmosClient implements Client on top of the cosmos-sdk client, using gRPC queries,
+// the Tendermint websocket and transactions signed by the key configured in the client
+// context. The fees and gas of the transactions are the ones of the transaction factory
+type CosmosClient struct {
+	clientCtx client.Context
+	txClient  *vigilante.CosmosClient
+}
+
+var _ Client = (*CosmosClient)(nil)
+
+func NewCosmosClient(clientCtx client.Context, txf tx.Factory) *CosmosClient {
+	return &CosmosClient{
+		clientCtx: clientCtx,
+		txClient:  vigilante.NewCosmosClient(clientCtx, txf),
+	}
+}
+
+func (c *CosmosClient) AccumulatingCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error) {
+	queryClient := ckpttypes.NewQueryClient(c.clientCtx)
+
+	var checkpoints []*ckpttypes.RawCheckpointWithMeta
+	var nextKey []byte
+
+	for {
+		res, err := queryClient.RawCheckpointList(ctx, &ckpttypes.QueryRawCheckpointListRequest{
+			Status:     ckpttypes.Accumulating,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		checkpoints = append(checkpoints, res.RawCheckpoints...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return checkpoints, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+func (c *CosmosClient) SubscribeAccumulatingCheckpoints(ctx context.Context) (<-chan *ckpttypes.RawCheckpointWithMeta, error) {
+	node, err := c.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// the websocket of the HTTP client needs to be started before subscribing
+	if service, ok := node.(interface {
+		IsRunning() bool
+		Start() error
+	}); ok && !service.IsRunning() {
+		if err := service.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := node.Subscribe(ctx, subscriberName, tmtypes.QueryForEvent(tmtypes.EventNewBlock).String())
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := proto.MessageName(&ckpttypes.EventCheckpointAccumulating{})
+	checkpoints := make(chan *ckpttypes.RawCheckpointWithMeta)
+
+	go func() {
+		defer close(checkpoints)
+
+		for {
+			var event interface{}
+			select {
+			case <-ctx.Done():
+				return
+			case res, ok := <-events:
+				if !ok {
+					return
+				}
+				event = res.Data
+			}
+
+			// the checkpoint of an epoch is built in the BeginBlock of the second block of the next epoch
+			block, ok := event.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+
+			for _, abciEvent := range block.ResultBeginBlock.Events {
+				if abciEvent.Type != eventType {
+					continue
+				}
+				msg, err := sdk.ParseTypedEvent(abciEvent)
+				if err != nil {
+					continue
+				}
+				accumulating, ok := msg.(*ckpttypes.EventCheckpointAccumulating)
+				if !ok || accumulating.Checkpoint == nil {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case checkpoints <- accumulating.Checkpoint:
+				}
+			}
+		}
+	}()
+
+	return checkpoints, nil
+}
+
+func (c *CosmosClient) ValidatorSet(ctx context.Context, epochNum uint64) ([]*ckpttypes.ValidatorWithBlsKey, error) {
+	res, err := ckpttypes.NewQueryClient(c.clientCtx).BlsPublicKeyList(ctx, &ckpttypes.QueryBlsPublicKeyListRequest{EpochNum: epochNum})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ValidatorWithBlsKeys, nil
+}
+
+func (c *CosmosClient) AddBlsSig(ctx context.Context, msg *ckpttypes.MsgAddBlsSig) error {
+	return c.txClient.SendMsg(msg)
+}
@@ -0,0 +1,92 @@
+package blssigner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/tempfile"
+)
+
+// DefaultRecordFile is the name of the record file in the data directory of the node
+const DefaultRecordFile = "bls_signer_state.json"
+
+type recordEntry struct {
+	LastCommitHash tmbytes.HexBytes `json:"last_commit_hash"`
+}
+
+// Record keeps track of the LastCommitHash signed for each epoch. Once persisted, the
+// record prevents the signer from signing a different LastCommitHash of the same epoch,
+// which would be slashed as a BLS double sign, including after a restart. Whether the
+// signature is included is not recorded, as it is read from the bitmap of the checkpoint
+type Record struct {
+	path    string
+	entries map[uint64]*recordEntry
+}
+
+// NewRecord loads the record from the file at the given path, or creates an empty one
+// if the file does not exist. The record is kept in memory only if the path is empty
+func NewRecord(path string) (*Record, error) {
+	r := &Record{
+		path:    path,
+		entries: make(map[uint64]*recordEntry),
+	}
+
+	if path == "" {
+		return r, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, &r.entries); err != nil {
+		return nil, fmt.Errorf("failed to read the record of the BLS signer from %s: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// Signed returns the LastCommitHash signed for the epoch, if any
+func (r *Record) Signed(epochNum uint64) (ckpttypes.LastCommitHash, bool) {
+	entry, ok := r.entries[epochNum]
+	if !ok {
+		return nil, false
+	}
+	return ckpttypes.LastCommitHash(entry.LastCommitHash), true
+}
+
+// SetSigned persists the LastCommitHash signed for the epoch. It needs to be called
+// before the signature is submitted
+func (r *Record) SetSigned(epochNum uint64, lch ckpttypes.LastCommitHash) error {
+	if signed, ok := r.Signed(epochNum); ok {
+		if !signed.Equal(lch) {
+			return fmt.Errorf("a different LastCommitHash %v is already signed for epoch %v", signed, epochNum)
+		}
+		return nil
+	}
+
+	r.entries[epochNum] = &recordEntry{LastCommitHash: tmbytes.HexBytes(lch)}
+
+	return r.save()
+}
+
+func (r *Record) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(r.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return tempfile.WriteFileAtomic(r.path, bz, 0600)
+}
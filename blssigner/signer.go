@@ -0,0 +1,220 @@
+package blssigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/babylonchain/babylon/x/checkpointing/keeper"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/boljen/go-bitmap"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultPollInterval  = 30 * time.Second
+	DefaultMaxRetries    = 5
+	DefaultRetryInterval = 5 * time.Second
+)
+
+type Config struct {
+	// PollInterval is the time between two polls of the accumulating checkpoints, which
+	// catch up on the checkpoints missed by the subscription
+	PollInterval time.Duration
+	// MaxRetries is the number of times the submission of a BLS signature is retried
+	// before giving up until the next poll
+	MaxRetries int
+	// RetryInterval is the time between two submissions of the same BLS signature
+	RetryInterval time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:  DefaultPollInterval,
+		MaxRetries:    DefaultMaxRetries,
+		RetryInterval: DefaultRetryInterval,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.PollInterval <= 0 {
+		return errors.New("poll interval should be positive")
+	}
+
+	if cfg.MaxRetries < 0 {
+		return errors.New("max retries should not be negative")
+	}
+
+	if cfg.RetryInterval <= 0 {
+		return errors.New("retry interval should be positive")
+	}
+
+	return nil
+}
+
+// Signer is the off-chain service of a validator signing the LastCommitHash of each
+// epoch with its BLS key and submitting the signature to Babylon. It subscribes to the
+// checkpoints starting to accumulate BLS signatures, and polls them in case any event
+// is missed
+type Signer struct {
+	cfg       Config
+	bbn       Client
+	blsSigner keeper.BlsSigner
+	record    *Record
+	logger    log.Logger
+}
+
+func NewSigner(cfg Config, bbn Client, blsSigner keeper.BlsSigner, record *Record, logger log.Logger) (*Signer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if blsSigner.GetAddress().Empty() {
+		return nil, errors.New("the BLS signer is not bound to a validator address")
+	}
+
+	return &Signer{
+		cfg:       cfg,
+		bbn:       bbn,
+		blsSigner: blsSigner,
+		record:    record,
+		logger:    logger.With("module", "bls-signer"),
+	}, nil
+}
+
+// Start signs the accumulating checkpoints until the context is done
+func (s *Signer) Start(ctx context.Context) error {
+	checkpoints, err := s.bbn.SubscribeAccumulatingCheckpoints(ctx)
+	if err != nil {
+		s.logger.Error("failed to subscribe to accumulating checkpoints, relying on polling", "err", err)
+	}
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	if err := s.Poll(ctx); err != nil {
+		s.logger.Error("failed to poll", "err", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ckpt, ok := <-checkpoints:
+			if !ok {
+				s.logger.Error("subscription to accumulating checkpoints is closed, relying on polling")
+				checkpoints = nil
+				continue
+			}
+			if err := s.HandleCheckpoint(ctx, ckpt); err != nil {
+				s.logger.Error("failed to sign checkpoint", "epoch", ckpt.Ckpt.EpochNum, "err", err)
+			}
+		case <-ticker.C:
+			if err := s.Poll(ctx); err != nil {
+				s.logger.Error("failed to poll", "err", err)
+			}
+		}
+	}
+}
+
+// Poll signs all checkpoints still accumulating BLS signatures
+func (s *Signer) Poll(ctx context.Context) error {
+	checkpoints, err := s.bbn.AccumulatingCheckpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query accumulating checkpoints: %w", err)
+	}
+
+	for _, ckpt := range checkpoints {
+		if err := s.HandleCheckpoint(ctx, ckpt); err != nil {
+			s.logger.Error("failed to sign checkpoint", "epoch", ckpt.Ckpt.EpochNum, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// HandleCheckpoint signs the LastCommitHash of the checkpoint and submits the signature,
+// unless the validator is not in the validator set of the epoch or the bitmap of the
+// checkpoint already includes its signature. As a transaction accepted in the mempool
+// may still fail or be dropped, the signature is submitted again upon each poll until
+// the bitmap includes it or the checkpoint stops accumulating. A LastCommitHash different
+// from the one signed before for the same epoch is never signed
+func (s *Signer) HandleCheckpoint(ctx context.Context, ckpt *ckpttypes.RawCheckpointWithMeta) error {
+	if ckpt == nil || ckpt.Ckpt == nil || ckpt.Ckpt.LastCommitHash == nil {
+		return errors.New("incomplete checkpoint")
+	}
+	if ckpt.Status != ckpttypes.Accumulating {
+		return nil
+	}
+
+	epochNum := ckpt.Ckpt.EpochNum
+	lch := *ckpt.Ckpt.LastCommitHash
+
+	if signed, ok := s.record.Signed(epochNum); ok && !signed.Equal(lch) {
+		return fmt.Errorf("refusing to sign LastCommitHash %v of epoch %v, which differs from the signed %v", lch, epochNum, signed)
+	}
+
+	addr := s.blsSigner.GetAddress()
+	valSet, err := s.bbn.ValidatorSet(ctx, epochNum)
+	if err != nil {
+		return fmt.Errorf("failed to query the validator set: %w", err)
+	}
+	// the validator set is in the order of the bitmap
+	index, ok := validatorIndex(valSet, addr.String())
+	if !ok {
+		// only validators of the epoch sign its checkpoint, not being one is not an error
+		s.logger.Debug("not in the validator set of the epoch", "epoch", epochNum)
+		return nil
+	}
+	if index < len(ckpt.Ckpt.Bitmap)*8 && bitmap.Get(ckpt.Ckpt.Bitmap, index) {
+		s.logger.Debug("BLS sig is included in the checkpoint", "epoch", epochNum)
+		return nil
+	}
+
+	sig, err := s.blsSigner.SignMsgWithBls(lch.MustMarshal())
+	if err != nil {
+		return err
+	}
+
+	// the signed LastCommitHash is persisted before the signature leaves the signer
+	if err := s.record.SetSigned(epochNum, lch); err != nil {
+		return err
+	}
+
+	return s.submit(ctx, ckpttypes.NewMsgAddBlsSig(epochNum, lch, sig, addr))
+}
+
+// submit sends the BLS signature to Babylon, retrying until the transaction is accepted
+// in the mempool. Its inclusion is checked upon the next poll
+func (s *Signer) submit(ctx context.Context, msg *ckpttypes.MsgAddBlsSig) error {
+	epochNum := msg.BlsSig.EpochNum
+
+	for attempt := 1; ; attempt++ {
+		err := s.bbn.AddBlsSig(ctx, msg)
+		switch {
+		case err == nil:
+			s.logger.Info("submitted BLS sig", "epoch", epochNum)
+			return nil
+		case attempt > s.cfg.MaxRetries:
+			return fmt.Errorf("failed to submit BLS sig after %d attempts: %w", attempt, err)
+		}
+
+		s.logger.Error("failed to submit BLS sig, retrying", "epoch", epochNum, "attempt", attempt, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.RetryInterval):
+		}
+	}
+}
+
+func validatorIndex(valSet []*ckpttypes.ValidatorWithBlsKey, addr string) (int, bool) {
+	for i, val := range valSet {
+		if val.ValidatorAddress == addr {
+			return i, true
+		}
+	}
+	return 0, false
+}
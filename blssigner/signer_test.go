@@ -0,0 +1,243 @@
+package blssigner_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/blssigner"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/boljen/go-bitmap"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeClient is an in memory stand-in of Babylon
+type fakeClient struct {
+	accumulating []*ckpttypes.RawCheckpointWithMeta
+	subscription chan *ckpttypes.RawCheckpointWithMeta
+	valSet       []*ckpttypes.ValidatorWithBlsKey
+	// errors returned by the next submissions
+	errs []error
+	msgs []*ckpttypes.MsgAddBlsSig
+}
+
+func (c *fakeClient) AccumulatingCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error) {
+	return c.accumulating, nil
+}
+
+func (c *fakeClient) SubscribeAccumulatingCheckpoints(ctx context.Context) (<-chan *ckpttypes.RawCheckpointWithMeta, error) {
+	if c.subscription == nil {
+		return nil, errors.New("subscription is not supported")
+	}
+	return c.subscription, nil
+}
+
+func (c *fakeClient) ValidatorSet(ctx context.Context, epochNum uint64) ([]*ckpttypes.ValidatorWithBlsKey, error) {
+	return c.valSet, nil
+}
+
+func (c *fakeClient) AddBlsSig(ctx context.Context, msg *ckpttypes.MsgAddBlsSig) error {
+	c.msgs = append(c.msgs, msg)
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return err
+	}
+	return nil
+}
+
+func genBlsSigner(t *testing.T) *privval.WrappedFilePV {
+	dir := t.TempDir()
+	pv := privval.NewWrappedFilePV(ed25519.GenPrivKey(), bls12381.GenPrivKey(), filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	pv.SetAccAddress(sdk.AccAddress(pv.GetValPrivKey().PubKey().Address()))
+	return pv
+}
+
+func genAccumulatingCheckpoint(epoch uint64) *ckpttypes.RawCheckpointWithMeta {
+	ckpt := dg.GenRandomRawCheckpoint()
+	ckpt.EpochNum = epoch
+	return ckpttypes.NewCheckpointWithMeta(ckpt, ckpttypes.Accumulating)
+}
+
+func newTestSigner(t *testing.T, bbn *fakeClient, pv *privval.WrappedFilePV, record *blssigner.Record) *blssigner.Signer {
+	cfg := blssigner.DefaultConfig()
+	cfg.MaxRetries = 2
+	cfg.RetryInterval = time.Millisecond
+	signer, err := blssigner.NewSigner(cfg, bbn, pv, record, log.NewNopLogger())
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSignerSubmitsBlsSig(t *testing.T) {
+	pv := genBlsSigner(t)
+	ckpt := genAccumulatingCheckpoint(1)
+	bbn := &fakeClient{
+		accumulating: []*ckpttypes.RawCheckpointWithMeta{ckpt},
+		valSet:       []*ckpttypes.ValidatorWithBlsKey{{ValidatorAddress: pv.GetAddress().String()}},
+	}
+	record, err := blssigner.NewRecord("")
+	require.NoError(t, err)
+	signer := newTestSigner(t, bbn, pv, record)
+
+	ctx := context.Background()
+
+	// the LastCommitHash is signed in the same way as the checkpoints are verified
+	require.NoError(t, signer.Poll(ctx))
+	require.Len(t, bbn.msgs, 1)
+	sig := bbn.msgs[0].BlsSig
+	require.Equal(t, ckpt.Ckpt.EpochNum, sig.EpochNum)
+	require.Equal(t, pv.GetAddress().String(), sig.SignerAddress)
+	blsPubKey, err := pv.GetBlsPubkey()
+	require.NoError(t, err)
+	valid, err := bls12381.Verify(*sig.BlsSig, blsPubKey, ckpt.Ckpt.LastCommitHash.MustMarshal())
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// the BLS sig is submitted again as long as the bitmap does not include it, since the
+	// transaction may have failed after being accepted in the mempool
+	require.NoError(t, signer.Poll(ctx))
+	require.Len(t, bbn.msgs, 2)
+	require.Equal(t, bbn.msgs[0], bbn.msgs[1])
+
+	// the included BLS sig is not sent again while the checkpoint is accumulating
+	bitmap.Set(ckpt.Ckpt.Bitmap, 0, true)
+	require.NoError(t, signer.Poll(ctx))
+	require.Len(t, bbn.msgs, 2)
+}
+
+func TestSignerChecksBitmapIndex(t *testing.T) {
+	pv := genBlsSigner(t)
+	ckpt := genAccumulatingCheckpoint(1)
+	other := sdk.ValAddress(dg.GenRandomByteArray(20)).String()
+	bbn := &fakeClient{
+		accumulating: []*ckpttypes.RawCheckpointWithMeta{ckpt},
+		valSet: []*ckpttypes.ValidatorWithBlsKey{
+			{ValidatorAddress: other},
+			{ValidatorAddress: pv.GetAddress().String()},
+		},
+	}
+	record, err := blssigner.NewRecord("")
+	require.NoError(t, err)
+	signer := newTestSigner(t, bbn, pv, record)
+
+	// the bit of another validator does not include the BLS sig of the validator
+	bitmap.Set(ckpt.Ckpt.Bitmap, 0, true)
+	require.NoError(t, signer.Poll(context.Background()))
+	require.Len(t, bbn.msgs, 1)
+
+	bitmap.Set(ckpt.Ckpt.Bitmap, 1, true)
+	require.NoError(t, signer.Poll(context.Background()))
+	require.Len(t, bbn.msgs, 1)
+}
+
+func TestSignerSkipsOtherValidatorSets(t *testing.T) {
+	pv := genBlsSigner(t)
+	bbn := &fakeClient{
+		accumulating: []*ckpttypes.RawCheckpointWithMeta{genAccumulatingCheckpoint(1)},
+		valSet:       []*ckpttypes.ValidatorWithBlsKey{{ValidatorAddress: sdk.ValAddress(dg.GenRandomByteArray(20)).String()}},
+	}
+	record, err := blssigner.NewRecord("")
+	require.NoError(t, err)
+	signer := newTestSigner(t, bbn, pv, record)
+
+	require.NoError(t, signer.Poll(context.Background()))
+	require.Empty(t, bbn.msgs)
+}
+
+func TestSignerRetriesSubmission(t *testing.T) {
+	testCases := []struct {
+		name      string
+		errs      []error
+		attempts  int
+		submitted bool
+	}{
+		{"transient failures", []error{errors.New("timeout"), errors.New("timeout")}, 3, true},
+		{"too many failures", []error{errors.New("timeout"), errors.New("timeout"), errors.New("timeout")}, 3, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pv := genBlsSigner(t)
+			ckpt := genAccumulatingCheckpoint(1)
+			bbn := &fakeClient{
+				valSet: []*ckpttypes.ValidatorWithBlsKey{{ValidatorAddress: pv.GetAddress().String()}},
+				errs:   tc.errs,
+			}
+			record, err := blssigner.NewRecord("")
+			require.NoError(t, err)
+			signer := newTestSigner(t, bbn, pv, record)
+
+			err = signer.HandleCheckpoint(context.Background(), ckpt)
+			require.Equal(t, tc.submitted, err == nil)
+			require.Len(t, bbn.msgs, tc.attempts)
+			// the signed LastCommitHash is recorded even if the submission fails
+			signed, ok := record.Signed(ckpt.Ckpt.EpochNum)
+			require.True(t, ok)
+			require.Equal(t, *ckpt.Ckpt.LastCommitHash, signed)
+		})
+	}
+}
+
+func TestSignerRefusesDoubleSign(t *testing.T) {
+	pv := genBlsSigner(t)
+	path := filepath.Join(t.TempDir(), blssigner.DefaultRecordFile)
+	ckpt := genAccumulatingCheckpoint(1)
+	bbn := &fakeClient{
+		valSet: []*ckpttypes.ValidatorWithBlsKey{{ValidatorAddress: pv.GetAddress().String()}},
+		// the submission fails, so the checkpoint is signed again upon the next poll
+		errs: []error{errors.New("timeout"), errors.New("timeout"), errors.New("timeout")},
+	}
+	record, err := blssigner.NewRecord(path)
+	require.NoError(t, err)
+	signer := newTestSigner(t, bbn, pv, record)
+	require.Error(t, signer.HandleCheckpoint(context.Background(), ckpt))
+	require.Len(t, bbn.msgs, 3)
+
+	// after a restart, the signer refuses to sign a different LastCommitHash of the same epoch
+	record, err = blssigner.NewRecord(path)
+	require.NoError(t, err)
+	signer = newTestSigner(t, bbn, pv, record)
+	conflicting := genAccumulatingCheckpoint(1)
+	require.Error(t, signer.HandleCheckpoint(context.Background(), conflicting))
+	require.Len(t, bbn.msgs, 3)
+
+	// but signs the same LastCommitHash again
+	require.NoError(t, signer.HandleCheckpoint(context.Background(), ckpt))
+	require.Len(t, bbn.msgs, 4)
+}
+
+func TestSignerSubscribesToAccumulatingCheckpoints(t *testing.T) {
+	pv := genBlsSigner(t)
+	bbn := &fakeClient{
+		subscription: make(chan *ckpttypes.RawCheckpointWithMeta),
+		valSet:       []*ckpttypes.ValidatorWithBlsKey{{ValidatorAddress: pv.GetAddress().String()}},
+	}
+	record, err := blssigner.NewRecord("")
+	require.NoError(t, err)
+	signer := newTestSigner(t, bbn, pv, record)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- signer.Start(ctx)
+	}()
+
+	ckpt := genAccumulatingCheckpoint(1)
+	bbn.subscription <- ckpt
+	// the checkpoint is handled before the next event is received
+	bbn.subscription <- genAccumulatingCheckpoint(2)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	_, ok := record.Signed(ckpt.Ckpt.EpochNum)
+	require.True(t, ok)
+	require.Equal(t, ckpt.Ckpt.EpochNum, bbn.msgs[0].BlsSig.EpochNum)
+}
@@ -40,7 +40,8 @@ const (
 
 	AddressLength = 20
 
-	// Each checkpoint is composed of two parts
+	// Each checkpoint in CurrentVersion is composed of two parts. See NPartsVersion
+	// for checkpoints composed of more parts
 	NumberOfParts = 2
 
 	// First 10 bytes of sha256 of first part are appended to second part to ease up
@@ -143,7 +144,8 @@ func EncodeCheckpointData(
 		return nil, nil, errors.New("tag should have 4 bytes")
 	}
 
-	if version > CurrentVersion {
+	// other versions are not encoded in two parts, see EncodeCheckpoint
+	if version != CurrentVersion {
 		return nil, nil, errors.New("invalid format version")
 	}
 
@@ -216,7 +218,7 @@ func (header *formatHeader) validateHeader(
 		return errors.New("data does not have expected tag")
 	}
 
-	if header.version > CurrentVersion {
+	if header.version != supportedVersion {
 		return errors.New("header have invalid version")
 	}
 
@@ -238,7 +240,7 @@ func GetCheckpointData(
 		return nil, errors.New("invalid part index")
 	}
 
-	if version > CurrentVersion {
+	if version != CurrentVersion {
 		return nil, errors.New("not supported version")
 	}
 
@@ -294,7 +296,7 @@ func IsBabylonCheckpointData(
 }
 
 func ConnectParts(version FormatVersion, f []byte, s []byte) ([]byte, error) {
-	if version > CurrentVersion {
+	if version != CurrentVersion {
 		return nil, errors.New("not supported version")
 	}
 
@@ -1,6 +1,7 @@
 package btctxformatter
 
 import (
+	"bytes"
 	"crypto/rand"
 	"testing"
 )
@@ -86,3 +87,228 @@ func FuzzDecodingWontPanic(f *testing.F) {
 		}
 	})
 }
+
+func FuzzNPartsEncodingDecoding(f *testing.F) {
+	f.Add(uint64(5), randNBytes(TagLength), randNBytes(LastCommitHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+	f.Add(uint64(20), randNBytes(TagLength), randNBytes(LastCommitHashLength), randNBytes(100), randNBytes(BlsSigLength), randNBytes(AddressLength))
+	f.Add(uint64(2000), randNBytes(TagLength), randNBytes(LastCommitHashLength), randNBytes(MaxNPartsBitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+
+	f.Fuzz(func(t *testing.T, epoch uint64, tag []byte, lastCommitHash []byte, bitMap []byte, blsSig []byte, address []byte) {
+
+		if len(tag) < TagLength {
+			t.Skip("Tag should have 4 bytes")
+		}
+
+		babylonTag := BabylonTag(tag[:TagLength])
+
+		encodedParts, err := EncodeCheckpointDataNParts(
+			babylonTag,
+			epoch,
+			lastCommitHash,
+			bitMap,
+			blsSig,
+			address,
+		)
+
+		if err != nil {
+			// if encoding failed we cannod check anything else
+			t.Skip("Encoding should be correct")
+		}
+
+		if len(encodedParts) != NumberOfPartsForBitMap(len(bitMap)) {
+			t.Fatalf("Expected %d parts, have %d", NumberOfPartsForBitMap(len(bitMap)), len(encodedParts))
+		}
+
+		var parts []*CheckpointPart
+		for i, encodedPart := range encodedParts {
+			if len(encodedPart) > MaxOpReturnLength {
+				t.Errorf("Part %d does not fit in OP_RETURN, have %d bytes", i, len(encodedPart))
+			}
+
+			version, err := GetFormatVersion(babylonTag, encodedPart)
+			if err != nil || version != NPartsVersion {
+				t.Errorf("Part %d should be encoded in NPartsVersion", i)
+			}
+
+			part, err := GetCheckpointPart(babylonTag, encodedPart)
+			if err != nil {
+				t.Fatalf("Valid part %d should be properly decoded. Error: %v", i, err)
+			}
+
+			if int(part.Index) != i {
+				t.Errorf("Expected part index %d, have %d", i, part.Index)
+			}
+
+			parts = append(parts, part)
+		}
+
+		data, err := ConnectNParts(parts)
+
+		if err != nil {
+			t.Fatalf("Parts should match. Error: %v", err)
+		}
+
+		expectedData := append(u64ToBEBytes(epoch), lastCommitHash...)
+		expectedData = append(expectedData, bitMap...)
+		expectedData = append(expectedData, address...)
+		expectedData = append(expectedData, blsSig...)
+
+		if !bytes.Equal(expectedData, data) {
+			t.Errorf("Connected parts do not match the encoded application data")
+		}
+
+		// parts in the wrong order do not connect
+		parts[0], parts[1] = parts[1], parts[0]
+
+		if _, err := ConnectNParts(parts); err == nil {
+			t.Errorf("Parts in the wrong order should not connect")
+		}
+	})
+}
+
+func FuzzWitnessEncodingDecoding(f *testing.F) {
+	f.Add(uint64(5), randNBytes(TagLength), randNBytes(LastCommitHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+	f.Add(uint64(2000), randNBytes(TagLength), randNBytes(LastCommitHashLength), randNBytes(MaxWitnessBitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+
+	f.Fuzz(func(t *testing.T, epoch uint64, tag []byte, lastCommitHash []byte, bitMap []byte, blsSig []byte, address []byte) {
+
+		if len(tag) < TagLength {
+			t.Skip("Tag should have 4 bytes")
+		}
+
+		babylonTag := BabylonTag(tag[:TagLength])
+
+		encoded, err := EncodeCheckpointDataWitness(
+			babylonTag,
+			epoch,
+			lastCommitHash,
+			bitMap,
+			blsSig,
+			address,
+		)
+
+		if err != nil {
+			// if encoding failed we cannod check anything else
+			t.Skip("Encoding should be correct")
+		}
+
+		script, err := BuildWitnessEnvelope(randNBytes(32), encoded)
+
+		if err != nil {
+			t.Fatalf("Valid data should be wrapped in envelope. Error: %v", err)
+		}
+
+		extracted := ExtractWitnessEnvelope(script)
+
+		if !bytes.Equal(encoded, extracted) {
+			t.Fatalf("Extracted envelope data does not match the encoded data")
+		}
+
+		version, err := GetFormatVersion(babylonTag, extracted)
+		if err != nil || version != WitnessVersion {
+			t.Errorf("Data should be encoded in WitnessVersion")
+		}
+
+		data, err := GetCheckpointDataWitness(babylonTag, extracted)
+
+		if err != nil {
+			t.Fatalf("Valid data should be properly decoded. Error: %v", err)
+		}
+
+		expectedData := append(u64ToBEBytes(epoch), lastCommitHash...)
+		expectedData = append(expectedData, bitMap...)
+		expectedData = append(expectedData, address...)
+		expectedData = append(expectedData, blsSig...)
+
+		if !bytes.Equal(expectedData, data) {
+			t.Errorf("Decoded data does not match the encoded application data")
+		}
+
+		// envelope which is not closed is not extracted
+		if ExtractWitnessEnvelope(script[:len(script)-1]) != nil {
+			t.Errorf("Envelope without OP_ENDIF should not be extracted")
+		}
+	})
+}
+
+func TestEncodeDecodeRegisteredVersions(t *testing.T) {
+	tag := BabylonTag(randNBytes(TagLength))
+	maxSupported := false
+
+	for _, version := range RegisteredVersions() {
+		spec, err := GetFormatSpec(version)
+		if err != nil {
+			t.Fatalf("Registered version %d should have a spec", version)
+		}
+
+		if spec.MaxBitMapLength > MaxSupportedBitMapLength() {
+			t.Errorf("Version %d supports bitmaps longer than the max supported length", version)
+		}
+
+		maxSupported = maxSupported || spec.MaxBitMapLength == MaxSupportedBitMapLength()
+
+		bitMap := randNBytes(spec.MaxBitMapLength)
+
+		parts, err := EncodeCheckpoint(
+			tag,
+			version,
+			10,
+			randNBytes(LastCommitHashLength),
+			bitMap,
+			randNBytes(BlsSigLength),
+			randNBytes(AddressLength),
+		)
+
+		if err != nil {
+			t.Fatalf("Version %d should encode valid checkpoint. Error: %v", version, err)
+		}
+
+		if len(parts) < spec.MinParts || len(parts) > spec.MaxParts {
+			t.Errorf("Version %d encoded checkpoint in %d parts", version, len(parts))
+		}
+
+		decodedVersion, data, err := DecodeCheckpoint(tag, parts)
+
+		if err != nil {
+			t.Fatalf("Version %d should decode its own encoding. Error: %v", version, err)
+		}
+
+		if decodedVersion != version {
+			t.Errorf("Expected version %d, decoded %d", version, decodedVersion)
+		}
+
+		if !bytes.Contains(data, bitMap) {
+			t.Errorf("Decoded data of version %d should contain the bitmap", version)
+		}
+
+		for i := 1; i < len(parts); i++ {
+			if !IsNextPart(tag, parts[i-1], parts[i]) {
+				t.Errorf("Part %d of version %d should follow part %d", i, version, i-1)
+			}
+
+			if IsNextPart(tag, parts[i], parts[i-1]) {
+				t.Errorf("Part %d of version %d should not follow part %d", i-1, version, i)
+			}
+		}
+
+		if _, err := EncodeCheckpoint(
+			tag,
+			version,
+			10,
+			randNBytes(LastCommitHashLength),
+			randNBytes(spec.MaxBitMapLength+1),
+			randNBytes(BlsSigLength),
+			randNBytes(AddressLength),
+		); err == nil {
+			t.Errorf("Version %d should reject too long bitmap", version)
+		}
+	}
+
+	if !maxSupported {
+		t.Errorf("Some version should support bitmaps of the max supported length")
+	}
+
+	if _, err := GetFormatSpec(FormatVersion(15)); err == nil {
+		t.Errorf("Unregistered version should not have a spec")
+	}
+}
@@ -0,0 +1,273 @@
+package btctxformatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// In NPartsVersion a checkpoint is split over as many OP_RETURN transactions as
+// needed to fit a bitmap of arbitrary length.
+// Each part starts with the usual header followed by the number of parts of the
+// checkpoint. Every part apart from the first one ends with the checksum of the
+// previous part, so that the parts form a chain. Every part apart from the last one
+// has the maximum OP_RETURN length.
+// Once connected, the parts form the application data with the same layout as
+// in CurrentVersion, i.e. epoch, last commit hash, bitmap, submitter address and
+// bls signature, with the difference that the bitmap has variable length.
+
+const (
+	NPartsVersion FormatVersion = 1
+
+	// Part index is encoded in 4 bits
+	MaxNumberOfParts = 16
+
+	// Maximum size of OP_RETURN data relayed by standard bitcoin nodes
+	MaxOpReturnLength = 80
+
+	// 4bytes tag + 4 bits version + 4 bits part index + 1 byte number of parts
+	nPartsHeaderLength = headerLength + 1
+
+	firstNPartDataLength = MaxOpReturnLength - nPartsHeaderLength
+
+	nPartDataLength = MaxOpReturnLength - nPartsHeaderLength - hashLength
+
+	// 8 bytes are for 64bit unsigned epoch number. Bitmap takes the rest of
+	// the application data
+	fixedApplicationDataLength = 8 + LastCommitHashLength + AddressLength + BlsSigLength
+
+	// Maximum length of the bitmap which fits in MaxNumberOfParts parts
+	MaxNPartsBitMapLength = firstNPartDataLength + (MaxNumberOfParts-1)*nPartDataLength - fixedApplicationDataLength
+)
+
+// CheckpointPart is the data of a part of a checkpoint encoded in NPartsVersion,
+// without the header
+type CheckpointPart struct {
+	Data     []byte
+	Index    uint8
+	NumParts uint8
+}
+
+func encodeNPartsHeader(tag BabylonTag, partIndex uint8, numParts uint8) []byte {
+	data := encodeHeader(tag, NPartsVersion, partIndex)
+	return append(data, numParts)
+}
+
+// encodeApplicationData serializes the checkpoint in the layout of the application data
+// of CurrentVersion, which is shared by the versions supporting bitmaps of variable length
+func encodeApplicationData(
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) []byte {
+	var data = []byte{}
+	data = append(data, u64ToBEBytes(epoch)...)
+	data = append(data, lastCommitHash...)
+	data = append(data, bitmap...)
+	data = append(data, submitterAddress...)
+	data = append(data, blsSig...)
+	return data
+}
+
+// NumberOfPartsForBitMap returns the number of parts needed to encode a
+// checkpoint with a bitmap of the given length in NPartsVersion
+func NumberOfPartsForBitMap(bitMapLength int) int {
+	dataLength := fixedApplicationDataLength + bitMapLength
+	if dataLength <= firstNPartDataLength {
+		return 1
+	}
+	// ceil of remaining data over the data length of a part
+	return 1 + (dataLength-firstNPartDataLength+nPartDataLength-1)/nPartDataLength
+}
+
+// EncodeCheckpointDataNParts encodes the checkpoint in NPartsVersion and returns
+// the OP_RETURN data of all its parts in order
+func EncodeCheckpointDataNParts(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) ([][]byte, error) {
+
+	if len(tag) != TagLength {
+		return nil, errors.New("tag should have 4 bytes")
+	}
+
+	if len(lastCommitHash) != LastCommitHashLength {
+		return nil, errors.New("lastCommitHash should have 32 bytes")
+	}
+
+	if len(bitmap) == 0 || len(bitmap) > MaxNPartsBitMapLength {
+		return nil, fmt.Errorf("bitmap should have between 1 and %d bytes", MaxNPartsBitMapLength)
+	}
+
+	if len(blsSig) != BlsSigLength {
+		return nil, errors.New("BlsSig should have 48 bytes")
+	}
+
+	if len(submitterAddress) != AddressLength {
+		return nil, errors.New("address should have 20 bytes")
+	}
+
+	data := encodeApplicationData(epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+
+	numParts := uint8(NumberOfPartsForBitMap(len(bitmap)))
+
+	parts := make([][]byte, numParts)
+	var previousPartData []byte
+	for i := uint8(0); i < numParts; i++ {
+		chunkLength := nPartDataLength
+		if i == 0 {
+			chunkLength = firstNPartDataLength
+		}
+		if chunkLength > len(data) {
+			chunkLength = len(data)
+		}
+
+		var partData = []byte{}
+		partData = append(partData, data[:chunkLength]...)
+		if i > 0 {
+			partData = append(partData, getCheckSum(previousPartData)...)
+		}
+		data = data[chunkLength:]
+
+		parts[i] = append(encodeNPartsHeader(tag, i, numParts), partData...)
+		previousPartData = partData
+	}
+
+	return parts, nil
+}
+
+func MustEncodeCheckpointDataNParts(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) [][]byte {
+	parts, err := EncodeCheckpointDataNParts(tag, epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return parts
+}
+
+// GetFormatVersion returns the format version of the given OP_RETURN data
+// if it starts with the expected tag
+func GetFormatVersion(tag BabylonTag, data []byte) (FormatVersion, error) {
+	if len(data) < headerLength {
+		return 0, errors.New("data is shorter than the header")
+	}
+
+	header := parseHeader(data)
+
+	if !bytes.Equal(header.tag, tag) {
+		return 0, errors.New("data does not have expected tag")
+	}
+
+	return header.version, nil
+}
+
+// GetPartIndex returns the index of the part of the checkpoint carried by the given
+// OP_RETURN data if it starts with the expected tag
+func GetPartIndex(tag BabylonTag, data []byte) (uint8, error) {
+	if len(data) < headerLength {
+		return 0, errors.New("data is shorter than the header")
+	}
+
+	header := parseHeader(data)
+
+	if !bytes.Equal(header.tag, tag) {
+		return 0, errors.New("data does not have expected tag")
+	}
+
+	return header.part, nil
+}
+
+// GetCheckpointPart validates that the data is a part of a checkpoint encoded
+// in NPartsVersion and returns the part without its header
+func GetCheckpointPart(tag BabylonTag, data []byte) (*CheckpointPart, error) {
+	if len(data) < nPartsHeaderLength || len(data) > MaxOpReturnLength {
+		return nil, errors.New("invalid length of checkpoint part")
+	}
+
+	header := parseHeader(data)
+	numParts := data[headerLength]
+
+	if !bytes.Equal(header.tag, tag) {
+		return nil, errors.New("data does not have expected tag")
+	}
+
+	if header.version != NPartsVersion {
+		return nil, errors.New("header have invalid version")
+	}
+
+	if numParts < 2 || numParts > MaxNumberOfParts {
+		return nil, errors.New("header have invalid number of parts")
+	}
+
+	if header.part >= numParts {
+		return nil, errors.New("header have invalid part number")
+	}
+
+	// all parts apart from the last one should have maximum length, so that
+	// every checkpoint has exactly one encoding
+	if header.part < numParts-1 && len(data) != MaxOpReturnLength {
+		return nil, errors.New("invalid length. Only the last part can be shorter than the OP_RETURN limit")
+	}
+
+	if header.part > 0 && len(data) <= nPartsHeaderLength+hashLength {
+		return nil, errors.New("invalid length. Part does not contain any data")
+	}
+
+	dataNoHeader := make([]byte, len(data)-nPartsHeaderLength)
+
+	copy(dataNoHeader, data[nPartsHeaderLength:])
+
+	return &CheckpointPart{Data: dataNoHeader, Index: header.part, NumParts: numParts}, nil
+}
+
+// ConnectNParts checks that the parts, provided in order, form a complete checkpoint
+// and returns its application data
+func ConnectNParts(parts []*CheckpointPart) ([]byte, error) {
+	if len(parts) < 2 || len(parts) > MaxNumberOfParts {
+		return nil, errors.New("invalid number of parts")
+	}
+
+	var dst []byte
+
+	for i, part := range parts {
+		if int(part.Index) != i || int(part.NumParts) != len(parts) {
+			return nil, errors.New("parts are not in order or do not belong to the same checkpoint")
+		}
+
+		if i == 0 {
+			dst = append(dst, part.Data...)
+			continue
+		}
+
+		hashStartIdx := len(part.Data) - hashLength
+
+		if hashStartIdx <= 0 {
+			return nil, errors.New("not valid part")
+		}
+
+		if !bytes.Equal(getCheckSum(parts[i-1].Data), part.Data[hashStartIdx:]) {
+			return nil, errors.New("parts do not connect")
+		}
+
+		dst = append(dst, part.Data[:hashStartIdx]...)
+	}
+
+	if len(dst) <= fixedApplicationDataLength {
+		return nil, errors.New("application data does not contain a bitmap")
+	}
+
+	return dst, nil
+}
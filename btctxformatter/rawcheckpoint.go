@@ -0,0 +1,45 @@
+package btctxformatter
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// RawBtcCheckpoint is the checkpoint carried by BTC transactions, decoded from the
+// application data, which has the same layout in all format versions
+type RawBtcCheckpoint struct {
+	Epoch            uint64
+	LastCommitHash   []byte
+	BitMap           []byte
+	SubmitterAddress []byte
+	BlsSig           []byte
+}
+
+// Encode returns the application data of the checkpoint
+func (c *RawBtcCheckpoint) Encode() []byte {
+	return encodeApplicationData(c.Epoch, c.LastCommitHash, c.BitMap, c.BlsSig, c.SubmitterAddress)
+}
+
+// DecodeRawCheckpoint decodes the application data returned by DecodeCheckpoint
+func DecodeRawCheckpoint(data []byte) (*RawBtcCheckpoint, error) {
+	if len(data) <= fixedApplicationDataLength {
+		return nil, errors.New("application data does not contain a bitmap")
+	}
+
+	bitMapEnd := len(data) - AddressLength - BlsSigLength
+	addressEnd := len(data) - BlsSigLength
+
+	return &RawBtcCheckpoint{
+		Epoch:            binary.BigEndian.Uint64(data[:8]),
+		LastCommitHash:   copyBytes(data[8 : 8+LastCommitHashLength]),
+		BitMap:           copyBytes(data[8+LastCommitHashLength : bitMapEnd]),
+		SubmitterAddress: copyBytes(data[bitMapEnd:addressEnd]),
+		BlsSig:           copyBytes(data[addressEnd:]),
+	}, nil
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
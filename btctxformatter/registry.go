@@ -0,0 +1,290 @@
+package btctxformatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// FormatSpec describes how a checkpoint is encoded in a given format version
+type FormatSpec struct {
+	Version FormatVersion
+
+	// InWitness is true if the checkpoint is carried in the witness envelope of a
+	// transaction instead of its OP_RETURN output
+	InWitness bool
+
+	// Bounds on the number of transactions carrying one checkpoint
+	MinParts int
+	MaxParts int
+
+	// Bounds on the length of the bitmap of the checkpoint
+	MinBitMapLength int
+	MaxBitMapLength int
+
+	// Encode returns the babylon data of all parts of the checkpoint in order
+	Encode func(
+		tag BabylonTag,
+		epoch uint64,
+		lastCommitHash []byte,
+		bitmap []byte,
+		blsSig []byte,
+		submitterAddress []byte,
+	) ([][]byte, error)
+
+	// Decode checks that the babylon data of the parts, provided in order, form
+	// a complete checkpoint and returns its application data
+	Decode func(tag BabylonTag, parts [][]byte) ([]byte, error)
+}
+
+var formatRegistry = map[FormatVersion]*FormatSpec{
+	CurrentVersion: {
+		Version:         CurrentVersion,
+		MinParts:        NumberOfParts,
+		MaxParts:        NumberOfParts,
+		MinBitMapLength: BitMapLength,
+		MaxBitMapLength: BitMapLength,
+		Encode:          encodeTwoParts,
+		Decode:          decodeTwoParts,
+	},
+	NPartsVersion: {
+		Version:         NPartsVersion,
+		MinParts:        2,
+		MaxParts:        MaxNumberOfParts,
+		MinBitMapLength: 1,
+		MaxBitMapLength: MaxNPartsBitMapLength,
+		Encode:          EncodeCheckpointDataNParts,
+		Decode:          decodeNParts,
+	},
+	WitnessVersion: {
+		Version:         WitnessVersion,
+		InWitness:       true,
+		MinParts:        1,
+		MaxParts:        1,
+		MinBitMapLength: 1,
+		MaxBitMapLength: MaxWitnessBitMapLength,
+		Encode:          encodeWitness,
+		Decode:          decodeWitness,
+	},
+}
+
+// GetFormatSpec returns the specification of a registered format version
+func GetFormatSpec(version FormatVersion) (*FormatSpec, error) {
+	spec, ok := formatRegistry[version]
+
+	if !ok {
+		return nil, fmt.Errorf("not supported format version %d", version)
+	}
+
+	return spec, nil
+}
+
+// RegisteredVersions returns all registered format versions in ascending order
+func RegisteredVersions() []FormatVersion {
+	versions := make([]FormatVersion, 0, len(formatRegistry))
+
+	for v := range formatRegistry {
+		versions = append(versions, v)
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions
+}
+
+// MaxSupportedBitMapLength returns the length of the longest bitmap which can be
+// encoded in some registered format version
+func MaxSupportedBitMapLength() int {
+	return MaxBitMapLength(RegisteredVersions())
+}
+
+// MaxBitMapLength returns the length of the longest bitmap which can be encoded in
+// one of the given format versions. Unregistered versions are ignored
+func MaxBitMapLength(versions []FormatVersion) int {
+	max := 0
+
+	for _, version := range versions {
+		spec, ok := formatRegistry[version]
+		if ok && spec.MaxBitMapLength > max {
+			max = spec.MaxBitMapLength
+		}
+	}
+
+	return max
+}
+
+// EncodeCheckpoint encodes the checkpoint in the given format version and returns
+// the babylon data of all its parts in order
+func EncodeCheckpoint(
+	tag BabylonTag,
+	version FormatVersion,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) ([][]byte, error) {
+	spec, err := GetFormatSpec(version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bitmap) < spec.MinBitMapLength || len(bitmap) > spec.MaxBitMapLength {
+		return nil, fmt.Errorf("bitmap should have between %d and %d bytes", spec.MinBitMapLength, spec.MaxBitMapLength)
+	}
+
+	return spec.Encode(tag, epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+}
+
+// DecodeCheckpoint decodes the babylon data of all parts of a checkpoint, provided
+// in order, in the format version of the first part. It returns the format version
+// along with the application data of the checkpoint
+func DecodeCheckpoint(tag BabylonTag, parts [][]byte) (FormatVersion, []byte, error) {
+	if len(parts) == 0 {
+		return 0, nil, errors.New("checkpoint should have at least one part")
+	}
+
+	// all parts of the checkpoint are encoded in the same version, the one of the first part
+	version, err := GetFormatVersion(tag, parts[0])
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	spec, err := GetFormatSpec(version)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(parts) < spec.MinParts || len(parts) > spec.MaxParts {
+		return 0, nil, fmt.Errorf("expected between %d and %d parts in version %d", spec.MinParts, spec.MaxParts, version)
+	}
+
+	data, err := spec.Decode(tag, parts)
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return version, data, nil
+}
+
+// IsNextPart returns true if next is the part following prev in the same checkpoint,
+// i.e. both parts are encoded in the same version carried in OP_RETURN outputs and
+// next carries the checksum of prev. It allows matching the parts of a checkpoint
+// which may be included in different transactions and blocks.
+func IsNextPart(tag BabylonTag, prev []byte, next []byte) bool {
+	version, err := GetFormatVersion(tag, prev)
+
+	if err != nil {
+		return false
+	}
+
+	switch version {
+	case CurrentVersion:
+		f, err := GetCheckpointData(tag, CurrentVersion, 0, prev)
+		if err != nil {
+			return false
+		}
+
+		s, err := GetCheckpointData(tag, CurrentVersion, 1, next)
+		if err != nil {
+			return false
+		}
+
+		_, err = ConnectParts(CurrentVersion, f, s)
+		return err == nil
+	case NPartsVersion:
+		p, err := GetCheckpointPart(tag, prev)
+		if err != nil {
+			return false
+		}
+
+		n, err := GetCheckpointPart(tag, next)
+		if err != nil {
+			return false
+		}
+
+		if n.Index != p.Index+1 || n.NumParts != p.NumParts {
+			return false
+		}
+
+		// GetCheckpointPart guarantees that parts other than the first one are longer
+		// than the checksum
+		return bytes.Equal(getCheckSum(p.Data), n.Data[len(n.Data)-hashLength:])
+	default:
+		return false
+	}
+}
+
+func encodeTwoParts(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) ([][]byte, error) {
+	f, s, err := EncodeCheckpointData(tag, CurrentVersion, epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{f, s}, nil
+}
+
+func decodeTwoParts(tag BabylonTag, parts [][]byte) ([]byte, error) {
+	var checkpointData [][]byte
+
+	for i, part := range parts {
+		data, err := GetCheckpointData(tag, CurrentVersion, uint8(i), part)
+
+		if err != nil {
+			return nil, err
+		}
+		checkpointData = append(checkpointData, data)
+	}
+
+	return ConnectParts(CurrentVersion, checkpointData[0], checkpointData[1])
+}
+
+func decodeNParts(tag BabylonTag, parts [][]byte) ([]byte, error) {
+	var checkpointParts []*CheckpointPart
+
+	for _, data := range parts {
+		part, err := GetCheckpointPart(tag, data)
+
+		if err != nil {
+			return nil, err
+		}
+		checkpointParts = append(checkpointParts, part)
+	}
+
+	// parts need to be provided in order and their checksums need to form a chain
+	return ConnectNParts(checkpointParts)
+}
+
+func encodeWitness(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) ([][]byte, error) {
+	data, err := EncodeCheckpointDataWitness(tag, epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{data}, nil
+}
+
+func decodeWitness(tag BabylonTag, parts [][]byte) ([]byte, error) {
+	return GetCheckpointDataWitness(tag, parts[0])
+}
@@ -0,0 +1,179 @@
+package btctxformatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// In WitnessVersion the whole checkpoint is carried by a single transaction, in the
+// witness of a Taproot script path spend. The tapscript contains an envelope, i.e. an
+// OP_FALSE OP_IF ... OP_ENDIF block which is never executed, with the checkpoint
+// split in data pushes:
+//
+//	<x-only public key> OP_CHECKSIG OP_FALSE OP_IF <push 1> ... <push n> OP_ENDIF
+//
+// Once the pushes are concatenated, the data starts with the usual header
+// followed by the application data with the same layout as in CurrentVersion,
+// with the difference that the bitmap has variable length.
+
+const (
+	WitnessVersion FormatVersion = 2
+
+	// Maximum length of the bitmap of a checkpoint encoded in WitnessVersion
+	MaxWitnessBitMapLength = 8192
+
+	// Maximum size of a data push in tapscript
+	maxEnvelopePushLength = txscript.MaxScriptElementSize
+)
+
+// EncodeCheckpointDataWitness encodes the checkpoint in WitnessVersion and returns
+// the data to be included in the witness envelope
+func EncodeCheckpointDataWitness(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) ([]byte, error) {
+
+	if len(tag) != TagLength {
+		return nil, errors.New("tag should have 4 bytes")
+	}
+
+	if len(lastCommitHash) != LastCommitHashLength {
+		return nil, errors.New("lastCommitHash should have 32 bytes")
+	}
+
+	if len(bitmap) == 0 || len(bitmap) > MaxWitnessBitMapLength {
+		return nil, fmt.Errorf("bitmap should have between 1 and %d bytes", MaxWitnessBitMapLength)
+	}
+
+	if len(blsSig) != BlsSigLength {
+		return nil, errors.New("BlsSig should have 48 bytes")
+	}
+
+	if len(submitterAddress) != AddressLength {
+		return nil, errors.New("address should have 20 bytes")
+	}
+
+	data := encodeHeader(tag, WitnessVersion, firstPartIndex)
+	data = append(data, encodeApplicationData(epoch, lastCommitHash, bitmap, blsSig, submitterAddress)...)
+
+	return data, nil
+}
+
+func MustEncodeCheckpointDataWitness(
+	tag BabylonTag,
+	epoch uint64,
+	lastCommitHash []byte,
+	bitmap []byte,
+	blsSig []byte,
+	submitterAddress []byte,
+) []byte {
+	data, err := EncodeCheckpointDataWitness(tag, epoch, lastCommitHash, bitmap, blsSig, submitterAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// GetCheckpointDataWitness validates that the data extracted from a witness envelope
+// is a checkpoint encoded in WitnessVersion and returns its application data
+func GetCheckpointDataWitness(tag BabylonTag, data []byte) ([]byte, error) {
+	if len(data) <= headerLength+fixedApplicationDataLength ||
+		len(data) > headerLength+fixedApplicationDataLength+MaxWitnessBitMapLength {
+		return nil, errors.New("invalid length of witness checkpoint data")
+	}
+
+	header := parseHeader(data)
+
+	if !bytes.Equal(header.tag, tag) {
+		return nil, errors.New("data does not have expected tag")
+	}
+
+	if header.version != WitnessVersion {
+		return nil, errors.New("header have invalid version")
+	}
+
+	if header.part != firstPartIndex {
+		return nil, errors.New("header have invalid part number")
+	}
+
+	dataNoHeader := make([]byte, len(data)-headerLength)
+
+	copy(dataNoHeader, data[headerLength:])
+
+	return dataNoHeader, nil
+}
+
+// BuildWitnessEnvelope returns the tapscript which commits to the given public key
+// and carries the data in an envelope
+func BuildWitnessEnvelope(xOnlyPubKey []byte, data []byte) ([]byte, error) {
+	if len(xOnlyPubKey) != 32 {
+		return nil, errors.New("public key should have 32 bytes")
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("envelope should carry some data")
+	}
+
+	builder := txscript.NewScriptBuilder().
+		AddData(xOnlyPubKey).
+		AddOp(txscript.OP_CHECKSIG).
+		AddOp(txscript.OP_FALSE).
+		AddOp(txscript.OP_IF)
+
+	for start := 0; start < len(data); start += maxEnvelopePushLength {
+		end := start + maxEnvelopePushLength
+		if end > len(data) {
+			end = len(data)
+		}
+		builder.AddFullData(data[start:end])
+	}
+
+	return builder.AddOp(txscript.OP_ENDIF).Script()
+}
+
+// ExtractWitnessEnvelope returns the concatenated data pushes of the first envelope
+// of the tapscript, or nil if the script does not contain a well formed envelope
+func ExtractWitnessEnvelope(script []byte) []byte {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	// look for the OP_FALSE OP_IF sequence starting the envelope
+	previousOp := byte(txscript.OP_INVALIDOPCODE)
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if previousOp == txscript.OP_FALSE && op == txscript.OP_IF {
+			break
+		}
+		previousOp = op
+	}
+
+	if tokenizer.Done() {
+		return nil
+	}
+
+	var data []byte
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+
+		if op == txscript.OP_ENDIF {
+			return data
+		}
+
+		// only data pushes are allowed in the envelope
+		if op > txscript.OP_PUSHDATA4 {
+			return nil
+		}
+
+		data = append(data, tokenizer.Data()...)
+	}
+
+	// either the script is malformed or the envelope is not closed
+	return nil
+}
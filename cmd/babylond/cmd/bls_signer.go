@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	"github.com/babylonchain/babylon/blssigner"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagMaxRetries    = "max-retries"
+	flagRetryInterval = "retry-interval"
+	flagRecordFile    = "record-file"
+)
+
+// BlsSignerCmd returns the cobra Command of the off-chain service submitting the BLS
+// signatures of a validator over the checkpoints of the epochs.
+func BlsSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-signer",
+		Short: "Sign the checkpoints of Babylon with the BLS key of the validator",
+		Long: `Sign the checkpoints of Babylon with the BLS key of the validator.
+The signer subscribes to the checkpoints starting to accumulate BLS signatures, through the
+websocket of the node given with --node, and polls them every --poll-interval in case an event
+is missed. If the validator is in the validator set of the epoch, the LastCommitHash of the
+checkpoint is signed with the BLS key of the priv_validator_key.json file of the node home, and
+submitted by the account given with --from, which needs to be the account of the validator.
+Failed submissions are retried up to --max-retries times, with the fees and gas given by the
+transaction flags. As long as the bitmap of the checkpoint does not include the signature of the
+validator, the signature is submitted again upon each poll.
+The signed LastCommitHash values are persisted in the record file, so that a different one is
+never signed for the same epoch, which would be slashed as a BLS double sign.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			nodeCfg := serverCtx.Config
+			nodeCfg.SetRoot(clientCtx.HomeDir)
+
+			keyFile := nodeCfg.PrivValidatorKeyFile()
+			if !tmos.FileExists(keyFile) {
+				return fmt.Errorf("priv validator key file %s does not exist", keyFile)
+			}
+			wrappedPV := privval.LoadWrappedFilePVEmptyState(keyFile, nodeCfg.PrivValidatorStateFile())
+			if !wrappedPV.GetAddress().Equals(sdk.ValAddress(clientCtx.GetFromAddress())) {
+				return fmt.Errorf("the account %s does not match the validator %s of the priv validator key",
+					clientCtx.GetFromAddress(), wrappedPV.GetAddress())
+			}
+
+			cfg := blssigner.DefaultConfig()
+			if cfg.PollInterval, err = cmd.Flags().GetDuration(flagPollInterval); err != nil {
+				return err
+			}
+			if cfg.MaxRetries, err = cmd.Flags().GetInt(flagMaxRetries); err != nil {
+				return err
+			}
+			if cfg.RetryInterval, err = cmd.Flags().GetDuration(flagRetryInterval); err != nil {
+				return err
+			}
+
+			recordFile, err := cmd.Flags().GetString(flagRecordFile)
+			if err != nil {
+				return err
+			}
+			if recordFile == "" {
+				recordFile = filepath.Join(nodeCfg.DBDir(), blssigner.DefaultRecordFile)
+			}
+			record, err := blssigner.NewRecord(recordFile)
+			if err != nil {
+				return err
+			}
+
+			bbnClient := blssigner.NewCosmosClient(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()))
+
+			signer, err := blssigner.NewSigner(cfg, bbnClient, wrappedPV, record, serverCtx.Logger)
+			if err != nil {
+				return err
+			}
+
+			return runUntilInterrupted(signer.Start)
+		},
+	}
+
+	cmd.Flags().Duration(flagPollInterval, blssigner.DefaultPollInterval, "Time between two polls of the accumulating checkpoints")
+	cmd.Flags().Int(flagMaxRetries, blssigner.DefaultMaxRetries, "Number of times a failed submission of a BLS signature is retried")
+	cmd.Flags().Duration(flagRetryInterval, blssigner.DefaultRetryInterval, "Time between two submissions of the same BLS signature")
+	cmd.Flags().String(flagRecordFile, "", "File recording the signed checkpoints (default <home>/data/"+blssigner.DefaultRecordFile+")")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
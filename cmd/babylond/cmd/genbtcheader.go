@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	bbn "github.com/babylonchain/babylon/types"
+	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagBaseBtcHeaderWork = "work"
+	flagBtcNetworkConfig  = "btc-config.network"
+)
+
+// SetBaseBTCHeaderCmd returns set-base-btc-header cobra Command.
+func SetBaseBTCHeaderCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-base-btc-header [header_hex] [height]",
+		Short: "Set the base BTC header of the btclightclient module in genesis.json",
+		Long: `Set the base BTC header of the btclightclient module in genesis.json.
+The base BTC header is the header from which the BTC light client starts following the BTC chain.
+It is provided as the hex of its bytes along with its height. The cumulative work of the header
+can be optionally provided, otherwise the work of the header itself is used.
+The header is validated against the bitcoin network configured through the btc-config.network option of app.toml.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			header, err := bbn.NewBTCHeaderBytesFromHex(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse the base BTC header: %w", err)
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse the base BTC header height: %w", err)
+			}
+
+			work := btclightclienttypes.CalcWork(&header)
+			workStr, _ := cmd.Flags().GetString(flagBaseBtcHeaderWork)
+			if workStr != "" {
+				work, err = sdk.ParseUint(workStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse the base BTC header work: %w", err)
+				}
+			}
+
+			btcParams, err := bbn.GetBtcNetParams(bbn.SupportedBtcNetwork(serverCtx.Viper.GetString(flagBtcNetworkConfig)))
+			if err != nil {
+				return fmt.Errorf("failed to get the configured bitcoin network: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var btclightclientGenState btclightclienttypes.GenesisState
+			clientCtx.Codec.MustUnmarshalJSON(appState[btclightclienttypes.ModuleName], &btclightclientGenState)
+			btclightclientGenState.BaseBtcHeader = *btclightclienttypes.NewBTCHeaderInfo(&header, header.Hash(), height, &work)
+
+			if err := btclightclientGenState.ValidateBaseBTCHeader(btcParams); err != nil {
+				return fmt.Errorf("invalid base BTC header: %w", err)
+			}
+
+			btclightclientGenStateBz, err := clientCtx.Codec.MarshalJSON(&btclightclientGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal btclightclient genesis state: %w", err)
+			}
+
+			appState[btclightclienttypes.ModuleName] = btclightclientGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagBaseBtcHeaderWork, "", "Cumulative work of the base BTC header. Defaults to the work of the header itself")
+
+	return cmd
+}
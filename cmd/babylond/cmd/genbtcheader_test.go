@@ -0,0 +1,137 @@
+package cmd_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/babylonchain/babylon/app"
+	bbncmd "github.com/babylonchain/babylon/cmd/babylond/cmd"
+	bbn "github.com/babylonchain/babylon/types"
+	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestSetBaseBTCHeaderCmd(t *testing.T) {
+	simnetGenesisHex := "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a45068653ffff7f2002000000"
+	mainnetHex := bbn.GetBaseBTCHeaderHex()
+	mainnetHeight := fmt.Sprint(bbn.GetBaseBTCHeaderHeight())
+
+	tests := []struct {
+		name      string
+		network   bbn.SupportedBtcNetwork
+		headerHex string
+		height    string
+		work      string
+		expectErr bool
+	}{
+		{
+			name:      "genesis block of the network",
+			network:   bbn.BtcSimnet,
+			headerHex: simnetGenesisHex,
+			height:    "0",
+			expectErr: false,
+		},
+		{
+			name:      "header with cumulative work",
+			network:   bbn.BtcMainnet,
+			headerHex: mainnetHex,
+			height:    mainnetHeight,
+			work:      "1000000000000000000000000000000",
+			expectErr: false,
+		},
+		{
+			name:      "invalid header hex",
+			network:   bbn.BtcSimnet,
+			headerHex: "00",
+			height:    "0",
+			expectErr: true,
+		},
+		{
+			name:      "invalid height",
+			network:   bbn.BtcSimnet,
+			headerHex: simnetGenesisHex,
+			height:    "-1",
+			expectErr: true,
+		},
+		{
+			name:      "cumulative work less than the header work",
+			network:   bbn.BtcMainnet,
+			headerHex: mainnetHex,
+			height:    mainnetHeight,
+			work:      "1",
+			expectErr: true,
+		},
+		{
+			name:      "not the genesis block of the network",
+			network:   bbn.BtcMainnet,
+			headerHex: mainnetHex,
+			height:    "0",
+			expectErr: true,
+		},
+		{
+			name:      "not enough proof of work for the network",
+			network:   bbn.BtcMainnet,
+			headerHex: simnetGenesisHex,
+			height:    "1",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			logger := log.NewNopLogger()
+			cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+			require.NoError(t, err)
+
+			appCodec := app.MakeTestEncodingConfig().Marshaler
+			err = genutiltest.ExecInitCmd(app.ModuleBasics, home, appCodec)
+			require.NoError(t, err)
+
+			v := viper.New()
+			v.Set("btc-config.network", string(tc.network))
+			serverCtx := server.NewContext(v, cfg, logger)
+			clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+			cmd := bbncmd.SetBaseBTCHeaderCmd(home)
+			args := []string{
+				tc.headerHex,
+				tc.height,
+				fmt.Sprintf("--%s=home", flags.FlagHome)}
+			if tc.work != "" {
+				args = append(args, fmt.Sprintf("--work=%s", tc.work))
+			}
+			cmd.SetArgs(args)
+
+			if tc.expectErr {
+				require.Error(t, cmd.ExecuteContext(ctx))
+				return
+			}
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+			require.NoError(t, err)
+			var genState btclightclienttypes.GenesisState
+			appCodec.MustUnmarshalJSON(appState[btclightclienttypes.ModuleName], &genState)
+			require.Equal(t, tc.headerHex, genState.BaseBtcHeader.Header.MarshalHex())
+			require.Equal(t, tc.height, fmt.Sprint(genState.BaseBtcHeader.Height))
+			if tc.work != "" {
+				require.Equal(t, tc.work, genState.BaseBtcHeader.Work.String())
+			}
+		})
+	}
+}
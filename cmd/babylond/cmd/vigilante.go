@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/vigilante"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagBtcRPCEndpoint    = "btc-rpc-endpoint"
+	flagBtcRPCUser        = "btc-rpc-user"
+	flagBtcRPCPass        = "btc-rpc-pass"
+	flagBtcRPCTimeout     = "btc-rpc-timeout"
+	flagPollInterval      = "poll-interval"
+	flagConfirmationDepth = "confirmation-depth"
+	flagFormatVersion     = "format-version"
+	flagMaxHeadersInMsg   = "max-headers-in-msg"
+	flagPartsMaxAge       = "parts-max-age"
+	flagResubmitPolls     = "resubmit-polls"
+
+	defaultBtcRPCEndpoint = "http://127.0.0.1:18443"
+	defaultBtcRPCTimeout  = 30 * time.Second
+)
+
+// VigilanteCmd returns the vigilante cobra Command, grouping the off-chain programs
+// relaying data between Babylon and BTC.
+func VigilanteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "vigilante",
+		Short:                      "Vigilante programs relaying data between Babylon and BTC",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		vigilanteSubmitterCmd(),
+		vigilanteReporterCmd(),
+	)
+
+	return cmd
+}
+
+func vigilanteSubmitterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submitter",
+		Short: "Submit the sealed checkpoints of Babylon to BTC",
+		Long: `Submit the sealed checkpoints of Babylon to BTC.
+The submitter polls Babylon for sealed checkpoints and encodes each of them in OP_RETURN
+transactions, which are funded, signed and broadcast by the wallet of a bitcoind compatible
+JSON-RPC server. Once all transactions of a checkpoint are confirmed, their proofs of inclusion
+are submitted back to Babylon by the account given with --from. That account is also encoded
+in the checkpoint as its submitter. If the checkpoint is still sealed --resubmit-polls polls later,
+e.g. because the transaction failed once included in a block, the proofs are submitted again.
+The headers of the blocks including the transactions need to be reported to Babylon, e.g. by the
+reporter, for the proofs to be accepted. Using --broadcast-mode block is recommended, so that consecutive
+transactions use the right account sequence.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+
+			btcCfg, err := btcRPCConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			btcClient, err := vigilante.NewRPCClient(btcCfg)
+			if err != nil {
+				return err
+			}
+
+			cfg := vigilante.DefaultSubmitterConfig(bbn.GetGlobalCheckPointTag())
+			if cfg.PollInterval, err = cmd.Flags().GetDuration(flagPollInterval); err != nil {
+				return err
+			}
+			if cfg.ConfirmationDepth, err = cmd.Flags().GetInt64(flagConfirmationDepth); err != nil {
+				return err
+			}
+			if cfg.ResubmitPolls, err = cmd.Flags().GetInt(flagResubmitPolls); err != nil {
+				return err
+			}
+			version, err := cmd.Flags().GetUint8(flagFormatVersion)
+			if err != nil {
+				return err
+			}
+			cfg.FormatVersion = txformat.FormatVersion(version)
+
+			bbnClient := vigilante.NewCosmosClient(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()))
+
+			submitter, err := vigilante.NewSubmitter(cfg, btcClient, bbnClient, server.GetServerContextFromCmd(cmd).Logger)
+			if err != nil {
+				return err
+			}
+
+			return runUntilInterrupted(submitter.Start)
+		},
+	}
+
+	addBtcRPCFlags(cmd)
+	cmd.Flags().Duration(flagPollInterval, vigilante.DefaultPollInterval, "Time between two polls of Babylon and BTC")
+	cmd.Flags().Int64(flagConfirmationDepth, vigilante.DefaultSubmitterConfirmationDepth, "Number of confirmations of the BTC transactions before their proofs are submitted")
+	cmd.Flags().Uint8(flagFormatVersion, uint8(txformat.CurrentVersion), "Format version in which checkpoints are encoded")
+	cmd.Flags().Int(flagResubmitPolls, vigilante.DefaultResubmitPolls, "Number of polls after which the proofs of a checkpoint still sealed are submitted again")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func vigilanteReporterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reporter",
+		Short: "Report the BTC headers and checkpoints to Babylon",
+		Long: `Report the BTC headers and checkpoints to Babylon.
+The reporter polls the best chain of a bitcoind compatible JSON-RPC server and reports the
+headers missing in the BTC light client of Babylon. If Babylon follows a fork, the common ancestor
+is found by walking back the best chain until a block known to Babylon, and the headers of the
+best chain are reported from there.
+The new blocks are scanned for transactions carrying the checkpoints of Babylon in their OP_RETURN
+outputs. Once all parts of a checkpoint are found, possibly in different blocks, their proofs of
+inclusion are submitted to Babylon by the account given with --from. Parts waiting for the remaining
+ones longer than --parts-max-age blocks are forgotten.
+Using --broadcast-mode block is recommended, so that consecutive transactions use the right
+account sequence.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+
+			btcCfg, err := btcRPCConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			btcClient, err := vigilante.NewRPCClient(btcCfg)
+			if err != nil {
+				return err
+			}
+
+			cfg := vigilante.DefaultReporterConfig(bbn.GetGlobalCheckPointTag())
+			if cfg.PollInterval, err = cmd.Flags().GetDuration(flagPollInterval); err != nil {
+				return err
+			}
+			if cfg.MaxHeadersInMsg, err = cmd.Flags().GetInt(flagMaxHeadersInMsg); err != nil {
+				return err
+			}
+			if cfg.PartsMaxAge, err = cmd.Flags().GetInt64(flagPartsMaxAge); err != nil {
+				return err
+			}
+
+			bbnClient := vigilante.NewCosmosClient(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()))
+
+			reporter, err := vigilante.NewReporter(cfg, btcClient, bbnClient, server.GetServerContextFromCmd(cmd).Logger)
+			if err != nil {
+				return err
+			}
+
+			return runUntilInterrupted(reporter.Start)
+		},
+	}
+
+	addBtcRPCFlags(cmd)
+	cmd.Flags().Duration(flagPollInterval, vigilante.DefaultPollInterval, "Time between two polls of BTC and Babylon")
+	cmd.Flags().Int(flagMaxHeadersInMsg, vigilante.DefaultMaxHeadersInMsg, "Maximum number of headers reported in one transaction")
+	cmd.Flags().Int64(flagPartsMaxAge, vigilante.DefaultPartsMaxAge, "Number of blocks during which a checkpoint part waits for the remaining parts")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addBtcRPCFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagBtcRPCEndpoint, defaultBtcRPCEndpoint, "URL of the bitcoind JSON-RPC server, including the wallet path if needed")
+	cmd.Flags().String(flagBtcRPCUser, "", "User of the bitcoind JSON-RPC server")
+	cmd.Flags().String(flagBtcRPCPass, "", "Password of the bitcoind JSON-RPC server")
+	cmd.Flags().Duration(flagBtcRPCTimeout, defaultBtcRPCTimeout, "Timeout of the requests to the bitcoind JSON-RPC server")
+}
+
+func btcRPCConfigFromFlags(cmd *cobra.Command) (vigilante.BTCRPCConfig, error) {
+	var cfg vigilante.BTCRPCConfig
+	var err error
+
+	if cfg.Endpoint, err = cmd.Flags().GetString(flagBtcRPCEndpoint); err != nil {
+		return cfg, err
+	}
+	if cfg.User, err = cmd.Flags().GetString(flagBtcRPCUser); err != nil {
+		return cfg, err
+	}
+	if cfg.Pass, err = cmd.Flags().GetString(flagBtcRPCPass); err != nil {
+		return cfg, err
+	}
+	if cfg.Timeout, err = cmd.Flags().GetDuration(flagBtcRPCTimeout); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// runUntilInterrupted runs the function until the process receives an interrupt signal
+func runUntilInterrupted(run func(ctx context.Context) error) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx); err != nil && err != context.Canceled {
+		return err
+	}
+
+	return nil
+}
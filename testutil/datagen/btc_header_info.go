@@ -132,6 +132,26 @@ func GenRandomBTCHeaderInfoWithParentAndBits(parent *btclightclienttypes.BTCHead
 	}
 }
 
+// GenRandomBTCHeaderInfoWithParentBitsAndTime generates a BTCHeaderInfo object that extends `parent`
+// and has the provided difficulty `bits` and `timestamp`.
+func GenRandomBTCHeaderInfoWithParentBitsAndTime(parent *btclightclienttypes.BTCHeaderInfo, bits uint32, timestamp time.Time) *btclightclienttypes.BTCHeaderInfo {
+	btcdHeader := GenRandomBtcdHeader()
+	btcdHeader.PrevBlock = *parent.Hash.ToChainhash()
+	btcdHeader.Bits = bits
+	btcdHeader.Timestamp = timestamp
+	header := bbn.NewBTCHeaderBytesFromBlockHeader(btcdHeader)
+
+	headerWork := btclightclienttypes.CalcWork(&header)
+	accumulatedWork := btclightclienttypes.CumulativeWork(headerWork, *parent.Work)
+
+	return &btclightclienttypes.BTCHeaderInfo{
+		Header: &header,
+		Hash:   header.Hash(),
+		Height: parent.Height + 1,
+		Work:   &accumulatedWork,
+	}
+}
+
 // GenRandomBTCHeaderInfoWithParent generates a random BTCHeaderInfo object
 // in which the parent points to the `parent` parameter.
 func GenRandomBTCHeaderInfoWithParent(parent *btclightclienttypes.BTCHeaderInfo) *btclightclienttypes.BTCHeaderInfo {
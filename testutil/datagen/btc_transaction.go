@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"runtime"
 
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
@@ -25,6 +26,9 @@ var (
 	tranasctionVersion = 1
 
 	lowFee = btcutil.Amount(1)
+
+	// leaf version of tapscript as defined in BIP342
+	tapscriptLeafVersion = byte(0xc0)
 )
 
 // standardCoinbaseScript returns a standard script suitable for use as the
@@ -271,3 +275,149 @@ func CreateBlock(
 
 	return &res
 }
+
+// addWitnessCommitment adds the witness nonce and the witness commitment output to
+// the coinbase transaction, as defined in BIP141
+func addWitnessCommitment(coinbase *wire.MsgTx, transactions []*wire.MsgTx) {
+	nonce := GenRandomByteArray(blockchain.CoinbaseWitnessDataLen)
+	coinbase.TxIn[0].Witness = wire.TxWitness{nonce}
+
+	utilTxns := make([]*btcutil.Tx, 0, len(transactions))
+	for _, tx := range transactions {
+		utilTxns = append(utilTxns, btcutil.NewTx(tx))
+	}
+	// wtxid of the coinbase is replaced by zero hash when building the witness tree
+	merkles := blockchain.BuildMerkleTreeStore(utilTxns, true)
+	witnessRoot := merkles[len(merkles)-1]
+
+	var preimage []byte
+	preimage = append(preimage, witnessRoot[:]...)
+	preimage = append(preimage, nonce...)
+	commitment := chainhash.DoubleHashB(preimage)
+
+	var pkScript []byte
+	pkScript = append(pkScript, blockchain.WitnessMagicBytes...)
+	pkScript = append(pkScript, commitment...)
+	coinbase.AddTxOut(wire.NewTxOut(0, pkScript))
+}
+
+// createSpendWitnessEnvelopeTx creates a transaction whose only input looks like a
+// taproot script path spend of a tapscript carrying provided data in an envelope
+func createSpendWitnessEnvelopeTx(spend *spendableOut, fee btcutil.Amount, data []byte) *wire.MsgTx {
+	script, err := txformat.BuildWitnessEnvelope(GenRandomByteArray(32), data)
+	if err != nil {
+		panic(err)
+	}
+
+	// control block with leaf version and internal key, without any merkle path
+	controlBlock := append([]byte{tapscriptLeafVersion}, GenRandomByteArray(32)...)
+
+	spendTx := wire.NewMsgTx(int32(tranasctionVersion))
+	spendTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: spend.prevOut,
+		Sequence:         wire.MaxTxInSequenceNum,
+		SignatureScript:  nil,
+		Witness:          wire.TxWitness{GenRandomByteArray(64), script, controlBlock},
+	})
+	spendTx.AddTxOut(wire.NewTxOut(int64(spend.amount-fee),
+		opTrueScript))
+
+	return spendTx
+}
+
+// createSpendSegwitOpReturnTx creates an OP_RETURN transaction with witness data,
+// so that its wtxid differs from its txid
+func createSpendSegwitOpReturnTx(spend *spendableOut, fee btcutil.Amount, data []byte) *wire.MsgTx {
+	spendTx := createSpendOpReturnTx(spend, fee, data)
+	// witness of a pay to witness public key hash spend
+	spendTx.TxIn[0].Witness = wire.TxWitness{GenRandomByteArray(71), GenRandomByteArray(33)}
+	return spendTx
+}
+
+// CreateWitnessBlock creates a block with a witness commitment, in which the
+// transaction with index babylonTxIdx carries babylonData in its witness envelope
+func CreateWitnessBlock(
+	height uint32,
+	numTx uint32,
+	babylonTxIdx uint32,
+	babylonData []byte,
+) *BlockCreationResult {
+	return createBlockWithWitnessCommitment(height, numTx, babylonTxIdx, babylonData, createSpendWitnessEnvelopeTx)
+}
+
+// CreateSegwitOpReturnBlock creates a block with a witness commitment, in which the
+// transaction with index babylonTxIdx is a segwit transaction carrying babylonData
+// in its OP_RETURN output
+func CreateSegwitOpReturnBlock(
+	height uint32,
+	numTx uint32,
+	babylonTxIdx uint32,
+	babylonData []byte,
+) *BlockCreationResult {
+	return createBlockWithWitnessCommitment(height, numTx, babylonTxIdx, babylonData, createSpendSegwitOpReturnTx)
+}
+
+func createBlockWithWitnessCommitment(
+	height uint32,
+	numTx uint32,
+	babylonTxIdx uint32,
+	babylonData []byte,
+	createBabylonTx func(spend *spendableOut, fee btcutil.Amount, data []byte) *wire.MsgTx,
+) *BlockCreationResult {
+
+	if babylonTxIdx == 0 || babylonTxIdx > numTx {
+		panic("babylon tx index should be less than number of transasactions and greater than 0")
+	}
+
+	coinbase := createCoinbaseTx(int32(height), &chaincfg.SimNetParams)
+	transactions := []*wire.MsgTx{coinbase}
+
+	for i := uint32(1); i <= numTx; i++ {
+		out := makeSpendableOutWithRandOutPoint(1000)
+		if i == babylonTxIdx {
+			transactions = append(transactions, createBabylonTx(&out, lowFee, babylonData))
+		} else {
+			transactions = append(transactions, createSpendTx(&out, lowFee))
+		}
+	}
+
+	addWitnessCommitment(coinbase, transactions)
+
+	btcHeader := GenRandomBtcdHeader()
+
+	// setting SimNetParams so that block can be easily solved
+	btcHeader.Bits = chaincfg.SimNetParams.GenesisBlock.Header.Bits
+	btcHeader.MerkleRoot = calcMerkleRoot(transactions)
+
+	if !SolveBlock(btcHeader) {
+		panic("Should solve block")
+	}
+
+	var hexTx []string
+	for _, tx := range transactions {
+		buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
+		_ = tx.Serialize(buf)
+		hexTx = append(hexTx, hex.EncodeToString(buf.Bytes()))
+	}
+
+	return &BlockCreationResult{
+		HeaderBytes:  bbn.NewBTCHeaderBytesFromBlockHeader(btcHeader),
+		Transactions: hexTx,
+		BbnTxIndex:   babylonTxIdx,
+	}
+}
+
+// ChainBlocks makes each of the blocks extend the preceding one and solves their
+// headers again, so that the blocks form a valid header chain
+func ChainBlocks(blocks []*BlockCreationResult) {
+	for i := 1; i < len(blocks); i++ {
+		header := blocks[i].HeaderBytes.ToBlockHeader()
+		header.PrevBlock = *blocks[i-1].HeaderBytes.Hash().ToChainhash()
+
+		if !SolveBlock(header) {
+			panic("Should solve block")
+		}
+
+		blocks[i].HeaderBytes = bbn.NewBTCHeaderBytesFromBlockHeader(header)
+	}
+}
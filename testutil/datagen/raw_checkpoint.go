@@ -1,9 +1,12 @@
 package datagen
 
 import (
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	"github.com/boljen/go-bitmap"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"math/rand"
 )
 
@@ -22,7 +25,7 @@ func GenRandomRawCheckpoint() *types.RawCheckpoint {
 	return &types.RawCheckpoint{
 		EpochNum:       GenRandomEpochNum(),
 		LastCommitHash: &randomHashBytes,
-		Bitmap:         bitmap.New(13),
+		Bitmap:         bitmap.New(8 * txformat.BitMapLength),
 		BlsMultiSig:    &randomBLSSig,
 	}
 }
@@ -69,3 +72,71 @@ func GenRandomBlsMultiSig() bls12381.Signature {
 func GenRandomStatus() types.CheckpointStatus {
 	return types.CheckpointStatus(rand.Int31n(int32(len(types.CheckpointStatus_name) - 1)))
 }
+
+// GenSignedRawCheckpoint generates a checkpoint of the epoch along with a set of
+// numVals validators of equal voting power and their BLS keys. The checkpoint is
+// signed by the first numSigners validators.
+func GenSignedRawCheckpoint(epoch uint64, numVals int, numSigners int) (*types.RawCheckpoint, *types.ValidatorWithBlsKeySet) {
+	ckpt, valSet, _ := GenSignedRawCheckpointWithKeys(epoch, numVals, numSigners)
+	return ckpt, valSet
+}
+
+// GenSignedRawCheckpointWithKeys is GenSignedRawCheckpoint also returning the BLS
+// private keys of the validators, so that further checkpoints can be signed by them
+func GenSignedRawCheckpointWithKeys(epoch uint64, numVals int, numSigners int) (*types.RawCheckpoint, *types.ValidatorWithBlsKeySet, []bls12381.PrivateKey) {
+	valSet := &types.ValidatorWithBlsKeySet{}
+	sks := make([]bls12381.PrivateKey, numVals)
+	signers := make([]int, 0, numSigners)
+
+	for i := 0; i < numVals; i++ {
+		sk, pk := bls12381.GenKeyPair()
+		sks[i] = sk
+		valSet.ValSet = append(valSet.ValSet, &types.ValidatorWithBlsKey{
+			ValidatorAddress: sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			BlsPubKey:        pk.Bytes(),
+			VotingPower:      10,
+		})
+
+		if i < numSigners {
+			signers = append(signers, i)
+		}
+	}
+
+	return SignRawCheckpoint(epoch, GenRandomLastCommitHash(), sks, signers), valSet, sks
+}
+
+// SignRawCheckpoint generates the checkpoint of the epoch committing to the given
+// LastCommitHash, signed by the validators of the given indices among the keys
+func SignRawCheckpoint(epoch uint64, lch types.LastCommitHash, sks []bls12381.PrivateKey, signers []int) *types.RawCheckpoint {
+	bm := bitmap.New(8 * types.BitmapLength(len(sks)))
+	sigs := make([]bls12381.Signature, 0, len(signers))
+
+	for _, i := range signers {
+		bm.Set(i, true)
+		sigs = append(sigs, bls12381.Sign(sks[i], lch.MustMarshal()))
+	}
+
+	sig, err := bls12381.AggrSigList(sigs)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.RawCheckpoint{
+		EpochNum:       epoch,
+		LastCommitHash: &lch,
+		Bitmap:         bm,
+		BlsMultiSig:    &sig,
+	}
+}
+
+// GenBlsDoubleSignEvidence generates the evidence of the given validator BLS-signing
+// two random LastCommitHash values of the given epoch, whose last block is at the given height
+func GenBlsDoubleSignEvidence(epoch uint64, height int64, valAddr sdk.ValAddress, sk bls12381.PrivateKey) *types.BlsDoubleSignEvidence {
+	var sigs []*types.BlsSig
+	for i := 0; i < 2; i++ {
+		lch := GenRandomLastCommitHash()
+		sig := bls12381.Sign(sk, lch.MustMarshal())
+		sigs = append(sigs, types.NewMsgAddBlsSig(epoch, lch, sig, valAddr).BlsSig)
+	}
+	return types.NewBlsDoubleSignEvidence(sigs[0], sigs[1], height)
+}
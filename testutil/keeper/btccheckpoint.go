@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,6 +26,15 @@ func NewBTCCheckpointKeeper(
 	lk btcctypes.BTCLightClientKeeper,
 	ek btcctypes.CheckpointingKeeper,
 	powLimit *big.Int) (*keeper.Keeper, sdk.Context) {
+	return NewBTCCheckpointKeeperWithBank(t, lk, ek, btcctypes.NewMockBankKeeper(), powLimit)
+}
+
+func NewBTCCheckpointKeeperWithBank(
+	t testing.TB,
+	lk btcctypes.BTCLightClientKeeper,
+	ek btcctypes.CheckpointingKeeper,
+	bk btcctypes.BankKeeper,
+	powLimit *big.Int) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(btcctypes.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(btcctypes.MemStoreKey)
 
@@ -51,6 +61,8 @@ func NewBTCCheckpointKeeper(
 		paramsSubspace,
 		lk,
 		ek,
+		bk,
+		authtypes.FeeCollectorName,
 		powLimit,
 		// use MainTag tests
 		txformat.MainTag(),
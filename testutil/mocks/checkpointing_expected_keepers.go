@@ -6,10 +6,12 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	types "github.com/babylonchain/babylon/x/epoching/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -136,6 +138,21 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
 }
 
+// GetHistoricalEpoch mocks base method.
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx types0.Context, epochNumber uint64) (*types.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
+	ret0, _ := ret[0].(*types.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalEpoch indicates an expected call of GetHistoricalEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
+}
+
 // GetTotalVotingPower mocks base method.
 func (m *MockEpochingKeeper) GetTotalVotingPower(ctx types0.Context, epochNumber uint64) int64 {
 	m.ctrl.T.Helper()
@@ -164,6 +181,193 @@ func (mr *MockEpochingKeeperMockRecorder) GetValidatorSet(ctx, epochNumer interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSet", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidatorSet), ctx, epochNumer)
 }
 
+// MockBtcCheckpointKeeper is a mock of BtcCheckpointKeeper interface.
+type MockBtcCheckpointKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBtcCheckpointKeeperMockRecorder
+}
+
+// MockBtcCheckpointKeeperMockRecorder is the mock recorder for MockBtcCheckpointKeeper.
+type MockBtcCheckpointKeeperMockRecorder struct {
+	mock *MockBtcCheckpointKeeper
+}
+
+// NewMockBtcCheckpointKeeper creates a new mock instance.
+func NewMockBtcCheckpointKeeper(ctrl *gomock.Controller) *MockBtcCheckpointKeeper {
+	mock := &MockBtcCheckpointKeeper{ctrl: ctrl}
+	mock.recorder = &MockBtcCheckpointKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBtcCheckpointKeeper) EXPECT() *MockBtcCheckpointKeeperMockRecorder {
+	return m.recorder
+}
+
+// MaxBitMapLength mocks base method.
+func (m *MockBtcCheckpointKeeper) MaxBitMapLength(ctx types0.Context) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxBitMapLength", ctx)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxBitMapLength indicates an expected call of MaxBitMapLength.
+func (mr *MockBtcCheckpointKeeperMockRecorder) MaxBitMapLength(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxBitMapLength", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).MaxBitMapLength), ctx)
+}
+
+// MockStakingKeeper is a mock of StakingKeeper interface.
+type MockStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingKeeperMockRecorder
+}
+
+// MockStakingKeeperMockRecorder is the mock recorder for MockStakingKeeper.
+type MockStakingKeeperMockRecorder struct {
+	mock *MockStakingKeeper
+}
+
+// NewMockStakingKeeper creates a new mock instance.
+func NewMockStakingKeeper(ctrl *gomock.Controller) *MockStakingKeeper {
+	mock := &MockStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// Validator mocks base method.
+func (m *MockStakingKeeper) Validator(ctx types0.Context, address types0.ValAddress) types2.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", ctx, address)
+	ret0, _ := ret[0].(types2.ValidatorI)
+	return ret0
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockStakingKeeperMockRecorder) Validator(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), ctx, address)
+}
+
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// HasValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) HasValidatorSigningInfo(ctx types0.Context, consAddr types0.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasValidatorSigningInfo indicates an expected call of HasValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) HasValidatorSigningInfo(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).HasValidatorSigningInfo), ctx, consAddr)
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlashingKeeper) IsTombstoned(ctx types0.Context, consAddr types0.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlashingKeeperMockRecorder) IsTombstoned(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlashingKeeper)(nil).IsTombstoned), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx types0.Context, consAddr types0.ConsAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Jail", ctx, consAddr)
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx types0.Context, consAddr types0.ConsAddress, jailTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// Slash mocks base method.
+func (m *MockSlashingKeeper) Slash(ctx types0.Context, consAddr types0.ConsAddress, fraction types0.Dec, power, distributionHeight int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Slash", ctx, consAddr, fraction, power, distributionHeight)
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockSlashingKeeperMockRecorder) Slash(ctx, consAddr, fraction, power, distributionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), ctx, consAddr, fraction, power, distributionHeight)
+}
+
+// SlashFractionDoubleSign mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSign(ctx types0.Context) types0.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", ctx)
+	ret0, _ := ret[0].(types0.Dec)
+	return ret0
+}
+
+// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), ctx)
+}
+
+// Tombstone mocks base method.
+func (m *MockSlashingKeeper) Tombstone(ctx types0.Context, consAddr types0.ConsAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tombstone", ctx, consAddr)
+}
+
+// Tombstone indicates an expected call of Tombstone.
+func (mr *MockSlashingKeeperMockRecorder) Tombstone(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlashingKeeper)(nil).Tombstone), ctx, consAddr)
+}
+
 // MockCheckpointingHooks is a mock of CheckpointingHooks interface.
 type MockCheckpointingHooks struct {
 	ctrl     *gomock.Controller
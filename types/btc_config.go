@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 	"sync"
 
@@ -21,7 +22,7 @@ var (
 type SupportedBtcNetwork string
 
 type BtcConfig struct {
-	powLimit      *big.Int
+	btcNetParams  *chaincfg.Params
 	checkPointTag txformat.BabylonTag
 }
 
@@ -29,9 +30,10 @@ const (
 	BtcMainnet SupportedBtcNetwork = "mainnet"
 	BtcTestnet SupportedBtcNetwork = "testnet"
 	BtcSimnet  SupportedBtcNetwork = "simnet"
+	BtcRegtest SupportedBtcNetwork = "regtest"
 )
 
-func parsePowLimit(opts servertypes.AppOptions) *big.Int {
+func parseBtcNetParams(opts servertypes.AppOptions) *chaincfg.Params {
 	valueInterface := opts.Get("btc-config.network")
 
 	if valueInterface == nil {
@@ -44,14 +46,26 @@ func parsePowLimit(opts servertypes.AppOptions) *big.Int {
 		panic("Btcoin netowrk config should be valid string")
 	}
 
-	if network == string(BtcMainnet) {
-		return chaincfg.MainNetParams.PowLimit
-	} else if network == string(BtcTestnet) {
-		return chaincfg.TestNet3Params.PowLimit
-	} else if network == string(BtcSimnet) {
-		return chaincfg.SimNetParams.PowLimit
-	} else {
-		panic("Bicoin network should be one of [mainet, testnet, simnet]")
+	params, err := GetBtcNetParams(SupportedBtcNetwork(network))
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// GetBtcNetParams returns the btcd chain parameters of a supported bitcoin network
+func GetBtcNetParams(network SupportedBtcNetwork) (*chaincfg.Params, error) {
+	switch network {
+	case BtcMainnet:
+		return &chaincfg.MainNetParams, nil
+	case BtcTestnet:
+		return &chaincfg.TestNet3Params, nil
+	case BtcSimnet:
+		return &chaincfg.SimNetParams, nil
+	case BtcRegtest:
+		return &chaincfg.RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("bitcoin network should be one of [mainnet, testnet, simnet, regtest], got %s", network)
 	}
 }
 
@@ -78,10 +92,10 @@ func parseCheckpointTag(opts servertypes.AppOptions) txformat.BabylonTag {
 }
 
 func ParseBtcOptionsFromConfig(opts servertypes.AppOptions) BtcConfig {
-	powLimit := parsePowLimit(opts)
+	netParams := parseBtcNetParams(opts)
 	tag := parseCheckpointTag(opts)
 	return BtcConfig{
-		powLimit:      powLimit,
+		btcNetParams:  netParams,
 		checkPointTag: tag,
 	}
 }
@@ -93,7 +107,12 @@ func InitGlobalBtcConfig(c BtcConfig) {
 }
 
 func (c *BtcConfig) PowLimit() big.Int {
-	return *c.powLimit
+	return *c.btcNetParams.PowLimit
+}
+
+// NetParams returns the chain parameters of the configured bitcoin network
+func (c *BtcConfig) NetParams() *chaincfg.Params {
+	return c.btcNetParams
 }
 
 func (c *BtcConfig) CheckpointTag() txformat.BabylonTag {
@@ -108,6 +127,11 @@ func GetGlobalPowLimit() big.Int {
 	return btcConfig.PowLimit()
 }
 
+// GetGlobalBtcNetParams returns the chain parameters of the globally configured bitcoin network
+func GetGlobalBtcNetParams() *chaincfg.Params {
+	return btcConfig.NetParams()
+}
+
 func GetGlobalCheckPointTag() txformat.BabylonTag {
 	return btcConfig.checkPointTag
 }
@@ -15,7 +15,9 @@ import (
 // Perform the checks that [checkBlockHeaderSanity](https://github.com/btcsuite/btcd/blob/master/blockchain/validate.go#L430) of btcd does
 //
 // We skip the "timestamp should not be 2 hours into the future" check
-// since this might introduce undeterministic behavior
+// since this might introduce undeterministic behavior.
+// Checks that depend on previous headers, such as the median time past
+// of the timestamp, are performed by the light client when the header is inserted.
 func ValidateBTCHeader(header *wire.BlockHeader, powLimit *big.Int) error {
 	msgBlock := &wire.MsgBlock{Header: *header}
 
@@ -0,0 +1,172 @@
+package vigilante
+
+import (
+	"context"
+	"errors"
+
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// BabylonClient is the subset of the Babylon API needed by the vigilantes
+type BabylonClient interface {
+	// GetAddress returns the address of the account sending the transactions
+	GetAddress() sdk.AccAddress
+	// SealedCheckpoints returns the checkpoints which gathered enough BLS signatures
+	// but were not submitted to BTC yet
+	SealedCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error)
+	// InsertBTCSpvProofs submits the proofs of the BTC transactions carrying a checkpoint
+	InsertBTCSpvProofs(ctx context.Context, proofs []*btcctypes.BTCSpvProof) error
+	// BTCTip returns the best header of the BTC light client
+	BTCTip(ctx context.Context) (*btclctypes.BTCHeaderInfo, error)
+	// ContainsBTCHeader returns true if the BTC light client knows the header with given
+	// hash, on any of its forks
+	ContainsBTCHeader(ctx context.Context, hash *chainhash.Hash) (bool, error)
+	// InsertBTCHeaders submits the headers to the BTC light client. Each header needs
+	// to extend the preceding one
+	InsertBTCHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error
+}
+
+// CosmosClient implements BabylonClient on top of the cosmos-sdk client, using
+// gRPC queries and transactions signed by the key configured in the client context
+type CosmosClient struct {
+	clientCtx client.Context
+	txf       tx.Factory
+}
+
+var _ BabylonClient = (*CosmosClient)(nil)
+
+func NewCosmosClient(clientCtx client.Context, txf tx.Factory) *CosmosClient {
+	return &CosmosClient{
+		clientCtx: clientCtx,
+		txf:       txf,
+	}
+}
+
+func (c *CosmosClient) GetAddress() sdk.AccAddress {
+	return c.clientCtx.GetFromAddress()
+}
+
+func (c *CosmosClient) SealedCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error) {
+	queryClient := ckpttypes.NewQueryClient(c.clientCtx)
+
+	var checkpoints []*ckpttypes.RawCheckpointWithMeta
+	var nextKey []byte
+
+	for {
+		res, err := queryClient.RawCheckpointList(ctx, &ckpttypes.QueryRawCheckpointListRequest{
+			Status:     ckpttypes.Sealed,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		checkpoints = append(checkpoints, res.RawCheckpoints...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return checkpoints, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+func (c *CosmosClient) InsertBTCSpvProofs(ctx context.Context, proofs []*btcctypes.BTCSpvProof) error {
+	msg := &btcctypes.MsgInsertBTCSpvProof{
+		Submitter: c.GetAddress().String(),
+		Proofs:    proofs,
+	}
+
+	return c.SendMsg(msg)
+}
+
+func (c *CosmosClient) BTCTip(ctx context.Context) (*btclctypes.BTCHeaderInfo, error) {
+	res, err := btclctypes.NewQueryClient(c.clientCtx).Tip(ctx, &btclctypes.QueryTipRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Header == nil {
+		return nil, errors.New("btc light client returned empty tip")
+	}
+
+	return res.Header, nil
+}
+
+func (c *CosmosClient) ContainsBTCHeader(ctx context.Context, hash *chainhash.Hash) (bool, error) {
+	hashBytes := bbn.NewBTCHeaderHashBytesFromChainhash(hash)
+
+	res, err := btclctypes.NewQueryClient(c.clientCtx).Contains(ctx, &btclctypes.QueryContainsRequest{Hash: &hashBytes})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Contains, nil
+}
+
+func (c *CosmosClient) InsertBTCHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	msg := &btclctypes.MsgInsertHeaders{
+		Signer:  c.GetAddress().String(),
+		Headers: headers,
+	}
+
+	return c.SendMsg(msg)
+}
+
+// SendMsg signs the message with the configured key and broadcasts it, returning
+// an error if the transaction was not accepted. The error of a rejected transaction
+// wraps the registered error of its ABCI code, so that it can be checked with errors.Is
+func (c *CosmosClient) SendMsg(msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	clientCtx := c.clientCtx
+
+	txf, err := c.txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code != 0 {
+		return sdkerrors.Wrapf(sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog), "transaction %s failed", res.TxHash)
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package vigilante
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BTCWallet is the subset of the bitcoind wallet and chain API needed by the
+// vigilantes
+type BTCWallet interface {
+	// SendOpReturnTx funds, signs and broadcasts a transaction carrying data in its
+	// OP_RETURN output. If input is not nil, the transaction spends it, which allows
+	// chaining transactions so that they are included in the chain in order.
+	// It returns the id of the transaction and its change output, if any.
+	SendOpReturnTx(data []byte, input *wire.OutPoint) (*chainhash.Hash, *wire.OutPoint, error)
+	// GetTxConfirmations returns the hash of the block including the transaction
+	// and the number of its confirmations. Block hash is nil for unconfirmed
+	// transactions
+	GetTxConfirmations(txid *chainhash.Hash) (*chainhash.Hash, int64, error)
+	// GetBlock returns the block with given hash
+	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// BTCChain is the subset of the bitcoind chain API needed to follow the BTC chain
+type BTCChain interface {
+	// GetBlockCount returns the height of the best block
+	GetBlockCount() (int64, error)
+	// GetBlockHash returns the hash of the block at given height of the best chain
+	GetBlockHash(height int64) (*chainhash.Hash, error)
+	// GetBlockHeader returns the header of the block with given hash
+	GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error)
+	// GetBlock returns the block with given hash
+	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// BTCRPCConfig is the configuration of the connection to a bitcoind compatible
+// JSON-RPC server
+type BTCRPCConfig struct {
+	// Endpoint is the url of the server, including the wallet path if needed
+	// e.g. http://127.0.0.1:18443/wallet/vigilante
+	Endpoint string
+	User     string
+	Pass     string
+	Timeout  time.Duration
+}
+
+// RPCClient implements BTCWallet and BTCChain on top of bitcoind JSON-RPC API
+type RPCClient struct {
+	cfg        BTCRPCConfig
+	httpClient *http.Client
+	id         uint64
+}
+
+var (
+	_ BTCWallet = (*RPCClient)(nil)
+	_ BTCChain  = (*RPCClient)(nil)
+)
+
+func NewRPCClient(cfg BTCRPCConfig) (*RPCClient, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("bitcoin rpc endpoint should not be empty")
+	}
+
+	return &RPCClient{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+func (c *RPCClient) call(method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "1.0",
+		ID:      atomic.AddUint64(&c.id, 1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.cfg.User, c.cfg.Pass)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp rpcResponse
+	// bitcoind returns errors along with non 200 status codes, try to decode them first
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("%s failed with status %s: %w", method, resp.Status, err)
+	}
+
+	if rpcResp.Error != nil {
+		return fmt.Errorf("%s failed: %w", method, rpcResp.Error)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(rpcResp.Result, result)
+}
+
+func (c *RPCClient) SendOpReturnTx(data []byte, input *wire.OutPoint) (*chainhash.Hash, *wire.OutPoint, error) {
+	inputs := []map[string]interface{}{}
+	if input != nil {
+		inputs = append(inputs, map[string]interface{}{
+			"txid": input.Hash.String(),
+			"vout": input.Index,
+		})
+	}
+	outputs := []map[string]string{{"data": hex.EncodeToString(data)}}
+
+	var rawTx string
+	if err := c.call("createrawtransaction", &rawTx, inputs, outputs); err != nil {
+		return nil, nil, err
+	}
+
+	var funded struct {
+		Hex       string `json:"hex"`
+		ChangePos int64  `json:"changepos"`
+	}
+	if err := c.call("fundrawtransaction", &funded, rawTx); err != nil {
+		return nil, nil, err
+	}
+
+	var signed struct {
+		Hex      string `json:"hex"`
+		Complete bool   `json:"complete"`
+	}
+	if err := c.call("signrawtransactionwithwallet", &signed, funded.Hex); err != nil {
+		return nil, nil, err
+	}
+
+	if !signed.Complete {
+		return nil, nil, errors.New("wallet was not able to sign the transaction")
+	}
+
+	var txidStr string
+	if err := c.call("sendrawtransaction", &txidStr, signed.Hex); err != nil {
+		return nil, nil, err
+	}
+
+	txid, err := chainhash.NewHashFromStr(txidStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if funded.ChangePos < 0 {
+		return txid, nil, nil
+	}
+
+	return txid, wire.NewOutPoint(txid, uint32(funded.ChangePos)), nil
+}
+
+func (c *RPCClient) GetTxConfirmations(txid *chainhash.Hash) (*chainhash.Hash, int64, error) {
+	var tx struct {
+		Confirmations int64  `json:"confirmations"`
+		BlockHash     string `json:"blockhash"`
+	}
+	if err := c.call("gettransaction", &tx, txid.String()); err != nil {
+		return nil, 0, err
+	}
+
+	if tx.BlockHash == "" || tx.Confirmations <= 0 {
+		return nil, tx.Confirmations, nil
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(tx.BlockHash)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return blockHash, tx.Confirmations, nil
+}
+
+func (c *RPCClient) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	var blockHex string
+	// verbosity 0 returns the serialized block
+	if err := c.call("getblock", &blockHex, blockHash.String(), 0); err != nil {
+		return nil, err
+	}
+
+	blockBytes, err := hex.DecodeString(blockHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var block wire.MsgBlock
+	if err := block.Deserialize(bytes.NewReader(blockBytes)); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+func (c *RPCClient) GetBlockCount() (int64, error) {
+	var count int64
+	if err := c.call("getblockcount", &count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (c *RPCClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	var hashStr string
+	if err := c.call("getblockhash", &hashStr, height); err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHashFromStr(hashStr)
+}
+
+func (c *RPCClient) GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+	var headerHex string
+	// verbose false returns the serialized header
+	if err := c.call("getblockheader", &headerHex, blockHash.String(), false); err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
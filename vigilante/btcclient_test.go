@@ -0,0 +1,165 @@
+package vigilante_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/babylonchain/babylon/vigilante"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// newRPCStandIn starts a server answering bitcoind JSON-RPC requests with the
+// results of the handlers, keyed by method
+func newRPCStandIn(t *testing.T, handlers map[string]func(params []json.RawMessage) interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "user", user)
+		require.Equal(t, "pass", pass)
+
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		handler, ok := handlers[req.Method]
+		resp := map[string]interface{}{"id": req.ID, "result": nil, "error": nil}
+		if ok {
+			resp["result"] = handler(req.Params)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "Method not found"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+}
+
+func TestRPCClientSendOpReturnTx(t *testing.T) {
+	data := []byte("babylon checkpoint part")
+	input := wire.NewOutPoint(&chainhash.Hash{1}, 3)
+	txid := chainhash.Hash{2}
+
+	server := newRPCStandIn(t, map[string]func(params []json.RawMessage) interface{}{
+		"createrawtransaction": func(params []json.RawMessage) interface{} {
+			var inputs []struct {
+				Txid string `json:"txid"`
+				Vout uint32 `json:"vout"`
+			}
+			var outputs []map[string]string
+			require.NoError(t, json.Unmarshal(params[0], &inputs))
+			require.NoError(t, json.Unmarshal(params[1], &outputs))
+			require.Len(t, inputs, 1)
+			require.Equal(t, input.Hash.String(), inputs[0].Txid)
+			require.Equal(t, input.Index, inputs[0].Vout)
+			require.Equal(t, hex.EncodeToString(data), outputs[0]["data"])
+			return "raw"
+		},
+		"fundrawtransaction": func(params []json.RawMessage) interface{} {
+			return map[string]interface{}{"hex": "funded", "changepos": 1}
+		},
+		"signrawtransactionwithwallet": func(params []json.RawMessage) interface{} {
+			return map[string]interface{}{"hex": "signed", "complete": true}
+		},
+		"sendrawtransaction": func(params []json.RawMessage) interface{} {
+			var rawTx string
+			require.NoError(t, json.Unmarshal(params[0], &rawTx))
+			require.Equal(t, "signed", rawTx)
+			return txid.String()
+		},
+	})
+	defer server.Close()
+
+	client, err := vigilante.NewRPCClient(vigilante.BTCRPCConfig{Endpoint: server.URL, User: "user", Pass: "pass"})
+	require.NoError(t, err)
+
+	sentTxid, change, err := client.SendOpReturnTx(data, input)
+	require.NoError(t, err)
+	require.Equal(t, txid, *sentTxid)
+	require.Equal(t, *wire.NewOutPoint(&txid, 1), *change)
+
+	_, err = client.GetBlock(&txid)
+	require.ErrorContains(t, err, "Method not found")
+}
+
+func TestRPCClientGetBlock(t *testing.T) {
+	block := chaincfg.SimNetParams.GenesisBlock
+	var buf bytes.Buffer
+	require.NoError(t, block.Serialize(&buf))
+	blockHash := block.BlockHash()
+
+	server := newRPCStandIn(t, map[string]func(params []json.RawMessage) interface{}{
+		"getblock": func(params []json.RawMessage) interface{} {
+			var hash string
+			require.NoError(t, json.Unmarshal(params[0], &hash))
+			require.Equal(t, blockHash.String(), hash)
+			return hex.EncodeToString(buf.Bytes())
+		},
+		"gettransaction": func(params []json.RawMessage) interface{} {
+			return map[string]interface{}{"confirmations": 3, "blockhash": blockHash.String()}
+		},
+	})
+	defer server.Close()
+
+	client, err := vigilante.NewRPCClient(vigilante.BTCRPCConfig{Endpoint: server.URL, User: "user", Pass: "pass"})
+	require.NoError(t, err)
+
+	received, err := client.GetBlock(&blockHash)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, received.BlockHash())
+
+	txid := block.Transactions[0].TxHash()
+	confirmedIn, confirmations, err := client.GetTxConfirmations(&txid)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *confirmedIn)
+	require.Equal(t, int64(3), confirmations)
+}
+
+func TestRPCClientFollowChain(t *testing.T) {
+	header := chaincfg.SimNetParams.GenesisBlock.Header
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	blockHash := header.BlockHash()
+
+	server := newRPCStandIn(t, map[string]func(params []json.RawMessage) interface{}{
+		"getblockcount": func(params []json.RawMessage) interface{} {
+			return 7
+		},
+		"getblockhash": func(params []json.RawMessage) interface{} {
+			var height int64
+			require.NoError(t, json.Unmarshal(params[0], &height))
+			require.Equal(t, int64(7), height)
+			return blockHash.String()
+		},
+		"getblockheader": func(params []json.RawMessage) interface{} {
+			var verbose bool
+			require.NoError(t, json.Unmarshal(params[1], &verbose))
+			require.False(t, verbose)
+			return hex.EncodeToString(buf.Bytes())
+		},
+	})
+	defer server.Close()
+
+	client, err := vigilante.NewRPCClient(vigilante.BTCRPCConfig{Endpoint: server.URL, User: "user", Pass: "pass"})
+	require.NoError(t, err)
+
+	height, err := client.GetBlockCount()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), height)
+
+	hash, err := client.GetBlockHash(height)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *hash)
+
+	received, err := client.GetBlockHeader(hash)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, received.BlockHash())
+}
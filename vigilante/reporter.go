@@ -0,0 +1,429 @@
+package vigilante
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultMaxHeadersInMsg       = 100
+	DefaultPartsMaxAge     int64 = 144
+)
+
+type ReporterConfig struct {
+	// Tag of the checkpoints of the Babylon chain
+	Tag txformat.BabylonTag
+	// PollInterval is the time between two polls of BTC and Babylon
+	PollInterval time.Duration
+	// MaxHeadersInMsg is the maximum number of headers reported in one message
+	MaxHeadersInMsg int
+	// PartsMaxAge is the number of blocks during which a part of a checkpoint
+	// waits for the remaining parts before it is forgotten
+	PartsMaxAge int64
+}
+
+func DefaultReporterConfig(tag txformat.BabylonTag) ReporterConfig {
+	return ReporterConfig{
+		Tag:             tag,
+		PollInterval:    DefaultPollInterval,
+		MaxHeadersInMsg: DefaultMaxHeadersInMsg,
+		PartsMaxAge:     DefaultPartsMaxAge,
+	}
+}
+
+func (cfg *ReporterConfig) Validate() error {
+	if len(cfg.Tag) != txformat.TagLength {
+		return fmt.Errorf("tag should have %d bytes", txformat.TagLength)
+	}
+
+	if cfg.PollInterval <= 0 {
+		return errors.New("poll interval should be positive")
+	}
+
+	if cfg.MaxHeadersInMsg <= 0 {
+		return errors.New("max headers in message should be positive")
+	}
+
+	if cfg.PartsMaxAge <= 0 {
+		return errors.New("parts max age should be positive")
+	}
+
+	return nil
+}
+
+// checkpointPart is the babylon data found in the OP_RETURN output of a BTC
+// transaction, along with the proof of its inclusion
+type checkpointPart struct {
+	height int64
+	data   []byte
+	proof  *btcctypes.BTCSpvProof
+}
+
+// Reporter follows the BTC chain, reports its headers to the BTC light client of
+// Babylon and submits the proofs of the checkpoints it finds in the new blocks
+type Reporter struct {
+	cfg    ReporterConfig
+	btc    BTCChain
+	bbn    BabylonClient
+	logger log.Logger
+	// height of the last block scanned for checkpoints, negative until the first poll
+	scannedHeight int64
+	// hashes of the recently scanned blocks, used to detect reorgs
+	scannedHashes map[int64]chainhash.Hash
+	// parts of checkpoints waiting for the remaining parts
+	parts []*checkpointPart
+}
+
+func NewReporter(cfg ReporterConfig, btc BTCChain, bbn BabylonClient, logger log.Logger) (*Reporter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Reporter{
+		cfg:           cfg,
+		btc:           btc,
+		bbn:           bbn,
+		logger:        logger.With("module", "vigilante-reporter"),
+		scannedHeight: -1,
+		scannedHashes: make(map[int64]chainhash.Hash),
+	}, nil
+}
+
+// Start polls BTC and Babylon until the context is done
+func (r *Reporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Poll(ctx); err != nil {
+			r.logger.Error("failed to poll", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll reports the headers of the BTC best chain which are missing in Babylon,
+// scans the new blocks for checkpoints and submits the proofs of the complete ones
+func (r *Reporter) Poll(ctx context.Context) error {
+	bestHeight, err := r.btc.GetBlockCount()
+	if err != nil {
+		return fmt.Errorf("failed to get best btc height: %w", err)
+	}
+
+	ancestor, err := r.findCommonAncestor(ctx, bestHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := r.reportHeaders(ctx, ancestor, bestHeight); err != nil {
+		return err
+	}
+
+	// checkpoints are looked for only in the blocks following the ones known
+	// to Babylon when the reporter started
+	if r.scannedHeight < 0 {
+		r.scannedHeight = ancestor
+	}
+
+	if err := r.rewindScanned(bestHeight); err != nil {
+		return err
+	}
+
+	for height := r.scannedHeight + 1; height <= bestHeight; height++ {
+		if err := r.scanBlock(height); err != nil {
+			return err
+		}
+	}
+
+	r.submitCheckpoints(ctx)
+	r.prune(bestHeight)
+
+	return nil
+}
+
+// findCommonAncestor returns the height of the last block of the BTC best chain
+// known to Babylon. Babylon may follow a fork of the BTC chain, in which case the
+// ancestor is found by walking back the best chain.
+func (r *Reporter) findCommonAncestor(ctx context.Context, bestHeight int64) (int64, error) {
+	tip, err := r.bbn.BTCTip(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query btc light client tip: %w", err)
+	}
+
+	height := int64(tip.Height)
+	if height > bestHeight {
+		height = bestHeight
+	}
+
+	for ; height >= 0; height-- {
+		hash, err := r.btc.GetBlockHash(height)
+		if err != nil {
+			return 0, err
+		}
+
+		contains, err := r.bbn.ContainsBTCHeader(ctx, hash)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query btc light client: %w", err)
+		}
+
+		if contains {
+			return height, nil
+		}
+	}
+
+	return 0, errors.New("btc light client does not contain any block of the btc best chain")
+}
+
+// reportHeaders reports the headers of the blocks of the best chain following the
+// ancestor, in batches of at most MaxHeadersInMsg headers
+func (r *Reporter) reportHeaders(ctx context.Context, ancestor int64, bestHeight int64) error {
+	for start := ancestor + 1; start <= bestHeight; start += int64(r.cfg.MaxHeadersInMsg) {
+		end := start + int64(r.cfg.MaxHeadersInMsg) - 1
+		if end > bestHeight {
+			end = bestHeight
+		}
+
+		var headers []bbn.BTCHeaderBytes
+		for height := start; height <= end; height++ {
+			hash, err := r.btc.GetBlockHash(height)
+			if err != nil {
+				return err
+			}
+
+			header, err := r.btc.GetBlockHeader(hash)
+			if err != nil {
+				return err
+			}
+
+			headers = append(headers, bbn.NewBTCHeaderBytesFromBlockHeader(header))
+		}
+
+		if err := r.bbn.InsertBTCHeaders(ctx, headers); err != nil {
+			return fmt.Errorf("failed to report headers from height %d to %d: %w", start, end, err)
+		}
+
+		r.logger.Info("reported btc headers", "from", start, "to", end)
+	}
+
+	return nil
+}
+
+// rewindScanned moves back the last scanned block to the last one which is still
+// part of the best chain and forgets the parts found in the reorged blocks
+func (r *Reporter) rewindScanned(bestHeight int64) error {
+	for r.scannedHeight > bestHeight {
+		delete(r.scannedHashes, r.scannedHeight)
+		r.scannedHeight--
+	}
+
+	for r.scannedHeight >= 0 {
+		scanned, ok := r.scannedHashes[r.scannedHeight]
+		if !ok {
+			break
+		}
+
+		hash, err := r.btc.GetBlockHash(r.scannedHeight)
+		if err != nil {
+			return err
+		}
+
+		if hash.IsEqual(&scanned) {
+			break
+		}
+
+		delete(r.scannedHashes, r.scannedHeight)
+		r.scannedHeight--
+	}
+
+	var parts []*checkpointPart
+	for _, part := range r.parts {
+		if part.height <= r.scannedHeight {
+			parts = append(parts, part)
+		}
+	}
+	r.parts = parts
+
+	return nil
+}
+
+// scanBlock collects the babylon data carried in the OP_RETURN outputs of the
+// transactions of the block at given height
+func (r *Reporter) scanBlock(height int64) error {
+	hash, err := r.btc.GetBlockHash(height)
+	if err != nil {
+		return err
+	}
+
+	block, err := r.btc.GetBlock(hash)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range block.Transactions {
+		data := r.extractCheckpointPart(tx)
+		if data == nil {
+			continue
+		}
+
+		txid := tx.TxHash()
+		proof, err := ProofFromBlock(block, &txid)
+		if err != nil {
+			return err
+		}
+
+		r.logger.Info("found checkpoint part", "height", height, "txid", txid.String())
+		r.parts = append(r.parts, &checkpointPart{height: height, data: data, proof: proof})
+	}
+
+	r.scannedHashes[height] = *hash
+	r.scannedHeight = height
+
+	return nil
+}
+
+// extractCheckpointPart returns the OP_RETURN data of the transaction if it is
+// tagged with the babylon tag and encoded in a version carried in OP_RETURN outputs
+func (r *Reporter) extractCheckpointPart(tx *wire.MsgTx) []byte {
+	data := btcctypes.ExtractOpReturnData(btcutil.NewTx(tx))
+	if len(data) == 0 {
+		return nil
+	}
+
+	version, err := txformat.GetFormatVersion(r.cfg.Tag, data)
+	if err != nil {
+		return nil
+	}
+
+	spec, err := txformat.GetFormatSpec(version)
+	if err != nil || spec.InWitness {
+		return nil
+	}
+
+	return data
+}
+
+// submitCheckpoints submits the proofs of the checkpoints whose parts were all
+// found. The parts of a submitted checkpoint are forgotten, while the parts of a
+// checkpoint whose submission failed are kept and submitted again upon the next
+// polls, until they are older than PartsMaxAge. A submission only fails if the
+// transaction is rejected by the node, which does not include the failures in
+// DeliverTx unless the transactions are broadcast in block mode.
+func (r *Reporter) submitCheckpoints(ctx context.Context) {
+	// parts matched with a checkpoint in this poll, which are not matched again
+	matched := make(map[*checkpointPart]bool)
+	submitted := make(map[*checkpointPart]bool)
+
+	for _, first := range r.parts {
+		if matched[first] {
+			continue
+		}
+
+		if idx, err := txformat.GetPartIndex(r.cfg.Tag, first.data); err != nil || idx != 0 {
+			continue
+		}
+
+		parts := r.matchParts(first, matched)
+		if parts == nil {
+			continue
+		}
+
+		var proofs []*btcctypes.BTCSpvProof
+		for _, part := range parts {
+			matched[part] = true
+			proofs = append(proofs, part.proof)
+		}
+
+		if err := r.bbn.InsertBTCSpvProofs(ctx, proofs); err != nil {
+			r.logger.Error("failed to submit checkpoint proofs, retrying upon the next poll", "height", first.height, "err", err)
+			continue
+		}
+
+		for _, part := range parts {
+			submitted[part] = true
+		}
+
+		r.logger.Info("submitted checkpoint proofs", "height", first.height, "parts", len(parts))
+	}
+
+	var remaining []*checkpointPart
+	for _, part := range r.parts {
+		if !submitted[part] {
+			remaining = append(remaining, part)
+		}
+	}
+	r.parts = remaining
+}
+
+// matchParts returns the parts of the checkpoint starting with the given part in
+// order, or nil if some parts were not found yet
+func (r *Reporter) matchParts(first *checkpointPart, used map[*checkpointPart]bool) []*checkpointPart {
+	parts := []*checkpointPart{first}
+
+	for len(parts) <= txformat.MaxNumberOfParts {
+		var data [][]byte
+		for _, part := range parts {
+			data = append(data, part.data)
+		}
+
+		if _, _, err := txformat.DecodeCheckpoint(r.cfg.Tag, data); err == nil {
+			return parts
+		}
+
+		next := r.findNextPart(parts[len(parts)-1], used)
+		if next == nil {
+			return nil
+		}
+
+		parts = append(parts, next)
+	}
+
+	return nil
+}
+
+func (r *Reporter) findNextPart(prev *checkpointPart, used map[*checkpointPart]bool) *checkpointPart {
+	for _, part := range r.parts {
+		if used[part] || part == prev {
+			continue
+		}
+
+		if txformat.IsNextPart(r.cfg.Tag, prev.data, part.data) {
+			return part
+		}
+	}
+
+	return nil
+}
+
+// prune forgets the parts and hashes of blocks older than PartsMaxAge
+func (r *Reporter) prune(bestHeight int64) {
+	minHeight := bestHeight - r.cfg.PartsMaxAge
+
+	var parts []*checkpointPart
+	for _, part := range r.parts {
+		if part.height > minHeight {
+			parts = append(parts, part)
+		}
+	}
+	r.parts = parts
+
+	for height := range r.scannedHashes {
+		if height <= minHeight {
+			delete(r.scannedHashes, height)
+		}
+	}
+}
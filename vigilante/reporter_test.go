@@ -0,0 +1,207 @@
+package vigilante_test
+
+import (
+	"context"
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/vigilante"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestReporter(t *testing.T, btc *simBTC, bbn *simBabylon) *vigilante.Reporter {
+	cfg := vigilante.DefaultReporterConfig(txformat.MainTag())
+	// make the reporter split the headers in several messages
+	cfg.MaxHeadersInMsg = 2
+	reporter, err := vigilante.NewReporter(cfg, btc, bbn, log.NewNopLogger())
+	require.NoError(t, err)
+	return reporter
+}
+
+func encodeSealedCheckpoint(t *testing.T, bbn *simBabylon, ckpt *ckpttypes.RawCheckpointWithMeta, version txformat.FormatVersion) [][]byte {
+	parts, err := txformat.EncodeCheckpoint(
+		txformat.MainTag(),
+		version,
+		ckpt.Ckpt.EpochNum,
+		*ckpt.Ckpt.LastCommitHash,
+		ckpt.Ckpt.Bitmap,
+		*ckpt.Ckpt.BlsMultiSig,
+		bbn.GetAddress(),
+	)
+	require.NoError(t, err)
+	return parts
+}
+
+func requireTipSynced(t *testing.T, btc *simBTC, bbn *simBabylon) {
+	best := btc.blocks[len(btc.blocks)-1].BlockHash()
+	require.Equal(t, uint64(len(btc.blocks)-1), bbn.tip.Height)
+	require.True(t, bbn.tip.Hash.ToChainhash().IsEqual(&best))
+}
+
+func TestReporterReportsHeadersAndCheckpoints(t *testing.T) {
+	testCases := []struct {
+		name       string
+		version    txformat.FormatVersion
+		bitmapBits int
+	}{
+		{"two parts", txformat.CurrentVersion, 8 * txformat.BitMapLength},
+		{"n parts", txformat.NPartsVersion, 8 * 200},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			btc := newSimBTC()
+			bbn := newSimBabylon()
+			reporter := newTestReporter(t, btc, bbn)
+			ctx := context.Background()
+
+			// missing headers are back-filled
+			for i := 0; i < 5; i++ {
+				btc.mine(t)
+			}
+			require.NoError(t, reporter.Poll(ctx))
+			requireTipSynced(t, btc, bbn)
+			require.Equal(t, 5, bbn.reportedHeaders)
+
+			ckpt := genSealedCheckpoint(1, tc.bitmapBits)
+			parts := encodeSealedCheckpoint(t, bbn, ckpt, tc.version)
+
+			// transactions which do not carry babylon data are ignored
+			_, _, err := btc.SendOpReturnTx([]byte("not a checkpoint"), nil)
+			require.NoError(t, err)
+
+			// the first part is included in a block, the remaining ones in the following block
+			_, change, err := btc.SendOpReturnTx(parts[0], nil)
+			require.NoError(t, err)
+			btc.mine(t)
+			require.NoError(t, reporter.Poll(ctx))
+			requireTipSynced(t, btc, bbn)
+			require.Empty(t, bbn.submissions)
+
+			for _, part := range parts[1:] {
+				_, change, err = btc.SendOpReturnTx(part, change)
+				require.NoError(t, err)
+			}
+			btc.mine(t)
+			require.NoError(t, reporter.Poll(ctx))
+			requireTipSynced(t, btc, bbn)
+			require.Len(t, bbn.submissions, 1)
+
+			sub := bbn.submissions[0]
+			require.Equal(t, tc.version, sub.Version)
+			require.Len(t, sub.Proofs, len(parts))
+
+			submitted, err := ckpttypes.FromBTCCkptBytesToRawCkpt(sub.GetRawCheckPointBytes())
+			require.NoError(t, err)
+			require.True(t, ckpt.Ckpt.Equal(submitted))
+
+			// nothing is reported again
+			reported := bbn.reportedHeaders
+			require.NoError(t, reporter.Poll(ctx))
+			require.Equal(t, reported, bbn.reportedHeaders)
+			require.Len(t, bbn.submissions, 1)
+		})
+	}
+}
+
+func TestReporterRetriesFailedSubmission(t *testing.T) {
+	btc := newSimBTC()
+	bbn := newSimBabylon()
+	cfg := vigilante.DefaultReporterConfig(txformat.MainTag())
+	cfg.PartsMaxAge = 2
+	reporter, err := vigilante.NewReporter(cfg, btc, bbn, log.NewNopLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	sendCheckpoint := func(epoch uint64) {
+		parts := encodeSealedCheckpoint(t, bbn, genSealedCheckpoint(epoch, 8*txformat.BitMapLength), txformat.CurrentVersion)
+		var change *wire.OutPoint
+		for _, part := range parts {
+			_, change, err = btc.SendOpReturnTx(part, change)
+			require.NoError(t, err)
+		}
+		btc.mine(t)
+	}
+
+	// the failed submission is retried upon the next poll
+	sendCheckpoint(1)
+	bbn.failingSubmissions = 1
+	require.NoError(t, reporter.Poll(ctx))
+	require.Empty(t, bbn.submissions)
+	require.NoError(t, reporter.Poll(ctx))
+	require.Len(t, bbn.submissions, 1)
+
+	// until the parts are older than PartsMaxAge
+	sendCheckpoint(2)
+	bbn.failingSubmissions = 3
+	require.NoError(t, reporter.Poll(ctx))
+	btc.mine(t)
+	require.NoError(t, reporter.Poll(ctx))
+	btc.mine(t)
+	require.NoError(t, reporter.Poll(ctx))
+	require.Zero(t, bbn.failingSubmissions)
+	require.NoError(t, reporter.Poll(ctx))
+	require.Len(t, bbn.submissions, 1)
+}
+
+func TestReporterFollowsReorg(t *testing.T) {
+	btc := newSimBTC()
+	bbnClient := newSimBabylon()
+	reporter := newTestReporter(t, btc, bbnClient)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		btc.mine(t)
+	}
+
+	ckpt := genSealedCheckpoint(1, 8*txformat.BitMapLength)
+	parts := encodeSealedCheckpoint(t, bbnClient, ckpt, txformat.CurrentVersion)
+
+	_, change, err := btc.SendOpReturnTx(parts[0], nil)
+	require.NoError(t, err)
+	btc.mine(t)
+	require.NoError(t, reporter.Poll(ctx))
+	requireTipSynced(t, btc, bbnClient)
+	staleTip := btc.blocks[len(btc.blocks)-1].BlockHash()
+
+	// the block including the first part is replaced by a longer fork including it again
+	btc.reorg(1)
+	btc.mine(t)
+	btc.mine(t)
+	require.NoError(t, reporter.Poll(ctx))
+	requireTipSynced(t, btc, bbnClient)
+	require.Contains(t, bbnClient.headers, staleTip)
+	require.Empty(t, bbnClient.submissions)
+
+	_, _, err = btc.SendOpReturnTx(parts[1], change)
+	require.NoError(t, err)
+	btc.mine(t)
+	require.NoError(t, reporter.Poll(ctx))
+	requireTipSynced(t, btc, bbnClient)
+	require.Len(t, bbnClient.submissions, 1)
+
+	// the proof of the first part is the one of the fork
+	sub := bbnClient.submissions[0]
+	require.Len(t, sub.Proofs, 2)
+	firstPartBlock := btc.blocks[4].BlockHash()
+	require.True(t, sub.Proofs[0].BlockHash.ToChainhash().IsEqual(&firstPartBlock))
+	require.False(t, sub.Proofs[0].BlockHash.ToChainhash().IsEqual(&staleTip))
+}
+
+func TestReporterConfigValidation(t *testing.T) {
+	cfg := vigilante.DefaultReporterConfig(txformat.MainTag())
+	require.NoError(t, cfg.Validate())
+
+	cfg.MaxHeadersInMsg = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = vigilante.DefaultReporterConfig(txformat.MainTag())
+	cfg.PartsMaxAge = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = vigilante.DefaultReporterConfig(txformat.BabylonTag("bbn"))
+	require.Error(t, cfg.Validate())
+}
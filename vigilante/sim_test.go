@@ -0,0 +1,294 @@
+package vigilante_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simBTC is an in memory stand-in of a simnet bitcoind node with a wallet
+type simBTC struct {
+	blocks  []*wire.MsgBlock
+	mempool []*wire.MsgTx
+	// height of the block including the transaction
+	txHeight map[chainhash.Hash]int
+	// number of reorgs, which makes the blocks of each fork different
+	forks int
+}
+
+func newSimBTC() *simBTC {
+	genesis := chaincfg.SimNetParams.GenesisBlock
+	return &simBTC{
+		blocks:   []*wire.MsgBlock{genesis},
+		txHeight: make(map[chainhash.Hash]int),
+	}
+}
+
+func (b *simBTC) SendOpReturnTx(data []byte, input *wire.OutPoint) (*chainhash.Hash, *wire.OutPoint, error) {
+	prevOut := input
+	if prevOut == nil {
+		hash, _ := chainhash.NewHash(dg.GenRandomByteArray(chainhash.HashSize))
+		prevOut = wire.NewOutPoint(hash, 0)
+	}
+
+	opReturn, err := txscript.NullDataScript(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(prevOut, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(0, opReturn))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{txscript.OP_TRUE}))
+
+	b.mempool = append(b.mempool, tx)
+
+	txid := tx.TxHash()
+	return &txid, wire.NewOutPoint(&txid, 1), nil
+}
+
+func (b *simBTC) GetTxConfirmations(txid *chainhash.Hash) (*chainhash.Hash, int64, error) {
+	height, ok := b.txHeight[*txid]
+	if !ok {
+		for _, tx := range b.mempool {
+			if tx.TxHash() == *txid {
+				return nil, 0, nil
+			}
+		}
+		return nil, 0, fmt.Errorf("unknown transaction %s", txid)
+	}
+
+	blockHash := b.blocks[height].BlockHash()
+	return &blockHash, int64(len(b.blocks) - height), nil
+}
+
+func (b *simBTC) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	for _, block := range b.blocks {
+		if block.BlockHash() == *blockHash {
+			return block, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown block %s", blockHash)
+}
+
+func (b *simBTC) GetBlockCount() (int64, error) {
+	return int64(len(b.blocks) - 1), nil
+}
+
+func (b *simBTC) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	if height < 0 || height >= int64(len(b.blocks)) {
+		return nil, fmt.Errorf("block height %d out of range", height)
+	}
+
+	hash := b.blocks[height].BlockHash()
+	return &hash, nil
+}
+
+func (b *simBTC) GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+	block, err := b.GetBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &block.Header, nil
+}
+
+// reorg removes the last depth blocks and puts their transactions back in the mempool
+func (b *simBTC) reorg(depth int) {
+	forkHeight := len(b.blocks) - depth
+
+	var txs []*wire.MsgTx
+	for _, block := range b.blocks[forkHeight:] {
+		for _, tx := range block.Transactions {
+			delete(b.txHeight, tx.TxHash())
+		}
+		txs = append(txs, block.Transactions[1:]...)
+	}
+
+	b.blocks = b.blocks[:forkHeight]
+	b.mempool = append(txs, b.mempool...)
+	b.forks++
+}
+
+// mine includes all the mempool transactions in a new block
+func (b *simBTC) mine(t *testing.T) *wire.MsgBlock {
+	height := len(b.blocks)
+
+	extraNonce := make([]byte, 16)
+	binary.BigEndian.PutUint64(extraNonce, uint64(height))
+	binary.BigEndian.PutUint64(extraNonce[8:], uint64(b.forks))
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), extraNonce, nil))
+	coinbase.AddTxOut(wire.NewTxOut(blockchain.CalcBlockSubsidy(int32(height), &chaincfg.SimNetParams), []byte{txscript.OP_TRUE}))
+
+	txs := append([]*wire.MsgTx{coinbase}, b.mempool...)
+	var utilTxs []*btcutil.Tx
+	for _, tx := range txs {
+		utilTxs = append(utilTxs, btcutil.NewTx(tx))
+	}
+	merkles := blockchain.BuildMerkleTreeStore(utilTxs, false)
+
+	prevHash := b.blocks[height-1].BlockHash()
+	header := wire.NewBlockHeader(
+		1,
+		&prevHash,
+		merkles[len(merkles)-1],
+		chaincfg.SimNetParams.GenesisBlock.Header.Bits,
+		0,
+	)
+	header.Timestamp = time.Unix(b.blocks[height-1].Header.Timestamp.Unix()+600, 0)
+
+	if !dg.SolveBlock(header) {
+		t.Fatalf("Should solve block")
+	}
+
+	block := wire.NewMsgBlock(header)
+	for _, tx := range txs {
+		_ = block.AddTransaction(tx)
+		b.txHeight[tx.TxHash()] = height
+	}
+
+	b.blocks = append(b.blocks, block)
+	b.mempool = nil
+
+	return block
+}
+
+// simBabylon is an in memory stand-in of Babylon, which parses the submitted proofs
+// in the same way as btccheckpoint. Its btc light client starts at the simnet genesis
+// and follows the fork with the most blocks, as all simnet blocks have the same work.
+type simBabylon struct {
+	address     sdk.AccAddress
+	sealed      []*ckpttypes.RawCheckpointWithMeta
+	submissions []*btcctypes.RawCheckpointSubmission
+	// heights of the headers known to the btc light client
+	headers map[chainhash.Hash]uint64
+	tip     *btclctypes.BTCHeaderInfo
+	// number of reported headers, including duplicates
+	reportedHeaders int
+	// number of next proof submissions failing, e.g. because of RPC errors
+	failingSubmissions int
+}
+
+func newSimBabylon() *simBabylon {
+	genesis := chaincfg.SimNetParams.GenesisBlock.Header
+	genesisHash := genesis.BlockHash()
+
+	b := &simBabylon{
+		address: sdk.AccAddress(dg.GenRandomByteArray(txformat.AddressLength)),
+		headers: map[chainhash.Hash]uint64{genesisHash: 0},
+	}
+	b.tip = newHeaderInfo(&genesis, 0)
+
+	return b
+}
+
+func newHeaderInfo(header *wire.BlockHeader, height uint64) *btclctypes.BTCHeaderInfo {
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(header)
+	return &btclctypes.BTCHeaderInfo{
+		Header: &headerBytes,
+		Hash:   headerBytes.Hash(),
+		Height: height,
+	}
+}
+
+func (b *simBabylon) GetAddress() sdk.AccAddress {
+	return b.address
+}
+
+func (b *simBabylon) SealedCheckpoints(ctx context.Context) ([]*ckpttypes.RawCheckpointWithMeta, error) {
+	return b.sealed, nil
+}
+
+func (b *simBabylon) InsertBTCSpvProofs(ctx context.Context, proofs []*btcctypes.BTCSpvProof) error {
+	if b.failingSubmissions > 0 {
+		b.failingSubmissions--
+		return errors.New("connection refused")
+	}
+
+	for _, proof := range proofs {
+		header := bbn.BTCHeaderBytes(proof.ConfirmingBtcHeader).ToBlockHeader()
+		if _, ok := b.headers[header.BlockHash()]; !ok {
+			return fmt.Errorf("header %s of the proof is not known", header.BlockHash())
+		}
+	}
+
+	sub, err := btcctypes.ParseProofs(b.address, proofs, chaincfg.SimNetParams.PowLimit, txformat.MainTag())
+	if err != nil {
+		return err
+	}
+
+	b.submissions = append(b.submissions, sub)
+	return nil
+}
+
+func (b *simBabylon) BTCTip(ctx context.Context) (*btclctypes.BTCHeaderInfo, error) {
+	return b.tip, nil
+}
+
+func (b *simBabylon) ContainsBTCHeader(ctx context.Context, hash *chainhash.Hash) (bool, error) {
+	_, ok := b.headers[*hash]
+	return ok, nil
+}
+
+func (b *simBabylon) InsertBTCHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	msg := &btclctypes.MsgInsertHeaders{Signer: b.address.String(), Headers: headers}
+	if err := msg.ValidateHeaders(chaincfg.SimNetParams.PowLimit); err != nil {
+		return err
+	}
+
+	for _, headerBytes := range headers {
+		header := headerBytes.ToBlockHeader()
+
+		parentHeight, ok := b.headers[header.PrevBlock]
+		if !ok {
+			return fmt.Errorf("parent of header %s is not known", header.BlockHash())
+		}
+
+		height := parentHeight + 1
+		b.headers[header.BlockHash()] = height
+		b.reportedHeaders++
+
+		if height > b.tip.Height {
+			b.tip = newHeaderInfo(header, height)
+		}
+	}
+
+	return nil
+}
+
+// syncHeaders inserts the headers of the blocks of btc which are not known yet, as
+// a reporter would do
+func (b *simBabylon) syncHeaders(t *testing.T, btc *simBTC) {
+	var headers []bbn.BTCHeaderBytes
+	for _, block := range btc.blocks {
+		if _, ok := b.headers[block.BlockHash()]; !ok {
+			headers = append(headers, bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header))
+		}
+	}
+
+	if len(headers) == 0 {
+		return
+	}
+
+	if err := b.InsertBTCHeaders(context.Background(), headers); err != nil {
+		t.Fatalf("Should insert headers: %v", err)
+	}
+}
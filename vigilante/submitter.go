@@ -0,0 +1,328 @@
+package vigilante
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultSubmitterConfirmationDepth int64 = 1
+	DefaultPollInterval                     = 10 * time.Second
+	DefaultResubmitPolls                    = 30
+)
+
+type SubmitterConfig struct {
+	// Tag of the checkpoints of the Babylon chain
+	Tag txformat.BabylonTag
+	// FormatVersion in which the checkpoints are encoded. Only versions carried in
+	// OP_RETURN outputs are supported
+	FormatVersion txformat.FormatVersion
+	// ConfirmationDepth is the number of confirmations all transactions of a checkpoint
+	// need to have before their proofs are submitted to Babylon
+	ConfirmationDepth int64
+	// PollInterval is the time between two polls of Babylon and BTC
+	PollInterval time.Duration
+	// ResubmitPolls is the number of polls after which the proofs of a checkpoint
+	// which is still sealed are submitted again, as the transaction carrying them
+	// may have failed after being accepted in the mempool
+	ResubmitPolls int
+}
+
+func DefaultSubmitterConfig(tag txformat.BabylonTag) SubmitterConfig {
+	return SubmitterConfig{
+		Tag:               tag,
+		FormatVersion:     txformat.CurrentVersion,
+		ConfirmationDepth: DefaultSubmitterConfirmationDepth,
+		PollInterval:      DefaultPollInterval,
+		ResubmitPolls:     DefaultResubmitPolls,
+	}
+}
+
+func (cfg *SubmitterConfig) Validate() error {
+	if len(cfg.Tag) != txformat.TagLength {
+		return fmt.Errorf("tag should have %d bytes", txformat.TagLength)
+	}
+
+	spec, err := txformat.GetFormatSpec(cfg.FormatVersion)
+	if err != nil {
+		return err
+	}
+
+	if spec.InWitness {
+		return fmt.Errorf("format version %d is not carried in OP_RETURN outputs", cfg.FormatVersion)
+	}
+
+	if cfg.ConfirmationDepth <= 0 {
+		return errors.New("confirmation depth should be positive")
+	}
+
+	if cfg.PollInterval <= 0 {
+		return errors.New("poll interval should be positive")
+	}
+
+	if cfg.ResubmitPolls <= 0 {
+		return errors.New("resubmit polls should be positive")
+	}
+
+	return nil
+}
+
+// submission tracks the BTC transactions carrying the parts of the checkpoint of an epoch
+type submission struct {
+	epoch uint64
+	parts [][]byte
+	txids []*chainhash.Hash
+	// change output of the last sent transaction, spent by the next one so that
+	// the parts are included in the BTC chain in order
+	change *wire.OutPoint
+	// number of polls since the proofs were submitted to Babylon, negative until then
+	pollsSinceReported int
+}
+
+func (s *submission) reported() bool {
+	return s.pollsSinceReported >= 0
+}
+
+func (s *submission) allPartsSent() bool {
+	return len(s.txids) == len(s.parts)
+}
+
+// Submitter watches Babylon for sealed checkpoints, sends them to BTC and, once the
+// BTC transactions are confirmed, submits their proofs back to Babylon
+type Submitter struct {
+	cfg    SubmitterConfig
+	btc    BTCWallet
+	bbn    BabylonClient
+	logger log.Logger
+	// submissions of the sealed checkpoints. The submissions whose proofs were submitted
+	// are kept until their checkpoint is not sealed anymore, as the transaction carrying
+	// the proofs may take some polls to be included in a Babylon block, or may fail
+	submissions map[uint64]*submission
+}
+
+func NewSubmitter(cfg SubmitterConfig, btc BTCWallet, bbn BabylonClient, logger log.Logger) (*Submitter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Submitter{
+		cfg:         cfg,
+		btc:         btc,
+		bbn:         bbn,
+		logger:      logger.With("module", "vigilante-submitter"),
+		submissions: make(map[uint64]*submission),
+	}, nil
+}
+
+// Start polls Babylon and BTC until the context is done
+func (s *Submitter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Poll(ctx); err != nil {
+			s.logger.Error("failed to poll", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll sends the new sealed checkpoints to BTC and submits the proofs of the
+// checkpoints whose transactions are confirmed
+func (s *Submitter) Poll(ctx context.Context) error {
+	checkpoints, err := s.bbn.SealedCheckpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query sealed checkpoints: %w", err)
+	}
+
+	sealed := make(map[uint64]bool)
+
+	for _, ckpt := range checkpoints {
+		epoch := ckpt.Ckpt.EpochNum
+		sealed[epoch] = true
+
+		if _, ok := s.submissions[epoch]; ok {
+			continue
+		}
+
+		parts, err := s.encodeCheckpoint(ckpt.Ckpt)
+		if err != nil {
+			s.logger.Error("failed to encode checkpoint", "epoch", epoch, "err", err)
+			continue
+		}
+
+		s.submissions[epoch] = &submission{epoch: epoch, parts: parts, pollsSinceReported: -1}
+	}
+
+	for _, epoch := range s.sortedEpochs() {
+		sub := s.submissions[epoch]
+
+		// the checkpoint is not sealed anymore, e.g. because its proofs were accepted
+		// or were submitted by someone else in the meantime, stop tracking it
+		if !sealed[epoch] {
+			delete(s.submissions, epoch)
+			continue
+		}
+
+		if !sub.allPartsSent() {
+			if err := s.sendParts(sub); err != nil {
+				s.logger.Error("failed to send checkpoint to BTC", "epoch", epoch, "err", err)
+			}
+			continue
+		}
+
+		// the proofs are submitted again if the checkpoint is still sealed after
+		// ResubmitPolls polls, as their transaction may have failed in DeliverTx
+		if sub.reported() {
+			sub.pollsSinceReported++
+			if sub.pollsSinceReported < s.cfg.ResubmitPolls {
+				continue
+			}
+			s.logger.Info("checkpoint is still sealed, submitting its proofs again", "epoch", epoch)
+		}
+
+		reported, err := s.reportIfConfirmed(ctx, sub)
+		if err != nil {
+			s.logger.Error("failed to submit checkpoint proofs", "epoch", epoch, "err", err)
+			continue
+		}
+
+		if reported {
+			s.logger.Info("submitted checkpoint proofs", "epoch", epoch)
+			sub.pollsSinceReported = 0
+		}
+	}
+
+	return nil
+}
+
+func (s *Submitter) sortedEpochs() []uint64 {
+	epochs := make([]uint64, 0, len(s.submissions))
+	for e := range s.submissions {
+		epochs = append(epochs, e)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+	return epochs
+}
+
+func (s *Submitter) encodeCheckpoint(ckpt *ckpttypes.RawCheckpoint) ([][]byte, error) {
+	if ckpt.LastCommitHash == nil || ckpt.BlsMultiSig == nil {
+		return nil, errors.New("checkpoint is not sealed")
+	}
+
+	return txformat.EncodeCheckpoint(
+		s.cfg.Tag,
+		s.cfg.FormatVersion,
+		ckpt.EpochNum,
+		*ckpt.LastCommitHash,
+		ckpt.Bitmap,
+		*ckpt.BlsMultiSig,
+		s.bbn.GetAddress(),
+	)
+}
+
+// sendParts sends the parts of the checkpoint which were not sent yet. Each part
+// spends the change of the previous one.
+func (s *Submitter) sendParts(sub *submission) error {
+	for i := len(sub.txids); i < len(sub.parts); i++ {
+		txid, change, err := s.btc.SendOpReturnTx(sub.parts[i], sub.change)
+		if err != nil {
+			return err
+		}
+
+		s.logger.Info("sent checkpoint part to BTC", "epoch", sub.epoch, "part", i, "txid", txid.String())
+
+		sub.txids = append(sub.txids, txid)
+		sub.change = change
+	}
+
+	return nil
+}
+
+// reportIfConfirmed submits the proofs of the checkpoint to Babylon if all its
+// transactions have enough confirmations and the headers of the blocks including
+// them are known to the BTC light client of Babylon, as the proofs would be
+// rejected otherwise
+func (s *Submitter) reportIfConfirmed(ctx context.Context, sub *submission) (bool, error) {
+	var proofs []*btcctypes.BTCSpvProof
+
+	for _, txid := range sub.txids {
+		blockHash, confirmations, err := s.btc.GetTxConfirmations(txid)
+		if err != nil {
+			return false, err
+		}
+
+		if blockHash == nil || confirmations < s.cfg.ConfirmationDepth {
+			return false, nil
+		}
+
+		known, err := s.bbn.ContainsBTCHeader(ctx, blockHash)
+		if err != nil {
+			return false, err
+		}
+		if !known {
+			return false, nil
+		}
+
+		block, err := s.btc.GetBlock(blockHash)
+		if err != nil {
+			return false, err
+		}
+
+		proof, err := ProofFromBlock(block, txid)
+		if err != nil {
+			return false, err
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	if err := s.bbn.InsertBTCSpvProofs(ctx, proofs); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ProofFromBlock creates the proof of inclusion of the transaction in the block
+func ProofFromBlock(block *wire.MsgBlock, txid *chainhash.Hash) (*btcctypes.BTCSpvProof, error) {
+	var transactions [][]byte
+	txIdx := -1
+
+	for i, tx := range block.Transactions {
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, buf.Bytes())
+
+		if txHash := tx.TxHash(); txHash.IsEqual(txid) {
+			txIdx = i
+		}
+	}
+
+	if txIdx < 0 {
+		return nil, fmt.Errorf("transaction %s is not included in block %s", txid, block.BlockHash())
+	}
+
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header)
+
+	return btcctypes.SpvProofFromHeaderAndTransactions(headerBytes, transactions, uint(txIdx))
+}
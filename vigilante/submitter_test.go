@@ -0,0 +1,140 @@
+package vigilante_test
+
+import (
+	"context"
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/vigilante"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/boljen/go-bitmap"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func genSealedCheckpoint(epoch uint64, bitmapBits int) *ckpttypes.RawCheckpointWithMeta {
+	ckpt := dg.GenRandomRawCheckpoint()
+	ckpt.EpochNum = epoch
+	ckpt.Bitmap = bitmap.New(bitmapBits)
+	return &ckpttypes.RawCheckpointWithMeta{Ckpt: ckpt, Status: ckpttypes.Sealed}
+}
+
+func TestSubmitterSubmitsSealedCheckpoint(t *testing.T) {
+	testCases := []struct {
+		name       string
+		version    txformat.FormatVersion
+		bitmapBits int
+		depth      int64
+	}{
+		{"two parts", txformat.CurrentVersion, 8 * txformat.BitMapLength, 1},
+		{"n parts", txformat.NPartsVersion, 8 * 200, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			btc := newSimBTC()
+			bbn := newSimBabylon()
+			ckpt := genSealedCheckpoint(1, tc.bitmapBits)
+			bbn.sealed = append(bbn.sealed, ckpt)
+
+			cfg := vigilante.DefaultSubmitterConfig(txformat.MainTag())
+			cfg.FormatVersion = tc.version
+			cfg.ConfirmationDepth = tc.depth
+			submitter, err := vigilante.NewSubmitter(cfg, btc, bbn, log.NewNopLogger())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+
+			// all parts are sent, each one spending the change of the previous one
+			require.NoError(t, submitter.Poll(ctx))
+			require.Greater(t, len(btc.mempool), 1)
+			for i := 1; i < len(btc.mempool); i++ {
+				require.Equal(t, btc.mempool[i-1].TxHash(), btc.mempool[i].TxIn[0].PreviousOutPoint.Hash)
+			}
+			numParts := len(btc.mempool)
+
+			// unconfirmed parts are neither sent again nor reported
+			require.NoError(t, submitter.Poll(ctx))
+			require.Len(t, btc.mempool, numParts)
+			require.Empty(t, bbn.submissions)
+
+			for i := int64(0); i < tc.depth; i++ {
+				btc.mine(t)
+			}
+			bbn.syncHeaders(t, btc)
+
+			require.NoError(t, submitter.Poll(ctx))
+			require.Len(t, bbn.submissions, 1)
+
+			sub := bbn.submissions[0]
+			require.Equal(t, tc.version, sub.Version)
+			require.Len(t, sub.Proofs, numParts)
+
+			submitted, err := ckpttypes.FromBTCCkptBytesToRawCkpt(sub.GetRawCheckPointBytes())
+			require.NoError(t, err)
+			require.True(t, ckpt.Ckpt.Equal(submitted))
+
+			// reported checkpoint is not tracked anymore
+			require.NoError(t, submitter.Poll(ctx))
+			require.Len(t, bbn.submissions, 1)
+			require.Empty(t, btc.mempool)
+		})
+	}
+}
+
+func TestSubmitterWaitsForHeadersAndResubmits(t *testing.T) {
+	btc := newSimBTC()
+	bbn := newSimBabylon()
+	ckpt := genSealedCheckpoint(1, 8*txformat.BitMapLength)
+	bbn.sealed = append(bbn.sealed, ckpt)
+
+	cfg := vigilante.DefaultSubmitterConfig(txformat.MainTag())
+	cfg.ResubmitPolls = 2
+	submitter, err := vigilante.NewSubmitter(cfg, btc, bbn, log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, submitter.Poll(ctx))
+	btc.mine(t)
+
+	// the proofs are not submitted until the header of the including block is reported
+	require.NoError(t, submitter.Poll(ctx))
+	require.Empty(t, bbn.submissions)
+	bbn.syncHeaders(t, btc)
+	require.NoError(t, submitter.Poll(ctx))
+	require.Len(t, bbn.submissions, 1)
+
+	// the proofs are submitted again if the checkpoint is still sealed after ResubmitPolls polls
+	require.NoError(t, submitter.Poll(ctx))
+	require.Len(t, bbn.submissions, 1)
+	require.NoError(t, submitter.Poll(ctx))
+	require.Len(t, bbn.submissions, 2)
+
+	// but not once the checkpoint is not sealed anymore
+	bbn.sealed = nil
+	for i := 0; i < 2*cfg.ResubmitPolls; i++ {
+		require.NoError(t, submitter.Poll(ctx))
+	}
+	require.Len(t, bbn.submissions, 2)
+	require.Empty(t, btc.mempool)
+}
+
+func TestSubmitterConfigValidation(t *testing.T) {
+	cfg := vigilante.DefaultSubmitterConfig(txformat.MainTag())
+	require.NoError(t, cfg.Validate())
+
+	cfg.FormatVersion = txformat.WitnessVersion
+	require.Error(t, cfg.Validate())
+
+	cfg = vigilante.DefaultSubmitterConfig(txformat.MainTag())
+	cfg.ConfirmationDepth = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = vigilante.DefaultSubmitterConfig(txformat.MainTag())
+	cfg.ResubmitPolls = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = vigilante.DefaultSubmitterConfig(txformat.BabylonTag("bbn"))
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,21 @@
+package btccheckpoint
+
+import (
+	"time"
+
+	"github.com/babylonchain/babylon/x/btccheckpoint/keeper"
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BeginBlocker is called at the beginning of every block.
+// Upon each BeginBlock, a share of the block rewards collected by the fee collector
+// is transferred to the reward pool of the reporters.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper, req abci.RequestBeginBlock) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.FundRewardPool(ctx)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 )
@@ -27,6 +28,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	// this line is used by starport scaffolding # 1
 
 	cmd.AddCommand(CmdBtcCheckpointHeight())
+	cmd.AddCommand(CmdEpochSubmissions())
+	cmd.AddCommand(CmdEpochInclusionProof())
+	cmd.AddCommand(CmdRewards())
 	return cmd
 }
 
@@ -60,3 +64,103 @@ func CmdBtcCheckpointHeight() *cobra.Command {
 
 	return cmd
 }
+
+func CmdEpochSubmissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-submissions [epoch_num]",
+		Short: "retrieve the status of given epoch and all its submissions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch_num, err := strconv.ParseUint(args[0], 10, 64)
+
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryEpochSubmissionsRequest{EpochNum: epoch_num}
+
+			res, err := queryClient.EpochSubmissions(context.Background(), &params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEpochInclusionProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-inclusion-proof [epoch_num]",
+		Short: "retrieve the proof of inclusion of the checkpoint of given epoch in the btc chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch_num, err := strconv.ParseUint(args[0], 10, 64)
+
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryEpochInclusionProofRequest{EpochNum: epoch_num}
+
+			res, err := queryClient.EpochInclusionProof(context.Background(), &params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "retrieve the history of rewards paid to the reporters of finalized epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryRewardsRequest{Pagination: pageReq}
+
+			res, err := queryClient.Rewards(context.Background(), &params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rewards")
+
+	return cmd
+}
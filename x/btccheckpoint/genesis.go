@@ -11,12 +11,41 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	for _, epoch := range genState.Epochs {
+		k.SetEpochData(ctx, epoch.EpochNum, epoch.Data)
+	}
+	for _, submission := range genState.Submissions {
+		k.SetSubmission(ctx, submission.Key, submission.Data)
+	}
+	for _, sk := range genState.UnconfirmedSubmissions {
+		k.SetUnconfirmedSubmission(ctx, sk)
+	}
+	for _, sk := range genState.ConfirmedSubmissions {
+		k.SetConfirmedSubmission(ctx, sk)
+	}
+	for _, sk := range genState.FinalizedSubmissions {
+		k.SetFinalizedSubmission(ctx, sk)
+	}
+	for _, rr := range genState.Rewards {
+		k.SetReporterReward(ctx, rr)
+	}
+	for _, er := range genState.EpochRewards {
+		k.SetEpochReward(ctx, er)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.Epochs = k.GetAllEpochData(ctx)
+	genesis.Submissions = k.GetAllSubmissions(ctx)
+	genesis.UnconfirmedSubmissions = k.GetAllUnconfirmedSubmissions(ctx)
+	genesis.ConfirmedSubmissions = k.GetAllConfirmedSubmissions(ctx)
+	genesis.FinalizedSubmissions = k.GetAllFinalizedSubmissions(ctx)
+	genesis.Rewards = k.GetAllReporterRewards(ctx)
+	genesis.EpochRewards = k.GetAllEpochRewards(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -3,7 +3,9 @@ package btccheckpoint_test
 import (
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btccheckpoint"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -28,6 +30,8 @@ func TestInitGenesis(t *testing.T) {
 		Params: types.Params{
 			BtcConfirmationDepth:          999,
 			CheckpointFinalizationTimeout: 888,
+			ReporterRewardShare:           types.DefaultReporterRewardShare,
+			AcceptedFormatVersions:        types.DefaultAcceptedFormatVersions,
 		},
 	}
 
@@ -35,3 +39,64 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, app.BtcCheckpointKeeper.GetParams(ctx).BtcConfirmationDepth, uint64(999))
 	require.Equal(t, app.BtcCheckpointKeeper.GetParams(ctx).CheckpointFinalizationTimeout, uint64(888))
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	newSubmission := func(epoch uint64) types.SubmissionEntry {
+		return types.SubmissionEntry{
+			Key: types.SubmissionKey{Key: []*types.TransactionKey{
+				{Index: 1, Hash: datagen.GenRandomBTCHeaderPrevBlock()},
+				{Index: 2, Hash: datagen.GenRandomBTCHeaderPrevBlock()},
+			}},
+			Data: types.SubmissionData{
+				Submitter:      datagen.GenRandomByteArray(20),
+				Btctransaction: [][]byte{datagen.GenRandomByteArray(100), datagen.GenRandomByteArray(100)},
+				Epoch:          epoch,
+			},
+		}
+	}
+	finalized := newSubmission(1)
+	confirmed := newSubmission(2)
+	unconfirmed := newSubmission(3)
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Epochs: []types.EpochEntry{
+			{EpochNum: 1, Data: types.EpochData{Key: []*types.SubmissionKey{&finalized.Key}, Status: types.Finalized, RawCheckpoint: datagen.GenRandomByteArray(10)}},
+			{EpochNum: 2, Data: types.EpochData{Key: []*types.SubmissionKey{&confirmed.Key}, Status: types.Confirmed, RawCheckpoint: datagen.GenRandomByteArray(10)}},
+			{EpochNum: 3, Data: types.EpochData{Key: []*types.SubmissionKey{&unconfirmed.Key}, Status: types.Submitted, RawCheckpoint: datagen.GenRandomByteArray(10)}},
+		},
+		Submissions:            []types.SubmissionEntry{finalized, confirmed, unconfirmed},
+		UnconfirmedSubmissions: []types.SubmissionKey{unconfirmed.Key},
+		ConfirmedSubmissions:   []types.SubmissionKey{confirmed.Key},
+		FinalizedSubmissions:   []types.SubmissionKey{finalized.Key},
+		Rewards: []types.ReporterReward{{
+			EpochNum:      1,
+			SubmissionKey: finalized.Key,
+			Submitter:     sdk.AccAddress(finalized.Data.Submitter).String(),
+			BtcHeight:     10,
+			Amount:        sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		}},
+		EpochRewards: []types.EpochReward{
+			{EpochNum: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+			{EpochNum: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	btccheckpoint.InitGenesis(ctx, app.BtcCheckpointKeeper, genesisState)
+	exported := btccheckpoint.ExportGenesis(ctx, app.BtcCheckpointKeeper)
+	require.NoError(t, exported.Validate())
+
+	require.Equal(t, genesisState.Params, exported.Params)
+	require.Equal(t, genesisState.Epochs, exported.Epochs)
+	require.ElementsMatch(t, genesisState.Submissions, exported.Submissions)
+	require.Equal(t, genesisState.UnconfirmedSubmissions, exported.UnconfirmedSubmissions)
+	require.Equal(t, genesisState.ConfirmedSubmissions, exported.ConfirmedSubmissions)
+	require.Equal(t, genesisState.FinalizedSubmissions, exported.FinalizedSubmissions)
+	require.Equal(t, genesisState.Rewards, exported.Rewards)
+	require.Equal(t, genesisState.EpochRewards, exported.EpochRewards)
+	require.Equal(t, &unconfirmed.Data, app.BtcCheckpointKeeper.GetSubmissionData(ctx, unconfirmed.Key))
+}
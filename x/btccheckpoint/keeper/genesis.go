@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAllEpochData returns the data of all epochs which have received submissions,
+// in ascending epoch order
+func (k Keeper) GetAllEpochData(ctx sdk.Context) []types.EpochEntry {
+	epochs := []types.EpochEntry{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochDataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epochNum := sdk.BigEndianToUint64(iterator.Key()[len(types.EpochDataPrefix):])
+		var ed types.EpochData
+		k.cdc.MustUnmarshal(iterator.Value(), &ed)
+		epochs = append(epochs, types.EpochEntry{EpochNum: epochNum, Data: ed})
+	}
+	return epochs
+}
+
+// GetAllSubmissions returns the data of all submissions along with their keys
+func (k Keeper) GetAllSubmissions(ctx sdk.Context) []types.SubmissionEntry {
+	submissions := []types.SubmissionEntry{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubmisionKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sk types.SubmissionKey
+		k.cdc.MustUnmarshal(iterator.Key()[len(types.SubmisionKeyPrefix):], &sk)
+		var sd types.SubmissionData
+		k.cdc.MustUnmarshal(iterator.Value(), &sd)
+		submissions = append(submissions, types.SubmissionEntry{Key: sk, Data: sd})
+	}
+	return submissions
+}
+
+// SetEpochData stores the data of the provided epoch
+func (k Keeper) SetEpochData(ctx sdk.Context, e uint64, ed types.EpochData) {
+	k.saveEpochData(ctx, e, &ed)
+}
+
+// SetSubmission stores the data of the submission under the provided key.
+// The submission should also be added to one of the unconfirmed, confirmed or finalized indices.
+func (k Keeper) SetSubmission(ctx sdk.Context, sk types.SubmissionKey, sd types.SubmissionData) {
+	k.saveSubmission(ctx, sk, sd)
+}
+
+// SetUnconfirmedSubmission adds the submission to the index of unconfirmed submissions
+func (k Keeper) SetUnconfirmedSubmission(ctx sdk.Context, sk types.SubmissionKey) {
+	k.addToUnconfirmed(ctx, sk)
+}
+
+// SetConfirmedSubmission adds the submission to the index of confirmed submissions
+func (k Keeper) SetConfirmedSubmission(ctx sdk.Context, sk types.SubmissionKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConfirmedSubmissionsKey(k.cdc, &sk), k.cdc.MustMarshal(&sk))
+}
+
+// SetFinalizedSubmission adds the submission to the index of finalized submissions
+func (k Keeper) SetFinalizedSubmission(ctx sdk.Context, sk types.SubmissionKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FinalizedSubmissionsKey(k.cdc, &sk), k.cdc.MustMarshal(&sk))
+}
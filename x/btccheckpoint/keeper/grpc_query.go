@@ -5,8 +5,13 @@ import (
 	"errors"
 	"math"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -79,3 +84,210 @@ func (k Keeper) BtcCheckpointHeight(c context.Context, req *types.QueryBtcCheckp
 
 	return &types.QueryBtcCheckpointHeightResponse{EarliestBtcBlockNumber: lowestHeaderNumber}, nil
 }
+
+// submissionDepth returns whether all the BTC blocks of the submission are on the
+// BTC main chain and, if so, the depth of the least deep of them
+func (k Keeper) submissionDepth(ctx sdk.Context, subKey *types.SubmissionKey) (bool, uint64, error) {
+	var onMain = true
+	var lowestDepth uint64 = math.MaxUint64
+
+	for _, tk := range subKey.Key {
+		depth, onMainChain, err := k.MainChainDepth(ctx, tk.Hash)
+		if err != nil {
+			return false, 0, err
+		}
+
+		if !onMainChain {
+			onMain = false
+		}
+
+		if depth < lowestDepth {
+			lowestDepth = depth
+		}
+	}
+
+	if !onMain {
+		return false, 0, nil
+	}
+
+	return true, lowestDepth, nil
+}
+
+func (k Keeper) EpochSubmissions(c context.Context, req *types.QueryEpochSubmissionsRequest) (*types.QueryEpochSubmissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochData := k.GetEpochData(ctx, req.GetEpochNum())
+
+	if epochData == nil {
+		return nil, status.Errorf(codes.NotFound, "no submissions for epoch %d", req.GetEpochNum())
+	}
+
+	submissions := []*types.SubmissionInfo{}
+
+	for _, submissionKey := range epochData.Key {
+		submissionData := k.GetSubmissionData(ctx, *submissionKey)
+
+		if submissionData == nil {
+			// every submission key of an epoch should have its submission data
+			panic("Inconsistent data model in btc checkpoint")
+		}
+
+		onMainChain, depth, err := k.submissionDepth(ctx, submissionKey)
+
+		if err != nil {
+			// submission is no longer known to the light client, ignore it
+			continue
+		}
+
+		submissions = append(submissions, &types.SubmissionInfo{
+			SubmissionKey: *submissionKey,
+			Submitter:     sdk.AccAddress(submissionData.Submitter).String(),
+			OnMainChain:   onMainChain,
+			Depth:         depth,
+		})
+	}
+
+	return &types.QueryEpochSubmissionsResponse{EpochStatus: epochData.Status, Submissions: submissions}, nil
+}
+
+// lowestBlockHash returns the hash of the lowest btc block including a transaction
+// of the submission
+func (k Keeper) lowestBlockHash(ctx sdk.Context, subKey *types.SubmissionKey) (*bbn.BTCHeaderHashBytes, error) {
+	var lowest *bbn.BTCHeaderHashBytes
+	var lowestHeight uint64 = math.MaxUint64
+
+	for _, tk := range subKey.Key {
+		height, err := k.GetBlockHeight(ctx, tk.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if height < lowestHeight {
+			lowest = tk.Hash
+			lowestHeight = height
+		}
+	}
+
+	return lowest, nil
+}
+
+func (k Keeper) EpochInclusionProof(c context.Context, req *types.QueryEpochInclusionProofRequest) (*types.QueryEpochInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochData := k.GetEpochData(ctx, req.GetEpochNum())
+
+	if epochData == nil {
+		return nil, status.Errorf(codes.NotFound, "no submissions for epoch %d", req.GetEpochNum())
+	}
+
+	best, _ := k.bestSubmission(ctx, epochData)
+
+	if best == nil {
+		return nil, status.Errorf(codes.NotFound, "no submission of epoch %d on btc main chain", req.GetEpochNum())
+	}
+
+	submissionData := k.GetSubmissionData(ctx, *best)
+
+	if submissionData == nil {
+		// every submission key of an epoch should have its submission data
+		panic("Inconsistent data model in btc checkpoint")
+	}
+
+	// proofs are not known for submissions received before they started being stored
+	if len(submissionData.Proofs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "proofs of the submission of epoch %d are not stored", req.GetEpochNum())
+	}
+
+	lowestHash, err := k.lowestBlockHash(ctx, best)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	headers, err := k.btcLightClientKeeper.MainChainFrom(ctx, lowestHash)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// submissions of the same epoch might be signed by different subsets of the
+	// validators, so the checkpoint is taken from the transactions of the submission
+	sub, err := types.ParseProofs(nil, submissionData.Proofs, k.GetPowLimit(), k.GetExpectedTag())
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ckpt, err := ckpttypes.FromBTCCkptBytesToRawCkpt(sub.GetRawCheckPointBytes())
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	valSet, err := k.checkpointingKeeper.GetBLSPubKeySet(ctx, req.GetEpochNum())
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	signers, _, err := valSet.FindSubsetWithPowerSum(ckpt.Bitmap)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	signerKeys, err := signers.GetBLSKeySet()
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	aggrPk, err := bls12381.AggrPKList(signerKeys)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proof := &types.EpochInclusionProof{
+		RawCheckpoint: ckpt,
+		BlsAggrPk:     &aggrPk,
+		ValidatorSet:  valSet,
+		Proofs:        submissionData.Proofs,
+		BtcHeaders:    headers,
+	}
+
+	return &types.QueryEpochInclusionProofResponse{Proof: proof}, nil
+}
+
+func (k Keeper) Rewards(c context.Context, req *types.QueryRewardsRequest) (*types.QueryRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards := []types.ReporterReward{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReporterRewardPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var rr types.ReporterReward
+		if err := k.cdc.Unmarshal(value, &rr); err != nil {
+			return err
+		}
+		rewards = append(rewards, rr)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardsResponse{Rewards: rewards, Pagination: pageRes}, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/babylonchain/babylon/x/btccheckpoint/verifier"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryEpochSubmissions(t *testing.T) {
+	epoch := uint64(1)
+	depth := int64(2)
+	checkpointData := getRandomCheckpointDataForEpoch(epoch)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		checkpointData.epoch,
+		checkpointData.lastCommitHash,
+		checkpointData.bitmap,
+		checkpointData.blsSig,
+		checkpointData.submitterAddress,
+	)
+
+	blck1 := dg.CreateBlock(1, 7, 7, data1)
+	blck2 := dg.CreateBlock(2, 14, 3, data2)
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(depth)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+	sdkCtx := sdk.WrapSDKContext(ctx)
+
+	// there is no data for an epoch without submissions
+	_, err := k.EpochSubmissions(sdkCtx, &btcctypes.QueryEpochSubmissionsRequest{EpochNum: epoch})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	pk, _ := dg.NewPV().GetPubKey()
+	address := sdk.AccAddress(pk.Address().Bytes())
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    BlockCreationResultToProofs([]*dg.BlockCreationResult{blck1, blck2}),
+		Submitter: address.String(),
+	}
+	srv := bkeeper.NewMsgServerImpl(*k)
+	_, err = srv.InsertBTCSpvProof(sdkCtx, &msg)
+	require.NoError(t, err)
+
+	resp, err := k.EpochSubmissions(sdkCtx, &btcctypes.QueryEpochSubmissionsRequest{EpochNum: epoch})
+	require.NoError(t, err)
+	require.Equal(t, btcctypes.Submitted, resp.EpochStatus)
+	require.Len(t, resp.Submissions, 1)
+
+	ed := k.GetEpochData(ctx, epoch)
+	submission := resp.Submissions[0]
+	require.Equal(t, *ed.Key[0], submission.SubmissionKey)
+	require.Len(t, submission.SubmissionKey.Key, 2)
+	require.Equal(t, blck1.BbnTxIndex, submission.SubmissionKey.Key[0].Index)
+	require.Equal(t, blck2.BbnTxIndex, submission.SubmissionKey.Key[1].Index)
+	require.Equal(t, address.String(), submission.Submitter)
+	require.True(t, submission.OnMainChain)
+	require.Equal(t, uint64(depth), submission.Depth)
+
+	// submissions with blocks that are not on the main chain have no depth
+	lc.SetDepth(-1)
+	resp, err = k.EpochSubmissions(sdkCtx, &btcctypes.QueryEpochSubmissionsRequest{EpochNum: epoch})
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 1)
+	require.False(t, resp.Submissions[0].OnMainChain)
+	require.Equal(t, uint64(0), resp.Submissions[0].Depth)
+}
+
+func TestQueryEpochInclusionProof(t *testing.T) {
+	epoch := uint64(1)
+	ckpt, valSet := dg.GenSignedRawCheckpoint(epoch, 4, 3)
+
+	pk, _ := dg.NewPV().GetPubKey()
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		ckpt.EpochNum,
+		*ckpt.LastCommitHash,
+		ckpt.Bitmap,
+		*ckpt.BlsMultiSig,
+		address,
+	)
+
+	// both parts are followed by two blocks on the main chain
+	blocks := []*dg.BlockCreationResult{
+		dg.CreateBlock(1, 7, 7, data1),
+		dg.CreateBlock(2, 14, 3, data2),
+		dg.CreateBlock(3, 0, 0, nil),
+		dg.CreateBlock(4, 0, 0, nil),
+	}
+	dg.ChainBlocks(blocks)
+
+	var mainChain []bbn.BTCHeaderBytes
+	for _, b := range blocks {
+		mainChain = append(mainChain, b.HeaderBytes)
+	}
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(2)
+	lc.SetMainChain(mainChain)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	cc.SetValidatorSet(valSet)
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+	sdkCtx := sdk.WrapSDKContext(ctx)
+
+	// there is no proof for an epoch without submissions
+	_, err := k.EpochInclusionProof(sdkCtx, &btcctypes.QueryEpochInclusionProofRequest{EpochNum: epoch})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    BlockCreationResultToProofs(blocks[:2]),
+		Submitter: address.String(),
+	}
+	srv := bkeeper.NewMsgServerImpl(*k)
+	_, err = srv.InsertBTCSpvProof(sdkCtx, &msg)
+	require.NoError(t, err)
+
+	resp, err := k.EpochInclusionProof(sdkCtx, &btcctypes.QueryEpochInclusionProofRequest{EpochNum: epoch})
+	require.NoError(t, err)
+
+	proof := resp.Proof
+	require.True(t, ckpt.Equal(proof.RawCheckpoint))
+	require.Equal(t, valSet, proof.ValidatorSet)
+	require.Equal(t, msg.Proofs, proof.Proofs)
+	require.Equal(t, mainChain, proof.BtcHeaders)
+
+	// the proof is self-contained
+	verified, err := verifier.VerifyEpochInclusionProof(proof, txformat.MainTag(), chaincfg.SimNetParams.PowLimit, ckpttypes.DefaultQuorumThreshold)
+	require.NoError(t, err)
+	require.True(t, ckpt.Equal(verified.Checkpoint))
+	require.Equal(t, *blocks[1].HeaderBytes.Hash(), verified.BtcBlockHash)
+	require.Equal(t, uint64(2), verified.Depth)
+	require.Equal(t, *blocks[3].HeaderBytes.Hash(), verified.TipHash)
+}
@@ -20,16 +20,22 @@ var _ HandledHooks = Hooks{}
 
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
-func (h Hooks) AfterBTCRollBack(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {
-	h.k.OnTipChange(ctx)
-}
+func (h Hooks) AfterBTCRollBack(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {}
+
+func (h Hooks) AfterBTCRollForward(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {}
 
-func (h Hooks) AfterBTCRollForward(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {
+// AfterBTCTipUpdated updates the status of the submissions once for every insertion
+// of headers which changes the tip, instead of once per header added to the main chain
+func (h Hooks) AfterBTCTipUpdated(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {
 	h.k.OnTipChange(ctx)
 }
 
 func (h Hooks) AfterBTCHeaderInserted(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {}
 
+func (h Hooks) BeforeBTCHeaderPruned(ctx sdk.Context, headerInfo *ltypes.BTCHeaderInfo) {
+	h.k.OnHeaderPruned(ctx, headerInfo.Hash)
+}
+
 func (h Hooks) AfterEpochBegins(ctx sdk.Context, epoch uint64) {}
 
 func (h Hooks) AfterEpochEnds(ctx sdk.Context, epoch uint64) {}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"math/big"
 
@@ -22,6 +24,8 @@ type (
 		paramstore            paramtypes.Subspace
 		btcLightClientKeeper  types.BTCLightClientKeeper
 		checkpointingKeeper   types.CheckpointingKeeper
+		bankKeeper            types.BankKeeper
+		feeCollectorName      string
 		powLimit              *big.Int
 		expectedCheckpointTag txformat.BabylonTag
 	}
@@ -34,6 +38,8 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bk types.BTCLightClientKeeper,
 	ck types.CheckpointingKeeper,
+	bankKeeper types.BankKeeper,
+	feeCollectorName string,
 	// TODO: Those are node level constants should go to some kind of global node config
 	powLimit *big.Int,
 	expectedTag txformat.BabylonTag,
@@ -50,6 +56,8 @@ func NewKeeper(
 		paramstore:            ps,
 		btcLightClientKeeper:  bk,
 		checkpointingKeeper:   ck,
+		bankKeeper:            bankKeeper,
+		feeCollectorName:      feeCollectorName,
 		powLimit:              powLimit,
 		expectedCheckpointTag: expectedTag,
 	}
@@ -105,7 +113,7 @@ func (k Keeper) GetCheckpointEpoch(ctx sdk.Context, c []byte) (uint64, error) {
 
 func (k Keeper) SubmissionExists(ctx sdk.Context, sk types.SubmissionKey) bool {
 	store := ctx.KVStore(k.storeKey)
-	kBytes := k.cdc.MustMarshal(&sk)
+	kBytes := types.PrefixedSubmisionKey(k.cdc, &sk)
 	return store.Has(kBytes)
 }
 
@@ -191,6 +199,12 @@ func (k Keeper) saveSubmission(ctx sdk.Context, sk types.SubmissionKey, sd types
 	kBytes := types.PrefixedSubmisionKey(k.cdc, &sk)
 	sBytes := k.cdc.MustMarshal(&sd)
 	store.Set(kBytes, sBytes)
+
+	// index the submission by the blocks including its transactions, so that
+	// the submissions affected by the pruning of a header are found directly
+	for _, h := range sk.GetKeyBlockHashes() {
+		store.Set(types.BlockHashIndexKey(k.cdc, h, &sk), k.cdc.MustMarshal(&sk))
+	}
 }
 
 // GetSubmissionData return submission data for given key, return nil if there is not data
@@ -307,45 +321,42 @@ func (k Keeper) promoteConfirmedToFinalized(ctx sdk.Context, sk types.Submission
 func (k Keeper) checkUnconfirmed(ctx sdk.Context) {
 
 	newConfirmed := []types.SubmissionKey{}
+	forgotten := []types.SubmissionKey{}
+	// epochs of all unconfirmed submissions, as any of them could have lost or regained
+	// its submissions on the main chain
+	epochs := map[uint64]bool{}
 
-	store := ctx.KVStore(k.storeKey)
-
-	// iterator over all unconfirmed submissions
-	iterator := sdk.KVStorePrefixIterator(store, types.UnconfirmedIndexPrefix)
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		skBytes := iterator.Value()
-		var sk types.SubmissionKey
-		k.cdc.MustUnmarshal(skBytes, &sk)
+	for _, sk := range k.GetAllUnconfirmedSubmissions(ctx) {
+		sd := k.getSubmissionDataExists(ctx, sk)
+		epochs[sd.Epoch] = true
 
 		onMainChain, deepEnough, err := k.checkSubmissionConfirmed(ctx, sk)
 
 		if err != nil {
-			// submission which was known to lighclient is no longer known
-			// TODO Decide how to best handle submissions forgotten by light client
-			// There are two main options:
-			// 1. Delete them - probably best in terms of space usage, but a lot of indexes
-			// need updating when deleting submissions.
-			// 2. Mark them as forgotten - they would linger a bit longer but the only
-			// index needed updaing in Submitted
-			// Whatever route will be taken, one need to remeber to inform checkpointing
-			// module if all checkpoints from epoch will be lost
+			// submission which was known to lighclient is no longer known, so it will
+			// never be on the main chain again. Such submissions are removed, so that
+			// they do not keep the epoch in submitted state
+			forgotten = append(forgotten, sk)
 			continue
 		}
 
-		// TODO Add handling of the case when onMainChain is false, which requires checking
-		// state of this submission epoch data, and if its Submitted it means that,
-		// submission which was on main chain suddenly became orphaned. If all submissions
-		// of the epoch become orphaned we need to inform checkpoinitng module about it
-
 		if onMainChain && deepEnough {
 			// we have new confirmed submission
 			newConfirmed = append(newConfirmed, sk)
 		}
 	}
 
+	for _, sk := range forgotten {
+		k.removeSubmission(ctx, types.UnconfirmedIndexPrefix, sk)
+	}
+
+	// Submissions of submitted epochs could have become orphaned and submissions of
+	// signed epochs could have become part of the main chain due to btc reorgs.
+	// Epochs are processed in ascending order so that the state transitions are deterministic
+	for _, epoch := range sortedEpochs(epochs) {
+		k.updateEpochSubmittedStatus(ctx, epoch)
+	}
+
 	if len(newConfirmed) == 0 {
 		// no new confirmed sumbmissions
 		return
@@ -400,13 +411,8 @@ func (k Keeper) checkUnconfirmed(ctx sdk.Context) {
 		k.saveEpochData(ctx, sd.Epoch, ed)
 		k.checkpointingKeeper.SetCheckpointConfirmed(ctx, sd.Epoch)
 
-		// TODO Rewards.
-		// 1. Check if any other submission from this epoch did not become confirmed
-		// 2. If yes, determine the better one of the newly confirmed submissions
-		// Definition of `better` need some tought. Shoud those be firstly submitted
-		// submission, or maybe submission which is oldest on btc chain  ?
-		// 3. Pay the reward to the best submission. It will probably require mint
-		// keeper os smth like that
+		// Rewards are paid when the epoch is finalized, as until then the winning
+		// submission can change due to btc reorgs
 	}
 
 	for _, newConfirmedSubKey := range newConfirmed {
@@ -507,6 +513,8 @@ func (k Keeper) checkConfirmed(ctx sdk.Context) {
 		k.promoteConfirmedToFinalized(ctx, newFinalizedSubKey)
 	}
 
+	k.rewardReporters(ctx, sortedEpochs(newFinalizedEpochs))
+
 }
 
 func (k Keeper) getSubmissionsWithPrefix(ctx sdk.Context, prefix []byte) []types.SubmissionKey {
@@ -540,6 +548,123 @@ func (k Keeper) GetAllFinalizedSubmissions(ctx sdk.Context) []types.SubmissionKe
 	return k.getSubmissionsWithPrefix(ctx, types.FinalizedIndexPrefix)
 }
 
+// removeSubmission removes the submission from the provided index, the submissions storage
+// and the list of submissions of its epoch.
+func (k Keeper) removeSubmission(ctx sdk.Context, indexPrefix []byte, sk types.SubmissionKey) {
+	store := ctx.KVStore(k.storeKey)
+	subKey := k.cdc.MustMarshal(&sk)
+	sd := k.getSubmissionDataExists(ctx, sk)
+
+	ed := k.GetEpochData(ctx, sd.Epoch)
+	if ed == nil {
+		panic("Submission without existing epoch")
+	}
+	var remainingKeys []*types.SubmissionKey
+	for _, epochKey := range ed.Key {
+		if !bytes.Equal(k.cdc.MustMarshal(epochKey), subKey) {
+			remainingKeys = append(remainingKeys, epochKey)
+		}
+	}
+	ed.Key = remainingKeys
+
+	store.Delete(append(indexPrefix, subKey...))
+	store.Delete(types.PrefixedSubmisionKey(k.cdc, &sk))
+	for _, h := range sk.GetKeyBlockHashes() {
+		store.Delete(types.BlockHashIndexKey(k.cdc, h, &sk))
+	}
+	k.saveEpochData(ctx, sd.Epoch, ed)
+}
+
+// hasSubmissionOnMainChain checks if at least one of the submissions of the epoch
+// is known to the light client and on the main chain
+func (k Keeper) hasSubmissionOnMainChain(ctx sdk.Context, ed *types.EpochData) bool {
+	for _, sk := range ed.Key {
+		onMainChain, err := k.checkSubmissionOnMainChain(ctx, *sk)
+		if err == nil && onMainChain {
+			return true
+		}
+	}
+	return false
+}
+
+// updateEpochSubmittedStatus handles btc reorgs for the given epoch:
+// - a submitted epoch without any submission on the main chain reverts to signed,
+// and the checkpointing module is informed that its checkpoint was forgotten
+// - a signed epoch with a submission on the main chain becomes submitted,
+// and the checkpointing module is informed that its checkpoint was submitted
+// Confirmed and finalized epochs are not affected.
+func (k Keeper) updateEpochSubmittedStatus(ctx sdk.Context, epoch uint64) {
+	ed := k.GetEpochData(ctx, epoch)
+
+	if ed == nil {
+		// if we do not have any data about epoch, something is really wrong with
+		// data model
+		panic("Submission without existing epoch")
+	}
+
+	switch ed.Status {
+	case types.Submitted:
+		if k.hasSubmissionOnMainChain(ctx, ed) {
+			return
+		}
+		ed.Status = types.Signed
+		k.saveEpochData(ctx, epoch, ed)
+		k.checkpointingKeeper.SetCheckpointForgotten(ctx, epoch)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventCheckpointOrphaned{EpochNum: epoch})
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit checkpoint orphaned event", "epoch", epoch, "error", err)
+		}
+	case types.Signed:
+		if !k.hasSubmissionOnMainChain(ctx, ed) {
+			return
+		}
+		ed.Status = types.Submitted
+		k.saveEpochData(ctx, epoch, ed)
+		k.checkpointingKeeper.SetCheckpointSubmitted(ctx, epoch)
+	}
+}
+
+// sortedEpochs returns the epochs of the set in ascending order
+func sortedEpochs(epochs map[uint64]bool) []uint64 {
+	sorted := make([]uint64, 0, len(epochs))
+	for epoch := range epochs {
+		sorted = append(sorted, epoch)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// OnHeaderPruned removes the unconfirmed submissions which include a transaction
+// from the header that the btc light client is about to prune, as they will never
+// be known to the light client again.
+// Confirmed and finalized submissions are kept, as the epochs they confirmed do not
+// revert. A confirmed submission on the main chain is finalized once it is
+// CheckpointFinalizationTimeout deep, which is before its headers are pruned as long
+// as the retention depths of the light client are larger.
+// Submitted epochs which lose all their submissions on the main chain revert to signed.
+func (k Keeper) OnHeaderPruned(ctx sdk.Context, hash *bbn.BTCHeaderHashBytes) {
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.BlockHashIndexPrefixKey(hash)
+
+	epochs := map[uint64]bool{}
+	for _, sk := range k.getSubmissionsWithPrefix(ctx, indexPrefix) {
+		if !store.Has(types.UnconfiredSubmissionsKey(k.cdc, &sk)) {
+			continue
+		}
+		epochs[k.getSubmissionDataExists(ctx, sk).Epoch] = true
+		k.removeSubmission(ctx, types.UnconfirmedIndexPrefix, sk)
+	}
+
+	// the header is never known again, so the index of the kept submissions is not needed
+	for _, sk := range k.getSubmissionsWithPrefix(ctx, indexPrefix) {
+		store.Delete(types.BlockHashIndexKey(k.cdc, hash, &sk))
+	}
+
+	for _, epoch := range sortedEpochs(epochs) {
+		k.updateEpochSubmittedStatus(ctx, epoch)
+	}
+}
+
 // Callback to be called when btc light client tip change
 func (k Keeper) OnTipChange(ctx sdk.Context) {
 	k.checkUnconfirmed(ctx)
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	btypes "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -35,44 +37,42 @@ func (m msgServer) isAncestor(ctx sdk.Context, fh *btypes.BTCHeaderHashBytes, sh
 	return isFirstAncestor || isSecondAncestor, nil
 }
 
-// Gets proof height in context of btclightclilent, also if proof is composed
-// from two different blocks checks that they are on the same fork.
+// Checks that all the blocks of the proofs are known to btclightclilent, also if proof
+// is composed from different blocks checks that they are on the same fork.
 func (m msgServer) checkAllHeadersAreKnown(ctx sdk.Context, rawSub *types.RawCheckpointSubmission) error {
-	fh := rawSub.GetFirstBlockHash()
-	sh := rawSub.GetSecondBlockHash()
-
-	if fh.Eq(&sh) {
-		// both hashes are the same which means, two transactions with their respective
-		// proofs were provided in the same block. We only need to check one block for
-		// for height
-		if m.k.CheckHeaderIsKnown(ctx, &fh) {
-			return nil
-		} else {
-			return types.ErrUnknownHeader
+	hashes := rawSub.GetBlockHashes()
+
+	for i := range hashes {
+		if i > 0 && hashes[i].Eq(&hashes[i-1]) {
+			// transaction was provided in the same block as the previous one, which
+			// was already checked
+			continue
 		}
-	}
 
-	// at this point we know that both transactions were in different blocks.
-	// we need to check two things:
-	// - if both blocks are known to header oracle
-	// - if both blocks are on the same fork i.e if second block is descendant of the
-	// first block
-	for _, hash := range []btypes.BTCHeaderHashBytes{fh, sh} {
-		if !m.k.CheckHeaderIsKnown(ctx, &hash) {
+		if !m.k.CheckHeaderIsKnown(ctx, &hashes[i]) {
 			return types.ErrUnknownHeader
 		}
 	}
 
-	// we have checked earlier that both blocks are known to header light client,
-	// so no need to check err.
-	isAncestor, err := m.isAncestor(ctx, &fh, &sh)
+	// at this point we know that all blocks are known to header oracle.
+	// we need to check if all blocks are on the same fork i.e if every block is
+	// either the same or descendant of the block including the previous part
+	for i := 1; i < len(hashes); i++ {
+		if hashes[i].Eq(&hashes[i-1]) {
+			continue
+		}
 
-	if err != nil {
-		panic("Headers which are should have been known to btclight client")
-	}
+		// we have checked earlier that all blocks are known to header light client,
+		// so no need to check err.
+		isAncestor, err := m.isAncestor(ctx, &hashes[i-1], &hashes[i])
 
-	if !isAncestor {
-		return types.ErrProvidedHeaderFromDifferentForks
+		if err != nil {
+			panic("Headers which are should have been known to btclight client")
+		}
+
+		if !isAncestor {
+			return types.ErrProvidedHeaderFromDifferentForks
+		}
 	}
 
 	return nil
@@ -125,10 +125,10 @@ func (m msgServer) checkHeaderIsDescentantOfPreviousEpoch(
 	rawSub *types.RawCheckpointSubmission) bool {
 
 	for _, sub := range previousEpochSubmissions {
-		// This should always be true, if we have some submission key composed from
-		// less than 2 transaction keys in previous epoch, something went really wrong
-		if len(sub.Key) < 2 {
-			panic("Submission key composed of less than 2 transactions keys in database")
+		// This should always be true, if we have some submission key without any
+		// transaction keys in previous epoch, something went really wrong
+		if len(sub.Key) < 1 {
+			panic("Submission key without any transaction keys in database")
 		}
 
 		hs := sub.GetKeyBlockHashes()
@@ -194,7 +194,7 @@ func (m msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertBT
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
 	}
 
-	rawSubmission, e := types.ParseTwoProofs(address, req.Proofs, m.k.GetPowLimit(), m.k.GetExpectedTag())
+	rawSubmission, e := types.ParseProofs(address, req.Proofs, m.k.GetPowLimit(), m.k.GetExpectedTag())
 
 	if e != nil {
 		return nil, types.ErrInvalidCheckpointProof
@@ -203,6 +203,11 @@ func (m msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertBT
 	// Get the SDK wrapped context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// format versions are rolled out and retired through governance
+	if !m.k.GetParams(sdkCtx).IsFormatVersionAccepted(rawSubmission.Version) {
+		return nil, types.ErrFormatVersionNotAccepted.Wrapf("version %d", rawSubmission.Version)
+	}
+
 	submissionKey := rawSubmission.GetSubmissionKey()
 
 	if m.k.SubmissionExists(sdkCtx, submissionKey) {
@@ -224,6 +229,13 @@ func (m msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertBT
 	// Inform checkpointing module about it.
 	epochNum, err := m.k.GetCheckpointEpoch(sdkCtx, rawCheckpointBytes)
 
+	if errors.Is(err, ckpttypes.ErrConflictingCheckpoint) {
+		// The checkpointing module has recorded the evidence of the conflicting
+		// checkpoint. The message succeeds so that the evidence is committed, but
+		// the submission is not stored as it does not checkpoint our chain.
+		return &types.MsgInsertBTCSpvProofResponse{}, nil
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +46,30 @@ func BlockCreationResultToProofs(inputs []*dg.BlockCreationResult) []*btcctypes.
 	return spvs
 }
 
+// blockCreationResultToWitnessProof creates proof of the babylon transaction through
+// the witness commitment of the block
+func blockCreationResultToWitnessProof(input *dg.BlockCreationResult) *btcctypes.BTCSpvProof {
+	var txBytes [][]byte
+
+	for _, t := range input.Transactions {
+		tbytes, err := hex.DecodeString(t)
+
+		if err != nil {
+			panic("Inputs should contain valid hex encoded transactions")
+		}
+
+		txBytes = append(txBytes, tbytes)
+	}
+
+	spv, err := btcctypes.WitnessSpvProofFromHeaderAndTransactions(input.HeaderBytes, txBytes, uint(input.BbnTxIndex))
+
+	if err != nil {
+		panic("Inputs should contain valid spv hex encoded data")
+	}
+
+	return spv
+}
+
 type testCheckpointData struct {
 	epoch            uint64
 	lastCommitHash   []byte
@@ -183,6 +207,280 @@ func TestSubmitValidNewCheckpoint(t *testing.T) {
 	}
 }
 
+func TestSubmitConflictingCheckpoint(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	checkpointData := getRandomCheckpointDataForEpoch(epoch)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		checkpointData.epoch,
+		checkpointData.lastCommitHash,
+		checkpointData.bitmap,
+		checkpointData.blsSig,
+		checkpointData.submitterAddress,
+	)
+
+	blck1 := dg.CreateBlock(1, 7, 7, data1)
+	blck2 := dg.CreateBlock(2, 14, 3, data2)
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(int64(kDeep) - 1)
+
+	// the checkpointing module records the evidence of the conflicting checkpoint
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	cc.ReturnConflict()
+
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+
+	pk, _ := dg.NewPV().GetPubKey()
+
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    BlockCreationResultToProofs([]*dg.BlockCreationResult{blck1, blck2}),
+		Submitter: sdk.AccAddress(pk.Address().Bytes()).String(),
+	}
+
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	// the message succeeds so that the evidence is committed
+	_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	// but the conflicting checkpoint is not stored as a submission
+	if ed := k.GetEpochData(ctx, epoch); ed != nil {
+		t.Errorf("Conflicting checkpoint should not be stored in epoch %d", epoch)
+	}
+
+	if len(k.GetAllUnconfirmedSubmissions(ctx)) != 0 {
+		t.Errorf("Conflicting checkpoint should not be stored as unconfirmed submission")
+	}
+}
+
+func TestSubmitValidNPartsCheckpoint(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	bitmap := dg.GenRandomByteArray(200)
+
+	parts := txformat.MustEncodeCheckpointDataNParts(
+		txformat.MainTag(),
+		epoch,
+		dg.GenRandomByteArray(txformat.LastCommitHashLength),
+		bitmap,
+		dg.GenRandomByteArray(txformat.BlsSigLength),
+		dg.GenRandomByteArray(txformat.AddressLength),
+	)
+
+	if len(parts) != txformat.NumberOfPartsForBitMap(len(bitmap)) || len(parts) <= txformat.NumberOfParts {
+		t.Fatalf("Checkpoint with large bitmap should be split in more than %d parts", txformat.NumberOfParts)
+	}
+
+	var blocks []*dg.BlockCreationResult
+	for i, part := range parts {
+		blocks = append(blocks, dg.CreateBlock(uint32(i+1), 7, uint32(rand.Intn(7)+1), part))
+	}
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	pk, _ := dg.NewPV().GetPubKey()
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	// parts which are not provided in order are rejected
+	proofs := BlockCreationResultToProofs(blocks)
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	msg := btcctypes.MsgInsertBTCSpvProof{Proofs: proofs, Submitter: address.String()}
+
+	_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if !btcctypes.ErrInvalidCheckpointProof.Is(err) {
+		t.Errorf("Parts provided out of order should lead to ErrInvalidCheckpointProof, got %v", err)
+	}
+
+	// checkpoints in versions which are not accepted are rejected
+	params := k.GetParams(ctx)
+	params.AcceptedFormatVersions = []uint32{uint32(txformat.CurrentVersion)}
+	k.SetParams(ctx, params)
+
+	msg.Proofs = BlockCreationResultToProofs(blocks)
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if !btcctypes.ErrFormatVersionNotAccepted.Is(err) {
+		t.Errorf("Checkpoint in not accepted version should lead to ErrFormatVersionNotAccepted, got %v", err)
+	}
+
+	k.SetParams(ctx, btcctypes.DefaultParams())
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	ed := k.GetEpochData(ctx, epoch)
+
+	if ed == nil || len(ed.Key) != 1 {
+		t.Fatalf("Epoch should contain exactly one submission")
+	}
+
+	if len(ed.Key[0].Key) != len(parts) {
+		t.Errorf("Submission key should contain %d transaction keys, have %d", len(parts), len(ed.Key[0].Key))
+	}
+
+	if len(k.GetSubmissionData(ctx, *ed.Key[0]).Btctransaction) != len(parts) {
+		t.Errorf("Submission data should contain all %d transactions", len(parts))
+	}
+
+	if !bytes.Contains(ed.RawCheckpoint, bitmap) {
+		t.Errorf("Raw checkpoint should contain the bitmap")
+	}
+}
+
+func TestSubmitValidWitnessCheckpoint(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	bitmap := dg.GenRandomByteArray(2000)
+
+	data := txformat.MustEncodeCheckpointDataWitness(
+		txformat.MainTag(),
+		epoch,
+		dg.GenRandomByteArray(txformat.LastCommitHashLength),
+		bitmap,
+		dg.GenRandomByteArray(txformat.BlsSigLength),
+		dg.GenRandomByteArray(txformat.AddressLength),
+	)
+
+	proof := blockCreationResultToWitnessProof(dg.CreateWitnessBlock(1, 7, uint32(rand.Intn(7)+1), data))
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	pk, _ := dg.NewPV().GetPubKey()
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	// without the witness commitment proof the merkle proof is checked against the
+	// transaction merkle tree and the transaction carries no OP_RETURN data
+	invalidProof := *proof
+	invalidProof.WitnessCommitmentProof = nil
+	msg := btcctypes.MsgInsertBTCSpvProof{Proofs: []*btcctypes.BTCSpvProof{&invalidProof}, Submitter: address.String()}
+
+	_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if !btcctypes.ErrInvalidCheckpointProof.Is(err) {
+		t.Errorf("Witness proof without commitment proof should lead to ErrInvalidCheckpointProof, got %v", err)
+	}
+
+	msg.Proofs = []*btcctypes.BTCSpvProof{proof}
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	ed := k.GetEpochData(ctx, epoch)
+
+	if ed == nil || len(ed.Key) != 1 || len(ed.Key[0].Key) != 1 {
+		t.Fatalf("Epoch should contain exactly one submission with one transaction key")
+	}
+
+	if !bytes.Contains(ed.RawCheckpoint, bitmap) {
+		t.Errorf("Raw checkpoint should contain the bitmap")
+	}
+}
+
+func TestSubmitOpReturnCheckpointWithWitnessCommitmentProof(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	raw := getRandomCheckpointDataForEpoch(epoch)
+
+	firstPart, secondPart := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		raw.epoch,
+		raw.lastCommitHash,
+		raw.bitmap,
+		raw.blsSig,
+		raw.submitterAddress,
+	)
+
+	// first part is carried by a segwit transaction and proven through the wtxid
+	// tree, second part by a legacy transaction proven through the txid tree
+	segwitBlock := dg.CreateSegwitOpReturnBlock(1, 7, uint32(rand.Intn(7)+1), firstPart)
+	legacyBlock := dg.CreateBlock(2, 7, uint32(rand.Intn(7)+1), secondPart)
+
+	witnessProof := blockCreationResultToWitnessProof(segwitBlock)
+	txidProofs := BlockCreationResultToProofs([]*dg.BlockCreationResult{segwitBlock, legacyBlock})
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	pk, _ := dg.NewPV().GetPubKey()
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	// proof of wtxid fails if the witness of the transaction is malleated
+	tx, err := btcctypes.ParseTransaction(witnessProof.BtcTransaction)
+	if err != nil {
+		t.Fatalf("Unexpected transaction parsing error: %v", err)
+	}
+	tx.MsgTx().TxIn[0].Witness[0] = dg.GenRandomByteArray(71)
+	var buf bytes.Buffer
+	_ = tx.MsgTx().Serialize(&buf)
+
+	malleatedProof := *witnessProof
+	malleatedProof.BtcTransaction = buf.Bytes()
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    []*btcctypes.BTCSpvProof{&malleatedProof, txidProofs[1]},
+		Submitter: address.String(),
+	}
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if !btcctypes.ErrInvalidCheckpointProof.Is(err) {
+		t.Errorf("Proof of malleated witness should lead to ErrInvalidCheckpointProof, got %v", err)
+	}
+
+	msg.Proofs = []*btcctypes.BTCSpvProof{witnessProof, txidProofs[1]}
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	ed := k.GetEpochData(ctx, epoch)
+
+	if ed == nil || len(ed.Key) != 1 {
+		t.Fatalf("Epoch should contain exactly one submission")
+	}
+
+	expectedOpReturn := getExpectedOpReturn(firstPart, secondPart)
+
+	if !bytes.Equal(ed.RawCheckpoint, expectedOpReturn) {
+		t.Errorf("Epoch should contain the connected op return data")
+	}
+
+	// the same transaction proven through the txid tree is the same submission
+	msg.Proofs = txidProofs
+
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if !btcctypes.ErrDuplicatedSubmission.Is(err) {
+		t.Errorf("Same transactions proven in a different way should lead to ErrDuplicatedSubmission, got %v", err)
+	}
+}
+
 func TestStateTransitionOfValidSubmission(t *testing.T) {
 	rand.Seed(time.Now().Unix())
 	epoch := uint64(1)
@@ -287,3 +585,205 @@ func TestStateTransitionOfValidSubmission(t *testing.T) {
 		t.Errorf("Epoch Data missing of in unexpected state")
 	}
 }
+
+func TestStateTransitionOfOrphanedSubmission(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	checkpointData := getRandomCheckpointDataForEpoch(epoch)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		checkpointData.epoch,
+		checkpointData.lastCommitHash,
+		checkpointData.bitmap,
+		checkpointData.blsSig,
+		checkpointData.submitterAddress,
+	)
+
+	blck1 := dg.CreateBlock(1, 7, 7, data1)
+	blck2 := dg.CreateBlock(2, 14, 3, data2)
+
+	// here we will only have valid unconfirmed submissions
+	lc := btcctypes.NewMockBTCLightClientKeeper(int64(kDeep) - 1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+
+	proofs := BlockCreationResultToProofs([]*dg.BlockCreationResult{blck1, blck2})
+
+	pk, _ := dg.NewPV().GetPubKey()
+
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    proofs,
+		Submitter: address.String(),
+	}
+
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	// the only submission of the epoch is orphaned by a btc reorg
+	lc.SetDepth(-1)
+	k.OnTipChange(ctx)
+
+	ed := k.GetEpochData(ctx, epoch)
+
+	if ed == nil || ed.Status != btcctypes.Signed {
+		t.Errorf("Epoch without submissions on the main chain should revert to signed state")
+	}
+
+	if len(k.GetAllUnconfirmedSubmissions(ctx)) != 1 {
+		t.Errorf("Orphaned submission should be kept as it can become part of the main chain again")
+	}
+
+	orphanedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "babylon.btccheckpoint.v1.EventCheckpointOrphaned" {
+			orphanedEvents++
+		}
+	}
+
+	if orphanedEvents != 1 {
+		t.Errorf("Expected 1 checkpoint orphaned event. Got %d", orphanedEvents)
+	}
+
+	// the submission becomes part of the main chain again
+	lc.SetDepth(int64(kDeep) - 1)
+	k.OnTipChange(ctx)
+
+	ed = k.GetEpochData(ctx, epoch)
+
+	if ed == nil || ed.Status != btcctypes.Submitted {
+		t.Errorf("Epoch with submission on the main chain should be in submitted state")
+	}
+
+	// the submission is forgotten by the light client
+	lc.ReturnError()
+	k.OnTipChange(ctx)
+
+	ed = k.GetEpochData(ctx, epoch)
+
+	if ed == nil || ed.Status != btcctypes.Signed {
+		t.Errorf("Epoch without known submissions should revert to signed state")
+	}
+
+	if len(ed.Key) != 0 {
+		t.Errorf("Forgotten submission should be removed from the epoch")
+	}
+
+	if len(k.GetAllUnconfirmedSubmissions(ctx)) != 0 {
+		t.Errorf("Forgotten submission should be removed from unconfirmed submissions")
+	}
+}
+
+func TestSubmissionRemovedWhenHeaderPruned(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	checkpointData := getRandomCheckpointDataForEpoch(epoch)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		checkpointData.epoch,
+		checkpointData.lastCommitHash,
+		checkpointData.bitmap,
+		checkpointData.blsSig,
+		checkpointData.submitterAddress,
+	)
+
+	blck1 := dg.CreateBlock(1, 7, 7, data1)
+	blck2 := dg.CreateBlock(2, 14, 3, data2)
+
+	// here we will only have valid unconfirmed submissions
+	lc := btcctypes.NewMockBTCLightClientKeeper(int64(kDeep) - 1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+
+	k, ctx := keepertest.NewBTCCheckpointKeeper(t, lc, cc, chaincfg.SimNetParams.PowLimit)
+
+	proofs := BlockCreationResultToProofs([]*dg.BlockCreationResult{blck1, blck2})
+
+	pk, _ := dg.NewPV().GetPubKey()
+
+	address := sdk.AccAddress(pk.Address().Bytes())
+
+	msg := btcctypes.MsgInsertBTCSpvProof{
+		Proofs:    proofs,
+		Submitter: address.String(),
+	}
+
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	ed := k.GetEpochData(ctx, epoch)
+	submissionKey := *ed.Key[0]
+
+	// pruning a header which is not part of the submission does not affect it
+	k.OnHeaderPruned(ctx, dg.GenRandomBTCHeaderPrevBlock())
+
+	if len(k.GetAllUnconfirmedSubmissions(ctx)) != 1 {
+		t.Errorf("Submission removed after pruning an unrelated header")
+	}
+
+	// pruning a header which includes one of the submission transactions removes it
+	k.OnHeaderPruned(ctx, submissionKey.Key[1].Hash)
+
+	if len(k.GetAllUnconfirmedSubmissions(ctx)) != 0 {
+		t.Errorf("Submission not removed after pruning its header")
+	}
+
+	if k.GetSubmissionData(ctx, submissionKey) != nil {
+		t.Errorf("Submission data not removed after pruning its header")
+	}
+
+	ed = k.GetEpochData(ctx, epoch)
+
+	if len(ed.Key) != 0 {
+		t.Errorf("Submission key not removed from epoch data after pruning its header")
+	}
+
+	// a confirmed submission is kept when its header is pruned
+	_, err = srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+
+	if err != nil {
+		t.Fatalf("Unexpected message processing error: %v", err)
+	}
+
+	lc.SetDepth(int64(kDeep))
+	k.OnTipChange(ctx)
+
+	if len(k.GetAllConfirmedSubmissions(ctx)) != 1 {
+		t.Fatalf("Submission not confirmed")
+	}
+
+	k.OnHeaderPruned(ctx, submissionKey.Key[1].Hash)
+
+	if len(k.GetAllConfirmedSubmissions(ctx)) != 1 {
+		t.Errorf("Confirmed submission removed after pruning its header")
+	}
+
+	if k.GetSubmissionData(ctx, submissionKey) == nil {
+		t.Errorf("Confirmed submission data removed after pruning its header")
+	}
+
+	ed = k.GetEpochData(ctx, epoch)
+
+	if len(ed.Key) != 1 || ed.Status != btcctypes.Confirmed {
+		t.Errorf("Confirmed epoch affected by pruning its submission header")
+	}
+}
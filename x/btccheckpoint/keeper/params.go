@@ -11,6 +11,12 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// MaxBitMapLength returns the length of the longest bitmap of the checkpoints which
+// can be encoded in the accepted format versions
+func (k Keeper) MaxBitMapLength(ctx sdk.Context) int {
+	return k.GetParams(ctx).MaxBitMapLength()
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
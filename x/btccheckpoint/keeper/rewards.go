@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FundRewardPool transfers the `ReporterRewardShare` of the block rewards held by the
+// fee collector to the btccheckpoint module account, which pays out the reporters.
+// The transferred funds are set aside for the reporter of the epoch of the block.
+// It needs to be invoked after the mint module has minted the block inflation and
+// before the distribution module has distributed the content of the fee collector.
+func (k Keeper) FundRewardPool(ctx sdk.Context) {
+	share := k.GetParams(ctx).ReporterRewardShare
+	if share.IsZero() {
+		return
+	}
+
+	collected := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(k.feeCollectorName))
+	funds, _ := sdk.NewDecCoinsFromCoins(collected...).MulDecTruncate(share).TruncateDecimal()
+	if funds.IsZero() {
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, funds); err != nil {
+		panic(err)
+	}
+
+	k.addEpochReward(ctx, k.blockEpoch(ctx), funds)
+}
+
+// blockEpoch returns the number of the epoch of the current block.
+// The epoching module increments the epoch number at the beginning of the first block
+// of the epoch, which might not have happened yet when the reward pool is funded.
+func (k Keeper) blockEpoch(ctx sdk.Context) uint64 {
+	epoch := k.checkpointingKeeper.GetEpoch(ctx)
+	if epoch.IsFirstBlockOfNextEpoch(ctx) {
+		return epoch.EpochNumber + 1
+	}
+	return epoch.EpochNumber
+}
+
+// addEpochReward adds the funds to the reward set aside for the reporter of the epoch
+func (k Keeper) addEpochReward(ctx sdk.Context, epoch uint64, funds sdk.Coins) {
+	if funds.IsZero() {
+		return
+	}
+	k.SetEpochReward(ctx, types.EpochReward{
+		EpochNum: epoch,
+		Amount:   k.GetEpochReward(ctx, epoch).Add(funds...),
+	})
+}
+
+// SetEpochReward stores the reward set aside for the reporter of the epoch
+func (k Keeper) SetEpochReward(ctx sdk.Context, er types.EpochReward) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEpochRewardKey(er.EpochNum), k.cdc.MustMarshal(&er))
+}
+
+// GetEpochReward returns the reward set aside for the reporter of the epoch
+func (k Keeper) GetEpochReward(ctx sdk.Context, epoch uint64) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochRewardKey(epoch))
+	if len(bz) == 0 {
+		return sdk.NewCoins()
+	}
+
+	var er types.EpochReward
+	k.cdc.MustUnmarshal(bz, &er)
+	return er.Amount
+}
+
+// GetAllEpochRewards returns the rewards set aside for the reporters of the epochs
+// which are not rewarded yet, sorted by epoch number
+func (k Keeper) GetAllEpochRewards(ctx sdk.Context) []types.EpochReward {
+	rewards := []types.EpochReward{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochRewardPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var er types.EpochReward
+		k.cdc.MustUnmarshal(iterator.Value(), &er)
+		rewards = append(rewards, er)
+	}
+	return rewards
+}
+
+// GetRewardPool returns the funds available for rewarding reporters
+func (k Keeper) GetRewardPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+}
+
+// bestSubmission returns the winning submission of the epoch along with the height of
+// the btc block including its last transaction.
+// The winning submission is the one, among the submissions of the epoch on the btc
+// main chain, whose last transaction is included in the lowest btc block.
+// Ties are broken by the order in which the submissions were received, i.e. the
+// order of the submissions in the epoch data.
+// Returns nil if none of the submissions is on the main chain.
+func (k Keeper) bestSubmission(ctx sdk.Context, ed *types.EpochData) (*types.SubmissionKey, uint64) {
+	var best *types.SubmissionKey
+	var bestHeight uint64
+
+	for _, sk := range ed.Key {
+		onMainChain, err := k.checkSubmissionOnMainChain(ctx, *sk)
+		if err != nil || !onMainChain {
+			continue
+		}
+
+		height, err := k.submissionBtcHeight(ctx, sk)
+		if err != nil {
+			continue
+		}
+
+		if best == nil || height < bestHeight {
+			best = sk
+			bestHeight = height
+		}
+	}
+
+	return best, bestHeight
+}
+
+// submissionBtcHeight returns the height of the highest btc block including
+// a transaction of the submission
+func (k Keeper) submissionBtcHeight(ctx sdk.Context, sk *types.SubmissionKey) (uint64, error) {
+	var height uint64
+	for _, tk := range sk.Key {
+		h, err := k.GetBlockHeight(ctx, tk.Hash)
+		if err != nil {
+			return 0, err
+		}
+		if h > height {
+			height = h
+		}
+	}
+	return height, nil
+}
+
+// rewardReporters pays out the reporters of the winning submissions of the newly
+// finalized epochs. Each reporter receives the reward set aside for its epoch, so that
+// the payout does not depend on how many epochs are finalized in the same block.
+// The reward of an epoch without a winning submission is carried over to the next epoch.
+func (k Keeper) rewardReporters(ctx sdk.Context, epochs []uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, epoch := range epochs {
+		ed := k.GetEpochData(ctx, epoch)
+		if ed == nil {
+			panic("Finalized epoch without existing epoch data")
+		}
+
+		sk, height := k.bestSubmission(ctx, ed)
+		if sk == nil {
+			// finalized epoch should have at least one submission on the main chain,
+			// but it is not worth halting the chain over rewards
+			k.Logger(ctx).Error("finalized epoch without submissions on the main chain", "epoch", epoch)
+			k.addEpochReward(ctx, epoch+1, k.GetEpochReward(ctx, epoch))
+			store.Delete(types.GetEpochRewardKey(epoch))
+			continue
+		}
+
+		reward := k.GetEpochReward(ctx, epoch)
+		store.Delete(types.GetEpochRewardKey(epoch))
+
+		submitter := sdk.AccAddress(k.getSubmissionDataExists(ctx, *sk).Submitter)
+
+		if !reward.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, submitter, reward); err != nil {
+				panic(err)
+			}
+		}
+
+		rr := types.ReporterReward{
+			EpochNum:      epoch,
+			SubmissionKey: *sk,
+			Submitter:     submitter.String(),
+			BtcHeight:     height,
+			Amount:        reward,
+		}
+		k.SetReporterReward(ctx, rr)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventReporterRewarded{Reward: &rr})
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit reporter rewarded event", "epoch", epoch, "error", err)
+		}
+	}
+}
+
+// SetReporterReward stores the reward paid to the reporter of the epoch
+func (k Keeper) SetReporterReward(ctx sdk.Context, rr types.ReporterReward) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReporterRewardKey(rr.EpochNum), k.cdc.MustMarshal(&rr))
+}
+
+// GetReporterReward returns the reward paid to the reporter of the epoch,
+// or nil if no reward has been paid for it
+func (k Keeper) GetReporterReward(ctx sdk.Context, epoch uint64) *types.ReporterReward {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReporterRewardKey(epoch))
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var rr types.ReporterReward
+	k.cdc.MustUnmarshal(bz, &rr)
+	return &rr
+}
+
+// GetAllReporterRewards returns the rewards paid to the reporters of all epochs,
+// sorted by epoch number
+func (k Keeper) GetAllReporterRewards(ctx sdk.Context) []types.ReporterReward {
+	rewards := []types.ReporterReward{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ReporterRewardPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rr types.ReporterReward
+		k.cdc.MustUnmarshal(iterator.Value(), &rr)
+		rewards = append(rewards, rr)
+	}
+	return rewards
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundRewardPool(t *testing.T) {
+	lc := btcctypes.NewMockBTCLightClientKeeper(1)
+	cc := btcctypes.NewMockCheckpointingKeeper(1)
+	bk := btcctypes.NewMockBankKeeper()
+	k, ctx := keepertest.NewBTCCheckpointKeeperWithBank(t, lc, cc, bk, chaincfg.SimNetParams.PowLimit)
+
+	params := k.GetParams(ctx)
+	params.ReporterRewardShare = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+
+	bk.MintCoins(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 1005)))
+	k.FundRewardPool(ctx)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), k.GetRewardPool(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 905)), bk.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), k.GetEpochReward(ctx, 1))
+
+	// the funds of the blocks of the next epoch are set aside for it
+	cc.SetEpoch(2)
+	k.FundRewardPool(ctx)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 190)), k.GetRewardPool(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), k.GetEpochReward(ctx, 1))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), k.GetEpochReward(ctx, 2))
+
+	// nothing is transferred if the share is zero
+	params.ReporterRewardShare = sdk.ZeroDec()
+	k.SetParams(ctx, params)
+	k.FundRewardPool(ctx)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 190)), k.GetRewardPool(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), k.GetEpochReward(ctx, 2))
+}
+
+func TestRewardReporterOfFinalizedEpoch(t *testing.T) {
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	wDeep := defaultParams.CheckpointFinalizationTimeout
+	checkpointData := getRandomCheckpointDataForEpoch(epoch)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		checkpointData.epoch,
+		checkpointData.lastCommitHash,
+		checkpointData.bitmap,
+		checkpointData.blsSig,
+		checkpointData.submitterAddress,
+	)
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(int64(kDeep) - 1)
+	cc := btcctypes.NewMockCheckpointingKeeper(epoch)
+	bk := btcctypes.NewMockBankKeeper()
+	k, ctx := keepertest.NewBTCCheckpointKeeperWithBank(t, lc, cc, bk, chaincfg.SimNetParams.PowLimit)
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	// two submissions of the same checkpoint, the second one is included in a lower btc block
+	var submitters []sdk.AccAddress
+	var blocks []*dg.BlockCreationResult
+	for i := 0; i < 2; i++ {
+		blck1 := dg.CreateBlock(1, 7, 7, data1)
+		blck2 := dg.CreateBlock(2, 14, 3, data2)
+		lc.SetBlockHeight(blck1.HeaderBytes.Hash(), uint64(100-10*i))
+		lc.SetBlockHeight(blck2.HeaderBytes.Hash(), uint64(101-10*i))
+
+		pk, _ := dg.NewPV().GetPubKey()
+		address := sdk.AccAddress(pk.Address().Bytes())
+		msg := btcctypes.MsgInsertBTCSpvProof{
+			Proofs:    BlockCreationResultToProofs([]*dg.BlockCreationResult{blck1, blck2}),
+			Submitter: address.String(),
+		}
+		_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		submitters = append(submitters, address)
+		blocks = append(blocks, blck2)
+	}
+
+	bk.MintCoins(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000)))
+	k.FundRewardPool(ctx)
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.Equal(t, pool, k.GetEpochReward(ctx, epoch))
+
+	// no reward is paid when the epoch is confirmed
+	lc.SetDepth(int64(kDeep))
+	k.OnTipChange(ctx)
+	require.Nil(t, k.GetReporterReward(ctx, epoch))
+	require.Equal(t, pool, k.GetRewardPool(ctx))
+
+	// the reporter of the submission included in the lower btc block is rewarded
+	// when the epoch is finalized
+	lc.SetDepth(int64(wDeep))
+	k.OnTipChange(ctx)
+
+	rr := k.GetReporterReward(ctx, epoch)
+	require.NotNil(t, rr)
+	require.Equal(t, submitters[1].String(), rr.Submitter)
+	require.Equal(t, uint64(91), rr.BtcHeight)
+	require.Equal(t, blocks[1].HeaderBytes.Hash(), rr.SubmissionKey.Key[1].Hash)
+	require.Equal(t, pool, rr.Amount)
+	require.Equal(t, pool, bk.GetAllBalances(ctx, submitters[1]))
+	require.True(t, bk.GetAllBalances(ctx, submitters[0]).IsZero())
+	require.True(t, k.GetRewardPool(ctx).IsZero())
+	require.True(t, k.GetEpochReward(ctx, epoch).IsZero())
+
+	resp, err := k.Rewards(sdk.WrapSDKContext(ctx), &btcctypes.QueryRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []btcctypes.ReporterReward{*rr}, resp.Rewards)
+}
+
+func TestRewardReportersOfEpochsFinalizedTogether(t *testing.T) {
+	defaultParams := btcctypes.DefaultParams()
+	wDeep := defaultParams.CheckpointFinalizationTimeout
+
+	lc := btcctypes.NewMockBTCLightClientKeeper(int64(wDeep) - 1)
+	cc := btcctypes.NewMockCheckpointingKeeper(1)
+	bk := btcctypes.NewMockBankKeeper()
+	k, ctx := keepertest.NewBTCCheckpointKeeperWithBank(t, lc, cc, bk, chaincfg.SimNetParams.PowLimit)
+	srv := bkeeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.ReporterRewardShare = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+
+	// epochs 1 and 2 receive a checkpoint, while the blocks of epoch 3 fund the pool
+	var submitters []sdk.AccAddress
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		cc.SetEpoch(epoch)
+		bk.MintCoins(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", int64(1000*epoch))))
+		k.FundRewardPool(ctx)
+
+		if epoch == 3 {
+			break
+		}
+
+		checkpointData := getRandomCheckpointDataForEpoch(epoch)
+		data1, data2 := txformat.MustEncodeCheckpointData(
+			txformat.MainTag(),
+			txformat.CurrentVersion,
+			checkpointData.epoch,
+			checkpointData.lastCommitHash,
+			checkpointData.bitmap,
+			checkpointData.blsSig,
+			checkpointData.submitterAddress,
+		)
+		pk, _ := dg.NewPV().GetPubKey()
+		address := sdk.AccAddress(pk.Address().Bytes())
+		msg := btcctypes.MsgInsertBTCSpvProof{
+			Proofs:    BlockCreationResultToProofs([]*dg.BlockCreationResult{dg.CreateBlock(1, 7, 7, data1), dg.CreateBlock(2, 14, 3, data2)}),
+			Submitter: address.String(),
+		}
+		_, err := srv.InsertBTCSpvProof(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		submitters = append(submitters, address)
+	}
+
+	// 10% of 1000, then 10% of 900 + 2000, then 10% of 2610 + 3000
+	rewards := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 290)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 561)),
+	}
+
+	// both epochs are finalized in the same block, and each reporter receives
+	// the reward of its own epoch only
+	lc.SetDepth(int64(wDeep))
+	k.OnTipChange(ctx)
+
+	for i, submitter := range submitters {
+		epoch := uint64(i + 1)
+		rr := k.GetReporterReward(ctx, epoch)
+		require.NotNil(t, rr)
+		require.Equal(t, submitter.String(), rr.Submitter)
+		require.Equal(t, rewards[i], rr.Amount)
+		require.Equal(t, rewards[i], bk.GetAllBalances(ctx, submitter))
+		require.True(t, k.GetEpochReward(ctx, epoch).IsZero())
+	}
+
+	// the reward of the epoch which is not finalized yet stays in the pool
+	require.Equal(t, rewards[2], k.GetEpochReward(ctx, 3))
+	require.Equal(t, rewards[2], k.GetRewardPool(ctx))
+}
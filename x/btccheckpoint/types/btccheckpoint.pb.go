@@ -5,7 +5,11 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	types1 "github.com/babylonchain/babylon/x/checkpointing/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -177,6 +181,9 @@ type SubmissionData struct {
 	// to recover sender of btc tx.
 	Btctransaction [][]byte `protobuf:"bytes,2,rep,name=btctransaction,proto3" json:"btctransaction,omitempty"`
 	Epoch          uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Proofs of inclusion of the transactions, in order of the parts of the checkpoint.
+	// Kept to serve proofs of the inclusion of checkpoints to external consumers.
+	Proofs []*BTCSpvProof `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
 }
 
 func (m *SubmissionData) Reset()         { *m = SubmissionData{} }
@@ -233,6 +240,13 @@ func (m *SubmissionData) GetEpoch() uint64 {
 	return 0
 }
 
+func (m *SubmissionData) GetProofs() []*BTCSpvProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 // Data stored in db and indexed by epoch number
 // TODO: Add btc blockheight at epooch end, when adding hadnling of epoching callbacks
 type EpochData struct {
@@ -299,12 +313,226 @@ func (m *EpochData) GetRawCheckpoint() []byte {
 	return nil
 }
 
+// ReporterReward is the reward paid to the submitter of the winning submission
+// of an epoch when the epoch is finalized.
+// The winning submission is the one, among the submissions of the epoch on the btc
+// main chain, whose last transaction is included in the lowest btc block.
+// Ties are broken by the order in which the submissions were received by babylon.
+type ReporterReward struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// Key of the winning submission
+	SubmissionKey SubmissionKey `protobuf:"bytes,2,opt,name=submission_key,json=submissionKey,proto3" json:"submission_key"`
+	// Address of the submitter of the winning submission
+	Submitter string `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// Height of the btc block including the last transaction of the winning submission
+	BtcHeight uint64 `protobuf:"varint,4,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// Amount paid to the submitter
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ReporterReward) Reset()         { *m = ReporterReward{} }
+func (m *ReporterReward) String() string { return proto.CompactTextString(m) }
+func (*ReporterReward) ProtoMessage()    {}
+func (*ReporterReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da8b9af3dbd18a36, []int{4}
+}
+func (m *ReporterReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReporterReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterReward.Merge(m, src)
+}
+func (m *ReporterReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterReward proto.InternalMessageInfo
+
+func (m *ReporterReward) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *ReporterReward) GetSubmissionKey() SubmissionKey {
+	if m != nil {
+		return m.SubmissionKey
+	}
+	return SubmissionKey{}
+}
+
+func (m *ReporterReward) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *ReporterReward) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *ReporterReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EpochReward is the share of the block rewards set aside for the reporter of
+// the epoch, accumulated over the blocks of the epoch
+type EpochReward struct {
+	EpochNum uint64                                   `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EpochReward) Reset()         { *m = EpochReward{} }
+func (m *EpochReward) String() string { return proto.CompactTextString(m) }
+func (*EpochReward) ProtoMessage()    {}
+func (*EpochReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da8b9af3dbd18a36, []int{5}
+}
+func (m *EpochReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochReward.Merge(m, src)
+}
+func (m *EpochReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochReward proto.InternalMessageInfo
+
+func (m *EpochReward) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EpochReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EpochInclusionProof is a self-contained proof that the checkpoint of an epoch
+// is included in the btc chain. It can be verified offline, without access to the
+// state of babylon nor of btc.
+type EpochInclusionProof struct {
+	// Checkpoint of the epoch
+	RawCheckpoint *types1.RawCheckpoint `protobuf:"bytes,1,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	// Aggregate of the bls public keys of the validators which signed the checkpoint
+	BlsAggrPk *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=bls_aggr_pk,json=blsAggrPk,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_aggr_pk,omitempty"`
+	// Validator set of the epoch along with the bls public keys of the validators
+	ValidatorSet *types1.ValidatorWithBlsKeySet `protobuf:"bytes,3,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// Proofs of inclusion of the transactions carrying the checkpoint, in order of
+	// the parts of the checkpoint
+	Proofs []*BTCSpvProof `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// Headers of the btc main chain, from the lowest block including a transaction
+	// of the checkpoint up to the tip
+	BtcHeaders []github_com_babylonchain_babylon_types.BTCHeaderBytes `protobuf:"bytes,5,rep,name=btc_headers,json=btcHeaders,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderBytes" json:"btc_headers"`
+}
+
+func (m *EpochInclusionProof) Reset()         { *m = EpochInclusionProof{} }
+func (m *EpochInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*EpochInclusionProof) ProtoMessage()    {}
+func (*EpochInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da8b9af3dbd18a36, []int{6}
+}
+func (m *EpochInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInclusionProof.Merge(m, src)
+}
+func (m *EpochInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInclusionProof proto.InternalMessageInfo
+
+func (m *EpochInclusionProof) GetRawCheckpoint() *types1.RawCheckpoint {
+	if m != nil {
+		return m.RawCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochInclusionProof) GetValidatorSet() *types1.ValidatorWithBlsKeySet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+func (m *EpochInclusionProof) GetProofs() []*BTCSpvProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btccheckpoint.v1.EpochStatus", EpochStatus_name, EpochStatus_value)
 	proto.RegisterType((*TransactionKey)(nil), "babylon.btccheckpoint.v1.TransactionKey")
 	proto.RegisterType((*SubmissionKey)(nil), "babylon.btccheckpoint.v1.SubmissionKey")
 	proto.RegisterType((*SubmissionData)(nil), "babylon.btccheckpoint.v1.SubmissionData")
 	proto.RegisterType((*EpochData)(nil), "babylon.btccheckpoint.v1.EpochData")
+	proto.RegisterType((*ReporterReward)(nil), "babylon.btccheckpoint.v1.ReporterReward")
+	proto.RegisterType((*EpochReward)(nil), "babylon.btccheckpoint.v1.EpochReward")
+	proto.RegisterType((*EpochInclusionProof)(nil), "babylon.btccheckpoint.v1.EpochInclusionProof")
 }
 
 func init() {
@@ -312,39 +540,63 @@ func init() {
 }
 
 var fileDescriptor_da8b9af3dbd18a36 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x4d, 0x88, 0x94, 0x6d, 0x12, 0x45, 0xa6, 0x42, 0x56, 0x84, 0x8c, 0x15, 0x54,
-	0x70, 0x39, 0x38, 0xa2, 0x08, 0x09, 0x10, 0x1c, 0x9a, 0xc4, 0x21, 0x51, 0x69, 0x52, 0xd9, 0xee,
-	0xa5, 0x97, 0x68, 0x6d, 0x2f, 0xf1, 0xaa, 0x89, 0x37, 0xf2, 0x6e, 0x68, 0xcc, 0x13, 0xa0, 0x9e,
-	0x78, 0x81, 0x9e, 0xb8, 0xf2, 0x02, 0xbc, 0x01, 0xc7, 0x1e, 0x11, 0x07, 0x84, 0x92, 0x17, 0x41,
-	0x5e, 0x1b, 0x52, 0x17, 0x22, 0x6e, 0x3b, 0xe3, 0x6f, 0xe6, 0xff, 0x67, 0x34, 0x86, 0x7b, 0x0e,
-	0x72, 0xa2, 0x09, 0x0d, 0x9a, 0x0e, 0x77, 0x5d, 0x1f, 0xbb, 0x67, 0x33, 0x4a, 0x02, 0x9e, 0x8d,
-	0xf4, 0x59, 0x48, 0x39, 0x95, 0xe4, 0x14, 0xd5, 0xb3, 0x1f, 0xdf, 0x3d, 0xae, 0xef, 0x8c, 0xe9,
-	0x98, 0x0a, 0xa8, 0x19, 0xbf, 0x12, 0xbe, 0xb1, 0x80, 0x55, 0x3b, 0x44, 0x01, 0x43, 0x2e, 0x27,
-	0x34, 0x38, 0xc4, 0x91, 0xb4, 0x03, 0x6f, 0x91, 0xc0, 0xc3, 0x0b, 0x19, 0xa8, 0x40, 0xab, 0x98,
-	0x49, 0x20, 0x1d, 0xc3, 0x82, 0x8f, 0x98, 0x2f, 0x6f, 0xa9, 0x40, 0x2b, 0xb7, 0x5e, 0x7e, 0xff,
-	0x71, 0xef, 0xd9, 0x98, 0x70, 0x7f, 0xee, 0xe8, 0x2e, 0x9d, 0x36, 0x53, 0x51, 0xd7, 0x47, 0x24,
-	0xf8, 0x1d, 0x34, 0x79, 0x34, 0xc3, 0x4c, 0x6f, 0xd9, 0xed, 0x1e, 0x46, 0x1e, 0x0e, 0x7b, 0x88,
-	0xf9, 0xad, 0x88, 0x63, 0x66, 0x8a, 0x4e, 0x8d, 0x43, 0x58, 0xb1, 0xe6, 0xce, 0x94, 0x30, 0x96,
-	0x0a, 0xbf, 0x80, 0xf9, 0x33, 0x1c, 0xc9, 0x40, 0xcd, 0x6b, 0xdb, 0xfb, 0x9a, 0xbe, 0x69, 0x10,
-	0x3d, 0xeb, 0xd7, 0x8c, 0x8b, 0x1a, 0x13, 0x58, 0x5d, 0x37, 0xeb, 0x20, 0x8e, 0xa4, 0xbb, 0xb0,
-	0xc4, 0xe2, 0x0c, 0xe7, 0x38, 0x14, 0xa3, 0x94, 0xcd, 0x75, 0x42, 0x7a, 0x00, 0xab, 0x0e, 0x77,
-	0xf9, 0xba, 0x93, 0xbc, 0xa5, 0xe6, 0xb5, 0xb2, 0x79, 0x23, 0x1b, 0x2f, 0x03, 0xcf, 0xa8, 0xeb,
-	0xcb, 0x79, 0x15, 0x68, 0x05, 0x33, 0x09, 0x1a, 0x9f, 0x01, 0x2c, 0x19, 0xf1, 0x4b, 0x28, 0x3d,
-	0xbf, 0xee, 0xfb, 0xe1, 0x66, 0xdf, 0x99, 0x69, 0x85, 0x6d, 0xe9, 0x15, 0x2c, 0x32, 0x8e, 0xf8,
-	0x9c, 0x89, 0xbd, 0x56, 0xf7, 0x77, 0x37, 0x57, 0x0b, 0x3d, 0x4b, 0xc0, 0x66, 0x5a, 0x24, 0xed,
-	0xc2, 0x6a, 0x88, 0xce, 0x47, 0x6b, 0x50, 0xd8, 0x2c, 0x9b, 0x95, 0x10, 0x9d, 0xb7, 0xff, 0x24,
-	0x1f, 0x7d, 0x01, 0x70, 0xfb, 0x5a, 0xb9, 0xb4, 0x07, 0xef, 0x18, 0xc7, 0xc3, 0x76, 0x6f, 0x64,
-	0xd9, 0x07, 0xf6, 0x89, 0x35, 0xb2, 0x4e, 0x5a, 0x47, 0x7d, 0xdb, 0x36, 0x3a, 0xb5, 0x5c, 0xbd,
-	0x72, 0x71, 0xa9, 0x96, 0xac, 0x74, 0x4f, 0xde, 0x5f, 0x68, 0x7b, 0x38, 0xe8, 0xf6, 0xcd, 0x23,
-	0xa3, 0x53, 0x03, 0x09, 0xda, 0xa6, 0xc1, 0x5b, 0x12, 0x4e, 0xff, 0x81, 0x76, 0xfb, 0x83, 0x83,
-	0x37, 0xfd, 0x53, 0xa3, 0x53, 0xdb, 0x4a, 0xd0, 0x2e, 0x09, 0xd0, 0x84, 0xbc, 0xc7, 0x9e, 0x74,
-	0x1f, 0xde, 0xce, 0x1a, 0xe8, 0xbf, 0x1e, 0x18, 0x9d, 0x5a, 0xbe, 0x0e, 0x2f, 0x2e, 0xd5, 0xa2,
-	0x45, 0xc6, 0x01, 0xf6, 0xea, 0x85, 0x0f, 0x9f, 0x94, 0x5c, 0x6b, 0xf8, 0x75, 0xa9, 0x80, 0xab,
-	0xa5, 0x02, 0x7e, 0x2e, 0x15, 0xf0, 0x71, 0xa5, 0xe4, 0xae, 0x56, 0x4a, 0xee, 0xdb, 0x4a, 0xc9,
-	0x9d, 0x3e, 0xfd, 0xdf, 0xfd, 0x2d, 0x6e, 0xfc, 0x2e, 0xe2, 0x1e, 0x9d, 0xa2, 0xb8, 0xfb, 0x27,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x99, 0xdd, 0xef, 0x21, 0x54, 0x03, 0x00, 0x00,
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xd1, 0x6e, 0x1a, 0x47,
+	0x14, 0x65, 0x81, 0x58, 0x65, 0x0c, 0xc8, 0xda, 0x44, 0xd5, 0x96, 0xb6, 0x80, 0x88, 0x92, 0x92,
+	0x4a, 0x5d, 0x82, 0xd3, 0x4a, 0x49, 0x95, 0x3c, 0x78, 0x01, 0xd7, 0xc8, 0x0d, 0x46, 0xb3, 0xb8,
+	0x95, 0x22, 0x55, 0x68, 0x76, 0x98, 0xec, 0x8e, 0x80, 0x9d, 0xd5, 0xce, 0x60, 0x9b, 0x7e, 0x41,
+	0x95, 0x97, 0xf6, 0x07, 0xf2, 0x54, 0xf5, 0xa5, 0xed, 0x0f, 0xe4, 0x0f, 0xf2, 0x98, 0xc7, 0xca,
+	0x0f, 0x6e, 0x65, 0xff, 0x48, 0x35, 0xb3, 0x8b, 0xf1, 0x12, 0xbb, 0x71, 0x55, 0xf5, 0x09, 0x66,
+	0xe6, 0xcc, 0x9d, 0x73, 0xcf, 0x3d, 0xf7, 0x2e, 0xb8, 0xe7, 0x20, 0x67, 0x3e, 0x61, 0x7e, 0xc3,
+	0x11, 0x18, 0x7b, 0x04, 0x8f, 0x03, 0x46, 0x7d, 0x91, 0x5c, 0x99, 0x41, 0xc8, 0x04, 0xd3, 0x8d,
+	0x18, 0x6a, 0x26, 0x0f, 0x0f, 0x9a, 0xa5, 0x5b, 0x2e, 0x73, 0x99, 0x02, 0x35, 0xe4, 0xbf, 0x08,
+	0x5f, 0x2a, 0x63, 0xc6, 0xa7, 0x8c, 0x37, 0x1c, 0xc4, 0x49, 0xe3, 0xa0, 0xe9, 0x10, 0x81, 0x9a,
+	0x0d, 0xcc, 0xa8, 0xbf, 0x38, 0xbf, 0xfc, 0x69, 0x71, 0x14, 0x9f, 0xdf, 0x5e, 0x9c, 0x2f, 0x0f,
+	0xa9, 0xef, 0x36, 0x9c, 0x09, 0x1f, 0x8e, 0xc9, 0x3c, 0x06, 0xdd, 0xbd, 0x1c, 0xb4, 0x4a, 0xbe,
+	0x76, 0x04, 0x8a, 0x83, 0x10, 0xf9, 0x1c, 0x61, 0x41, 0x99, 0xbf, 0x4b, 0xe6, 0xfa, 0x2d, 0x70,
+	0x83, 0xfa, 0x23, 0x72, 0x64, 0x68, 0x55, 0xad, 0x5e, 0x80, 0xd1, 0x42, 0xef, 0x83, 0xac, 0x87,
+	0xb8, 0x67, 0xa4, 0xab, 0x5a, 0x3d, 0x6f, 0x3d, 0x3e, 0x3e, 0xa9, 0x3c, 0x74, 0xa9, 0xf0, 0x66,
+	0x8e, 0x89, 0xd9, 0xb4, 0x11, 0x3f, 0x86, 0x3d, 0x44, 0xfd, 0xc5, 0xa2, 0x21, 0xe6, 0x01, 0xe1,
+	0xa6, 0x35, 0x68, 0xed, 0x10, 0x34, 0x22, 0xe1, 0x0e, 0xe2, 0x9e, 0x35, 0x17, 0x84, 0x43, 0x15,
+	0xa9, 0xb6, 0x0b, 0x0a, 0xf6, 0xcc, 0x99, 0x52, 0xce, 0xe3, 0x87, 0xbf, 0x04, 0x99, 0x31, 0x99,
+	0x1b, 0x5a, 0x35, 0x53, 0x5f, 0xdf, 0xac, 0x9b, 0x57, 0xa9, 0x6a, 0x26, 0xf9, 0x42, 0x79, 0xa9,
+	0xf6, 0x9b, 0x06, 0x8a, 0xcb, 0x68, 0x6d, 0x24, 0x90, 0xfe, 0x11, 0xc8, 0x71, 0xb9, 0x23, 0x04,
+	0x09, 0x55, 0x2e, 0x79, 0xb8, 0xdc, 0xd0, 0xef, 0x82, 0xa2, 0x23, 0xb0, 0x58, 0x86, 0x32, 0xd2,
+	0xd5, 0x4c, 0x3d, 0x0f, 0x57, 0x76, 0xa5, 0x1a, 0x24, 0x60, 0xd8, 0x33, 0x32, 0x55, 0xad, 0x9e,
+	0x85, 0xd1, 0x42, 0x7f, 0x02, 0xd6, 0x82, 0x90, 0xb1, 0xe7, 0xdc, 0xc8, 0x2a, 0xb6, 0x77, 0xae,
+	0x66, 0x6b, 0x0d, 0x5a, 0x76, 0x70, 0xd0, 0x97, 0x68, 0x18, 0x5f, 0xaa, 0xfd, 0xae, 0x81, 0x5c,
+	0x47, 0x06, 0x52, 0x44, 0x1f, 0x5d, 0xcc, 0xfb, 0x93, 0xab, 0x23, 0x25, 0xd4, 0x52, 0x69, 0x4b,
+	0x1e, 0x5c, 0x20, 0x31, 0xe3, 0xaa, 0x2e, 0xc5, 0x7f, 0xe2, 0xa1, 0xde, 0xb3, 0x15, 0x18, 0xc6,
+	0x97, 0xf4, 0x3b, 0xa0, 0x18, 0xa2, 0xc3, 0xe1, 0x12, 0xa8, 0xb2, 0xcc, 0xc3, 0x42, 0x88, 0x0e,
+	0x5b, 0xe7, 0x9b, 0xb5, 0x5f, 0xd2, 0xa0, 0x08, 0x49, 0xc0, 0x42, 0x41, 0x42, 0x48, 0x0e, 0x51,
+	0x38, 0xd2, 0x3f, 0x04, 0x39, 0xa5, 0xc4, 0xd0, 0x9f, 0x4d, 0x95, 0xb8, 0x59, 0xf8, 0x9e, 0xda,
+	0xe8, 0xcd, 0xa6, 0xfa, 0x00, 0x14, 0xf9, 0x39, 0x57, 0xe9, 0x49, 0xc5, 0xee, 0xfa, 0xb9, 0x59,
+	0xd9, 0xd7, 0x27, 0x95, 0x14, 0x2c, 0xf0, 0x84, 0x3d, 0x12, 0xf5, 0x94, 0x3c, 0x73, 0x17, 0xeb,
+	0xf9, 0x31, 0x00, 0x8e, 0xc0, 0x43, 0x8f, 0x50, 0xd7, 0x13, 0x46, 0x56, 0x31, 0xca, 0x39, 0x02,
+	0xef, 0xa8, 0x0d, 0x1d, 0x83, 0x35, 0x34, 0x65, 0x33, 0x5f, 0x18, 0x37, 0x94, 0xcc, 0x1f, 0x98,
+	0x51, 0x13, 0x9a, 0xb2, 0x09, 0xcd, 0xb8, 0x09, 0xcd, 0x16, 0xa3, 0xbe, 0x75, 0x5f, 0x3e, 0xfe,
+	0xeb, 0x9f, 0x95, 0xfa, 0x05, 0x7f, 0xc7, 0x1d, 0x1b, 0xfd, 0x7c, 0xc6, 0x47, 0xe3, 0xd8, 0xdc,
+	0xf2, 0x02, 0x87, 0x71, 0xe8, 0xda, 0x8f, 0x1a, 0x58, 0x57, 0x32, 0x5f, 0x47, 0xa4, 0x25, 0xa3,
+	0xf4, 0xff, 0xc7, 0xe8, 0x55, 0x06, 0xdc, 0x54, 0x8c, 0xba, 0x3e, 0x9e, 0xcc, 0xa4, 0x92, 0xca,
+	0x88, 0x7a, 0xef, 0xad, 0xc2, 0x6b, 0x2b, 0x15, 0x4a, 0x8c, 0x0d, 0x59, 0x21, 0x78, 0xd1, 0x12,
+	0x2b, 0x0e, 0xd1, 0xbf, 0x03, 0xeb, 0x72, 0xfc, 0x20, 0xd7, 0x0d, 0x87, 0xc1, 0x38, 0x1e, 0x12,
+	0x4f, 0x8e, 0x4f, 0x2a, 0x8f, 0xde, 0x35, 0x24, 0x70, 0x38, 0x0f, 0x04, 0x93, 0xc3, 0xab, 0xb9,
+	0xf9, 0xe0, 0x61, 0xd3, 0xec, 0xcf, 0x9c, 0x09, 0xc5, 0xd2, 0xe0, 0x39, 0x67, 0xc2, 0xb7, 0x5c,
+	0x37, 0xec, 0x8f, 0xf5, 0x7d, 0x50, 0x38, 0x40, 0x13, 0x3a, 0x42, 0x82, 0x85, 0x43, 0x4e, 0x22,
+	0x9b, 0xae, 0x6f, 0xde, 0xbf, 0x9a, 0xed, 0x37, 0x0b, 0xf8, 0xb7, 0x54, 0x78, 0xd6, 0x84, 0xef,
+	0x92, 0xb9, 0x4d, 0x04, 0xcc, 0x9f, 0x87, 0xb1, 0x89, 0xf8, 0x8f, 0x5d, 0xac, 0x92, 0x56, 0x96,
+	0x93, 0xd3, 0x8d, 0x2b, 0x63, 0xe5, 0xad, 0xc7, 0xb2, 0x56, 0xc7, 0x27, 0x95, 0xcf, 0xff, 0xe5,
+	0x74, 0x8c, 0x26, 0x23, 0x50, 0x8e, 0x55, 0xf1, 0x3e, 0x7d, 0xb5, 0x70, 0x53, 0xd4, 0xb4, 0xfa,
+	0x3d, 0xf0, 0x7e, 0xa7, 0xbf, 0xd7, 0xda, 0x19, 0xda, 0x83, 0xad, 0xc1, 0xbe, 0x3d, 0xb4, 0xf7,
+	0xad, 0xa7, 0xdd, 0xc1, 0xa0, 0xd3, 0xde, 0x48, 0x95, 0x0a, 0x2f, 0x5e, 0x56, 0x73, 0x76, 0xdc,
+	0x0c, 0xa3, 0xb7, 0xa0, 0xad, 0xbd, 0xde, 0x76, 0x17, 0x3e, 0xed, 0xb4, 0x37, 0xb4, 0x08, 0xda,
+	0x62, 0xfe, 0x73, 0x1a, 0x4e, 0x2f, 0x81, 0x6e, 0x77, 0x7b, 0x5b, 0x5f, 0x77, 0x9f, 0x75, 0xda,
+	0x1b, 0xe9, 0x08, 0xba, 0x4d, 0x7d, 0x34, 0xa1, 0xdf, 0x93, 0x91, 0x7e, 0x1b, 0xdc, 0x4c, 0x12,
+	0xe8, 0x7e, 0xd5, 0xeb, 0xb4, 0x37, 0x32, 0x25, 0xf0, 0xe2, 0x65, 0x75, 0xcd, 0xa6, 0xae, 0x4f,
+	0x46, 0xa5, 0xec, 0x0f, 0x3f, 0x97, 0x53, 0xd6, 0xde, 0xeb, 0xd3, 0xb2, 0xf6, 0xe6, 0xb4, 0xac,
+	0xfd, 0x75, 0x5a, 0xd6, 0x7e, 0x3a, 0x2b, 0xa7, 0xde, 0x9c, 0x95, 0x53, 0x7f, 0x9c, 0x95, 0x53,
+	0xcf, 0xbe, 0x78, 0x97, 0x2e, 0x47, 0xab, 0x9f, 0x3d, 0xa9, 0x93, 0xb3, 0xa6, 0xbe, 0x56, 0x0f,
+	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x86, 0x88, 0x29, 0x97, 0x07, 0x00, 0x00,
 }
 
 func (m *TransactionKey) Marshal() (dAtA []byte, err error) {
@@ -444,6 +696,20 @@ func (m *SubmissionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Epoch != 0 {
 		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Epoch))
 		i--
@@ -517,99 +783,870 @@ func (m *EpochData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBtccheckpoint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtccheckpoint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReporterReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TransactionKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ReporterReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovBtccheckpoint(uint64(m.Index))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovBtccheckpoint(uint64(l))
+	if m.BtcHeight != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *SubmissionKey) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		for _, e := range m.Key {
-			l = e.Size()
-			n += 1 + l + sovBtccheckpoint(uint64(l))
+	{
+		size, err := m.SubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNum != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubmissionData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovBtccheckpoint(uint64(l))
-	}
-	if len(m.Btctransaction) > 0 {
-		for _, b := range m.Btctransaction {
-			l = len(b)
-			n += 1 + l + sovBtccheckpoint(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovBtccheckpoint(uint64(m.Epoch))
+	if m.EpochNum != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EpochData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		for _, e := range m.Key {
-			l = e.Size()
-			n += 1 + l + sovBtccheckpoint(uint64(l))
+	if len(m.BtcHeaders) > 0 {
+		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BtcHeaders[iNdEx].Size()
+				i -= size
+				if _, err := m.BtcHeaders[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlsAggrPk != nil {
+		{
+			size := m.BlsAggrPk.Size()
+			i -= size
+			if _, err := m.BlsAggrPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtccheckpoint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtccheckpoint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Index))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		for _, e := range m.Key {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubmissionData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if len(m.Btctransaction) > 0 {
+		for _, b := range m.Btctransaction {
+			l = len(b)
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Epoch))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		for _, e := range m.Key {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Status))
+	}
+	l = len(m.RawCheckpoint)
+	if l > 0 {
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	return n
+}
+
+func (m *ReporterReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.EpochNum))
+	}
+	l = m.SubmissionKey.Size()
+	n += 1 + l + sovBtccheckpoint(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.BtcHeight))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.EpochNum))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.BlsAggrPk != nil {
+		l = m.BlsAggrPk.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	if len(m.BtcHeaders) > 0 {
+		for _, e := range m.BtcHeaders {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBtccheckpoint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtccheckpoint(x uint64) (n int) {
+	return sovBtccheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransactionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key, &TransactionKey{})
+			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btctransaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btctransaction = append(m.Btctransaction, make([]byte, postIndex-iNdEx))
+			copy(m.Btctransaction[len(m.Btctransaction)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &BTCSpvProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key, &SubmissionKey{})
+			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EpochStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawCheckpoint = append(m.RawCheckpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovBtccheckpoint(uint64(m.Status))
-	}
-	l = len(m.RawCheckpoint)
-	if l > 0 {
-		n += 1 + l + sovBtccheckpoint(uint64(l))
-	}
-	return n
-}
 
-func sovBtccheckpoint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtccheckpoint(x uint64) (n int) {
-	return sovBtccheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TransactionKey) Unmarshal(dAtA []byte) error {
+func (m *ReporterReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -632,17 +1669,17 @@ func (m *TransactionKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReporterReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReporterReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			m.Index = 0
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtccheckpoint
@@ -652,16 +1689,16 @@ func (m *TransactionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtccheckpoint
@@ -671,24 +1708,107 @@ func (m *TransactionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtccheckpoint
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtccheckpoint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -713,7 +1833,7 @@ func (m *TransactionKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
+func (m *EpochReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,15 +1856,34 @@ func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -771,8 +1910,8 @@ func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key, &TransactionKey{})
-			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -797,7 +1936,7 @@ func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmissionData) Unmarshal(dAtA []byte) error {
+func (m *EpochInclusionProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,17 +1959,17 @@ func (m *SubmissionData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmissionData: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochInclusionProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmissionData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtccheckpoint
@@ -840,29 +1979,31 @@ func (m *SubmissionData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtccheckpoint
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtccheckpoint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &types1.RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btctransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsAggrPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -889,14 +2030,17 @@ func (m *SubmissionData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btctransaction = append(m.Btctransaction, make([]byte, postIndex-iNdEx))
-			copy(m.Btctransaction[len(m.Btctransaction)-1], dAtA[iNdEx:postIndex])
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.BlsAggrPk = &v
+			if err := m.BlsAggrPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtccheckpoint
@@ -906,64 +2050,31 @@ func (m *SubmissionData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthBtccheckpoint
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EpochData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtccheckpoint
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types1.ValidatorWithBlsKeySet{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EpochData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -990,33 +2101,14 @@ func (m *EpochData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key, &SubmissionKey{})
-			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proofs = append(m.Proofs, &BTCSpvProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtccheckpoint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= EpochStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaders", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1043,9 +2135,10 @@ func (m *EpochData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawCheckpoint = append(m.RawCheckpoint[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = []byte{}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.BtcHeaders = append(m.BtcHeaders, v)
+			if err := m.BtcHeaders[len(m.BtcHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -38,6 +38,9 @@ type ParsedProof struct {
 	TransactionBytes []byte
 	TransactionIdx   uint32
 	OpReturnData     []byte
+	// data carried by the witness envelope of the transaction, only set for
+	// proofs with a witness commitment proof
+	WitnessData []byte
 }
 
 // Concatenates and double hashes two provided inputs
@@ -111,6 +114,16 @@ func createBranch(nodes []*chainhash.Hash, numfLeafs uint, idx uint) []*chainhas
 
 // quite inefficiet method of calculating merkle proofs, created for testing purposes
 func CreateProofForIdx(transactions [][]byte, idx uint) ([]*chainhash.Hash, error) {
+	return createProofForIdx(transactions, idx, false)
+}
+
+// CreateWitnessProofForIdx calculates the merkle proof of the wtxid of the transaction
+// with the given index in the witness merkle tree, created for testing purposes
+func CreateWitnessProofForIdx(transactions [][]byte, idx uint) ([]*chainhash.Hash, error) {
+	return createProofForIdx(transactions, idx, true)
+}
+
+func createProofForIdx(transactions [][]byte, idx uint, witness bool) ([]*chainhash.Hash, error) {
 	if len(transactions) == 0 {
 		return nil, errors.New("can't calculate proof for empty transaction list")
 	}
@@ -130,7 +143,7 @@ func CreateProofForIdx(transactions [][]byte, idx uint) ([]*chainhash.Hash, erro
 		txs = append(txs, tx)
 	}
 
-	store := blockchain.BuildMerkleTreeStore(txs, false)
+	store := blockchain.BuildMerkleTreeStore(txs, witness)
 
 	var storeNoNil []*chainhash.Hash
 
@@ -246,6 +259,8 @@ func ParseTransaction(bytes []byte) (*btcutil.Tx, error) {
 	return tx, nil
 }
 
+// ParseProof parses proof of a transaction whose inclusion is proven by the merkle
+// path of its txid. See ParseWitnessProof for proofs through the witness commitment.
 // TODO define domain errors with nice error messages
 // TODO add some tests for the proof validation
 func ParseProof(
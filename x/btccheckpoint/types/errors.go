@@ -15,4 +15,5 @@ var (
 	ErrProvidedHeaderFromDifferentForks  = sdkerrors.Register(ModuleName, 1104, "Proof header from different forks")
 	ErrProvidedHeaderDoesNotHaveAncestor = sdkerrors.Register(ModuleName, 1105, "Proof header does not have ancestor in previous epoch")
 	ErrEpochAlreadyConfirmedOrFinalized  = sdkerrors.Register(ModuleName, 1106, "Submission denied. Epoch already confirmed/finalized")
+	ErrFormatVersionNotAccepted          = sdkerrors.Register(ModuleName, 1107, "Submission denied. Checkpoint format version is not accepted")
 )
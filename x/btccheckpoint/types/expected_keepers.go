@@ -2,6 +2,8 @@ package types
 
 import (
 	bbn "github.com/babylonchain/babylon/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -15,6 +17,9 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
@@ -31,11 +36,18 @@ type BTCLightClientKeeper interface {
 	// MainChainDepth returns the depth of the header in the main chain or -1 if it does not exist in it
 	// Error is returned if header is unknown to lightclient
 	MainChainDepth(ctx sdk.Context, headerBytes *bbn.BTCHeaderHashBytes) (int64, error)
+
+	// MainChainFrom returns the headers of the main chain from the header with given
+	// hash up to the tip, in ascending order. Error is returned if header is unknown to
+	// lightclient or not on the main chain
+	MainChainFrom(ctx sdk.Context, headerHash *bbn.BTCHeaderHashBytes) ([]bbn.BTCHeaderBytes, error)
 }
 
 type CheckpointingKeeper interface {
 	// CheckpointEpoch should return epoch index if provided rawCheckpoint
-	// passes all checkpointing validations and error otherwise
+	// passes all checkpointing validations and error otherwise.
+	// ErrConflictingCheckpoint is returned if the checkpoint is validly signed
+	// but conflicts with the local one, after the evidence has been recorded
 	CheckpointEpoch(ctx sdk.Context, rawCheckpoint []byte) (uint64, error)
 
 	// It quite mouthfull to have 4 different methods to operate on checkpoint state
@@ -56,4 +68,11 @@ type CheckpointingKeeper interface {
 	// SetCheckpointForgotten informs checkpointing module that this checkpoint lost
 	// all submissions on btc chain
 	SetCheckpointForgotten(ctx sdk.Context, epoch uint64)
+
+	// GetBLSPubKeySet returns the validator set of the epoch along with the bls
+	// public keys of the validators, in the order of the bitmaps of the checkpoints
+	GetBLSPubKeySet(ctx sdk.Context, epochNumber uint64) (*ckpttypes.ValidatorWithBlsKeySet, error)
+
+	// GetEpoch returns the current epoch
+	GetEpoch(ctx sdk.Context) epochingtypes.Epoch
 }
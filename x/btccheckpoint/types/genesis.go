@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -10,15 +13,136 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-	    // this line is used by starport scaffolding # genesis/types/default
-	    Params:	DefaultParams(),
+		// this line is used by starport scaffolding # genesis/types/default
+		Params:                 DefaultParams(),
+		Epochs:                 []EpochEntry{},
+		Submissions:            []SubmissionEntry{},
+		UnconfirmedSubmissions: []SubmissionKey{},
+		ConfirmedSubmissions:   []SubmissionKey{},
+		FinalizedSubmissions:   []SubmissionKey{},
+		Rewards:                []ReporterReward{},
+		EpochRewards:           []EpochReward{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-    // this line is used by starport scaffolding # genesis/types/validate
+	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 
-	return gs.Params.Validate()
+	// Collect the submissions, which should have unique keys
+	submissions := make(map[string]SubmissionData, len(gs.Submissions))
+	for _, submission := range gs.Submissions {
+		key, err := submissionKeyString(submission.Key)
+		if err != nil {
+			return err
+		}
+		if _, ok := submissions[key]; ok {
+			return fmt.Errorf("duplicate submission %s", submission.Key.String())
+		}
+		submissions[key] = submission.Data
+	}
+
+	// Every submission key referenced by an epoch should exist and belong to the epoch.
+	// Every submission should be referenced by its epoch exactly once.
+	epochs := make(map[uint64]bool, len(gs.Epochs))
+	referenced := make(map[string]bool, len(gs.Submissions))
+	for _, epoch := range gs.Epochs {
+		if epochs[epoch.EpochNum] {
+			return fmt.Errorf("duplicate data for epoch %d", epoch.EpochNum)
+		}
+		epochs[epoch.EpochNum] = true
+		for _, sk := range epoch.Data.Key {
+			if sk == nil {
+				return fmt.Errorf("epoch %d references an empty submission key", epoch.EpochNum)
+			}
+			key, err := submissionKeyString(*sk)
+			if err != nil {
+				return err
+			}
+			sd, ok := submissions[key]
+			if !ok {
+				return fmt.Errorf("submission %s referenced by epoch %d does not exist", sk.String(), epoch.EpochNum)
+			}
+			if sd.Epoch != epoch.EpochNum {
+				return fmt.Errorf("submission %s referenced by epoch %d belongs to epoch %d", sk.String(), epoch.EpochNum, sd.Epoch)
+			}
+			if referenced[key] {
+				return fmt.Errorf("submission %s is referenced more than once by epoch %d", sk.String(), epoch.EpochNum)
+			}
+			referenced[key] = true
+		}
+	}
+	for _, submission := range gs.Submissions {
+		key, _ := submissionKeyString(submission.Key)
+		if !referenced[key] {
+			return fmt.Errorf("submission %s is not referenced by its epoch %d", submission.Key.String(), submission.Data.Epoch)
+		}
+	}
+
+	// Every submission should be in exactly one of the unconfirmed, confirmed and finalized indices
+	indexed := make(map[string]bool, len(gs.Submissions))
+	for _, index := range [][]SubmissionKey{gs.UnconfirmedSubmissions, gs.ConfirmedSubmissions, gs.FinalizedSubmissions} {
+		for _, sk := range index {
+			key, err := submissionKeyString(sk)
+			if err != nil {
+				return err
+			}
+			if _, ok := submissions[key]; !ok {
+				return fmt.Errorf("indexed submission %s does not exist", sk.String())
+			}
+			if indexed[key] {
+				return fmt.Errorf("submission %s is indexed more than once", sk.String())
+			}
+			indexed[key] = true
+		}
+	}
+	if len(indexed) != len(submissions) {
+		return fmt.Errorf("%d submissions are not indexed as unconfirmed, confirmed or finalized", len(submissions)-len(indexed))
+	}
+
+	// Every epoch should be rewarded at most once
+	rewarded := make(map[uint64]bool, len(gs.Rewards))
+	for _, rr := range gs.Rewards {
+		if rewarded[rr.EpochNum] {
+			return fmt.Errorf("duplicate reward for epoch %d", rr.EpochNum)
+		}
+		rewarded[rr.EpochNum] = true
+		if _, err := sdk.AccAddressFromBech32(rr.Submitter); err != nil {
+			return fmt.Errorf("invalid submitter of the reward for epoch %d: %w", rr.EpochNum, err)
+		}
+		if err := rr.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount of the reward for epoch %d: %w", rr.EpochNum, err)
+		}
+	}
+
+	// Rewards are set aside at most once for every epoch which is not rewarded yet
+	setAside := make(map[uint64]bool, len(gs.EpochRewards))
+	for _, er := range gs.EpochRewards {
+		if setAside[er.EpochNum] {
+			return fmt.Errorf("duplicate reward set aside for epoch %d", er.EpochNum)
+		}
+		setAside[er.EpochNum] = true
+		if rewarded[er.EpochNum] {
+			return fmt.Errorf("reward set aside for already rewarded epoch %d", er.EpochNum)
+		}
+		if err := er.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount of the reward set aside for epoch %d: %w", er.EpochNum, err)
+		}
+	}
+
+	return nil
+}
+
+// submissionKeyString returns the serialized submission key as a string,
+// so that it can be used as a map key
+func submissionKeyString(sk SubmissionKey) (string, error) {
+	bz, err := sk.Marshal()
+	if err != nil {
+		return "", fmt.Errorf("invalid submission key %s: %w", sk.String(), err)
+	}
+	return string(bz), nil
 }
@@ -26,6 +26,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the btccheckpoint module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// epochs are the data of all the epochs that have received submissions
+	Epochs []EpochEntry `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+	// submissions are all the submissions referenced by the epochs
+	Submissions []SubmissionEntry `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions"`
+	// unconfirmed_submissions are the keys of the submissions that are not yet
+	// confirmed
+	UnconfirmedSubmissions []SubmissionKey `protobuf:"bytes,4,rep,name=unconfirmed_submissions,json=unconfirmedSubmissions,proto3" json:"unconfirmed_submissions"`
+	// confirmed_submissions are the keys of the submissions that are confirmed
+	// but not yet finalized
+	ConfirmedSubmissions []SubmissionKey `protobuf:"bytes,5,rep,name=confirmed_submissions,json=confirmedSubmissions,proto3" json:"confirmed_submissions"`
+	// finalized_submissions are the keys of the submissions that are finalized
+	FinalizedSubmissions []SubmissionKey `protobuf:"bytes,6,rep,name=finalized_submissions,json=finalizedSubmissions,proto3" json:"finalized_submissions"`
+	// rewards are the rewards paid to the reporters of finalized epochs
+	Rewards []ReporterReward `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards"`
+	// epoch_rewards are the rewards set aside for the reporters of the epochs
+	// that are not finalized yet
+	EpochRewards []EpochReward `protobuf:"bytes,8,rep,name=epoch_rewards,json=epochRewards,proto3" json:"epoch_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,8 +85,165 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochs() []EpochEntry {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubmissions() []SubmissionEntry {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnconfirmedSubmissions() []SubmissionKey {
+	if m != nil {
+		return m.UnconfirmedSubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConfirmedSubmissions() []SubmissionKey {
+	if m != nil {
+		return m.ConfirmedSubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFinalizedSubmissions() []SubmissionKey {
+	if m != nil {
+		return m.FinalizedSubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewards() []ReporterReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRewards() []EpochReward {
+	if m != nil {
+		return m.EpochRewards
+	}
+	return nil
+}
+
+// EpochEntry is the data of an epoch along with the epoch number
+type EpochEntry struct {
+	EpochNum uint64    `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	Data     EpochData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+func (m *EpochEntry) Reset()         { *m = EpochEntry{} }
+func (m *EpochEntry) String() string { return proto.CompactTextString(m) }
+func (*EpochEntry) ProtoMessage()    {}
+func (*EpochEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf9801ce688057b7, []int{1}
+}
+func (m *EpochEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEntry.Merge(m, src)
+}
+func (m *EpochEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochEntry proto.InternalMessageInfo
+
+func (m *EpochEntry) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EpochEntry) GetData() EpochData {
+	if m != nil {
+		return m.Data
+	}
+	return EpochData{}
+}
+
+// SubmissionEntry is the data of a submission along with its key
+type SubmissionEntry struct {
+	Key  SubmissionKey  `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Data SubmissionData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+func (m *SubmissionEntry) Reset()         { *m = SubmissionEntry{} }
+func (m *SubmissionEntry) String() string { return proto.CompactTextString(m) }
+func (*SubmissionEntry) ProtoMessage()    {}
+func (*SubmissionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf9801ce688057b7, []int{2}
+}
+func (m *SubmissionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionEntry.Merge(m, src)
+}
+func (m *SubmissionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionEntry proto.InternalMessageInfo
+
+func (m *SubmissionEntry) GetKey() SubmissionKey {
+	if m != nil {
+		return m.Key
+	}
+	return SubmissionKey{}
+}
+
+func (m *SubmissionEntry) GetData() SubmissionData {
+	if m != nil {
+		return m.Data
+	}
+	return SubmissionData{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btccheckpoint.v1.GenesisState")
+	proto.RegisterType((*EpochEntry)(nil), "babylon.btccheckpoint.v1.EpochEntry")
+	proto.RegisterType((*SubmissionEntry)(nil), "babylon.btccheckpoint.v1.SubmissionEntry")
 }
 
 func init() {
@@ -77,20 +251,37 @@ func init() {
 }
 
 var fileDescriptor_bf9801ce688057b7 = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0x4e, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc,
-	0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x80, 0x2a, 0xd2, 0x43, 0x51, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0x29, 0x61, 0x37, 0xb4, 0x20, 0xb1, 0x28, 0x31, 0x17,
-	0x6a, 0xa6, 0x92, 0x1f, 0x17, 0x8f, 0x3b, 0xc4, 0x92, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b,
-	0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x82, 0x1e, 0x2e, 0x4b, 0xf5,
-	0x02, 0xc0, 0xea, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x72, 0xf2, 0x3f, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x99, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05,
-	0x9a, 0x3b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xee, 0x34, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x8b, 0xb4, 0x95, 0x7a, 0x22, 0x01, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0x94, 0x40,
+	0x1c, 0xc7, 0x97, 0x2e, 0xa5, 0x75, 0xb6, 0xc6, 0x64, 0x52, 0x95, 0xac, 0x09, 0x6e, 0xa8, 0x46,
+	0x7a, 0x81, 0x58, 0xe3, 0x51, 0x4d, 0x88, 0x8d, 0x26, 0x26, 0x5a, 0xd9, 0x9b, 0x97, 0x66, 0x60,
+	0x67, 0x61, 0xd2, 0x32, 0x43, 0x66, 0x06, 0x15, 0x9f, 0xc2, 0x93, 0xef, 0xe1, 0x5b, 0xf4, 0xd8,
+	0xa3, 0x27, 0x63, 0x76, 0x5f, 0xc4, 0xec, 0x00, 0x2d, 0x90, 0x25, 0xfd, 0x73, 0xdb, 0x9d, 0x7c,
+	0xbf, 0x9f, 0xcf, 0x2f, 0xf0, 0x63, 0xc0, 0x5e, 0x88, 0xc2, 0xe2, 0x94, 0x51, 0x2f, 0x94, 0x51,
+	0x94, 0xe0, 0xe8, 0x24, 0x63, 0x84, 0x4a, 0x2f, 0xc6, 0x14, 0x0b, 0x22, 0xdc, 0x8c, 0x33, 0xc9,
+	0xa0, 0x59, 0x85, 0xdc, 0x56, 0xc8, 0xfd, 0xfa, 0x7c, 0xbc, 0x1b, 0xb3, 0x98, 0xa9, 0x90, 0xb7,
+	0xfa, 0x55, 0xe6, 0xc7, 0xf6, 0x7a, 0x68, 0x86, 0x38, 0x4a, 0x2b, 0xe6, 0x78, 0x7f, 0x7d, 0xa6,
+	0x6d, 0x50, 0x51, 0xfb, 0xf7, 0x26, 0xd8, 0x79, 0x57, 0x0e, 0x34, 0x95, 0x48, 0x62, 0xf8, 0x1a,
+	0x18, 0x25, 0xcb, 0xd4, 0x26, 0x9a, 0x33, 0x3a, 0x98, 0xb8, 0x7d, 0x03, 0xba, 0x47, 0x2a, 0xe7,
+	0xeb, 0x67, 0x7f, 0x1f, 0x0f, 0x82, 0xaa, 0x05, 0x7d, 0x60, 0xe0, 0x8c, 0x45, 0x89, 0x30, 0x37,
+	0x26, 0x43, 0x67, 0x74, 0xf0, 0xa4, 0xbf, 0x7f, 0xb8, 0xca, 0x1d, 0x52, 0xc9, 0x8b, 0x9a, 0x51,
+	0x36, 0xe1, 0x67, 0x30, 0x12, 0x79, 0x98, 0x12, 0x21, 0x08, 0xa3, 0xc2, 0x1c, 0x2a, 0xd0, 0x7e,
+	0x3f, 0x68, 0x7a, 0x11, 0x6e, 0xd2, 0x9a, 0x0c, 0x38, 0x07, 0x0f, 0x73, 0x1a, 0x31, 0x3a, 0x27,
+	0x3c, 0xc5, 0xb3, 0xe3, 0x26, 0x5e, 0x57, 0xf8, 0x67, 0xd7, 0xc1, 0x7f, 0xc0, 0x35, 0xfc, 0x41,
+	0x83, 0x36, 0x6d, 0x78, 0x42, 0x70, 0x7f, 0xbd, 0x65, 0xf3, 0x36, 0x96, 0xdd, 0x3e, 0xc7, 0x9c,
+	0x50, 0x74, 0x4a, 0x7e, 0x74, 0x1c, 0xc6, 0xad, 0x1c, 0x17, 0xac, 0xa6, 0xe3, 0x3d, 0xd8, 0xe2,
+	0xf8, 0x1b, 0xe2, 0x33, 0x61, 0x6e, 0x29, 0xaa, 0xd3, 0x4f, 0x0d, 0x70, 0xc6, 0xb8, 0xc4, 0x3c,
+	0x50, 0x85, 0x0a, 0x5b, 0xd7, 0xe1, 0x11, 0xb8, 0xab, 0x5e, 0xeb, 0x71, 0xcd, 0xdb, 0x56, 0xbc,
+	0xa7, 0x57, 0xec, 0x45, 0x0b, 0xb6, 0x83, 0x2f, 0x8f, 0x84, 0x9d, 0x00, 0x70, 0xb9, 0x3a, 0xf0,
+	0x11, 0xb8, 0x53, 0xf2, 0x69, 0x9e, 0xaa, 0x9d, 0xd5, 0x83, 0x6d, 0x75, 0xf0, 0x31, 0x4f, 0xe1,
+	0x2b, 0xa0, 0xcf, 0x90, 0x44, 0xe6, 0x86, 0xda, 0xe5, 0xbd, 0x2b, 0x9c, 0x6f, 0x91, 0x44, 0x95,
+	0x51, 0xd5, 0xec, 0x5f, 0x1a, 0xb8, 0xd7, 0x59, 0x2e, 0xf8, 0x06, 0x0c, 0x4f, 0x70, 0x51, 0x7d,
+	0x1d, 0x37, 0x7c, 0xd6, 0xab, 0x26, 0xf4, 0x5b, 0x33, 0x39, 0xd7, 0x21, 0x74, 0x07, 0xf3, 0x3f,
+	0x9d, 0x2d, 0x2c, 0xed, 0x7c, 0x61, 0x69, 0xff, 0x16, 0x96, 0xf6, 0x73, 0x69, 0x0d, 0xce, 0x97,
+	0xd6, 0xe0, 0xcf, 0xd2, 0x1a, 0x7c, 0x79, 0x19, 0x13, 0x99, 0xe4, 0xa1, 0x1b, 0xb1, 0xd4, 0xab,
+	0xc8, 0x51, 0x82, 0x08, 0xad, 0xff, 0x78, 0xdf, 0x3b, 0xb7, 0x82, 0x2c, 0x32, 0x2c, 0x42, 0x43,
+	0x5d, 0x07, 0x2f, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x62, 0x0f, 0x03, 0xe3, 0xb4, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +304,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FinalizedSubmissions) > 0 {
+		for iNdEx := len(m.FinalizedSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizedSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConfirmedSubmissions) > 0 {
+		for iNdEx := len(m.ConfirmedSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmedSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnconfirmedSubmissions) > 0 {
+		for iNdEx := len(m.UnconfirmedSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnconfirmedSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -126,6 +415,87 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNum != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -145,6 +515,75 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnconfirmedSubmissions) > 0 {
+		for _, e := range m.UnconfirmedSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfirmedSubmissions) > 0 {
+		for _, e := range m.ConfirmedSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FinalizedSubmissions) > 0 {
+		for _, e := range m.FinalizedSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNum))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SubmissionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Data.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -216,6 +655,462 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochEntry{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, SubmissionEntry{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnconfirmedSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnconfirmedSubmissions = append(m.UnconfirmedSubmissions, SubmissionKey{})
+			if err := m.UnconfirmedSubmissions[len(m.UnconfirmedSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmedSubmissions = append(m.ConfirmedSubmissions, SubmissionKey{})
+			if err := m.ConfirmedSubmissions[len(m.ConfirmedSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedSubmissions = append(m.FinalizedSubmissions, SubmissionKey{})
+			if err := m.FinalizedSubmissions[len(m.FinalizedSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, ReporterReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRewards = append(m.EpochRewards, EpochReward{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,83 @@ package types_test
 import (
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
+func genSubmission(epoch uint64) types.SubmissionEntry {
+	return types.SubmissionEntry{
+		Key: types.SubmissionKey{Key: []*types.TransactionKey{
+			{Index: 1, Hash: datagen.GenRandomBTCHeaderPrevBlock()},
+			{Index: 2, Hash: datagen.GenRandomBTCHeaderPrevBlock()},
+		}},
+		Data: types.SubmissionData{
+			Submitter:      datagen.GenRandomByteArray(20),
+			Btctransaction: [][]byte{datagen.GenRandomByteArray(100), datagen.GenRandomByteArray(100)},
+			Epoch:          epoch,
+		},
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	sub1 := genSubmission(1)
+	sub2 := genSubmission(1)
+	sub3 := genSubmission(2)
+	missing := genSubmission(2)
+	newGenState := func(submissions []types.SubmissionEntry, epochs ...types.EpochEntry) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.Epochs = epochs
+		genState.Submissions = submissions
+		genState.UnconfirmedSubmissions = []types.SubmissionKey{sub3.Key}
+		genState.FinalizedSubmissions = []types.SubmissionKey{sub1.Key, sub2.Key}
+		return genState
+	}
+	epoch1 := types.EpochEntry{EpochNum: 1, Data: types.EpochData{
+		Key:    []*types.SubmissionKey{&sub1.Key, &sub2.Key},
+		Status: types.Finalized,
+	}}
+	epoch2 := types.EpochEntry{EpochNum: 2, Data: types.EpochData{
+		Key:    []*types.SubmissionKey{&sub3.Key},
+		Status: types.Submitted,
+	}}
+	epochWithMissingKey := types.EpochEntry{EpochNum: 2, Data: types.EpochData{
+		Key:    []*types.SubmissionKey{&sub3.Key, &missing.Key},
+		Status: types.Submitted,
+	}}
+	epochWithWrongKey := types.EpochEntry{EpochNum: 2, Data: types.EpochData{
+		Key:    []*types.SubmissionKey{&sub3.Key, &sub1.Key},
+		Status: types.Submitted,
+	}}
+	notIndexed := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	notIndexed.UnconfirmedSubmissions = nil
+	indexedTwice := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	indexedTwice.ConfirmedSubmissions = []types.SubmissionKey{sub3.Key}
+	reward := types.ReporterReward{
+		EpochNum:      1,
+		SubmissionKey: sub1.Key,
+		Submitter:     sdk.AccAddress(sub1.Data.Submitter).String(),
+		BtcHeight:     10,
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	rewarded := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	rewarded.Rewards = []types.ReporterReward{reward}
+	rewardedTwice := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	rewardedTwice.Rewards = []types.ReporterReward{reward, reward}
+	invalidRewardSubmitter := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	invalidRewardSubmitter.Rewards = []types.ReporterReward{reward}
+	invalidRewardSubmitter.Rewards[0].Submitter = "invalid"
+	epochReward := types.EpochReward{EpochNum: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	setAside := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	setAside.Rewards = []types.ReporterReward{reward}
+	setAside.EpochRewards = []types.EpochReward{epochReward}
+	setAsideTwice := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	setAsideTwice.EpochRewards = []types.EpochReward{epochReward, epochReward}
+	setAsideForRewarded := newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2)
+	setAsideForRewarded.Rewards = []types.ReporterReward{reward}
+	setAsideForRewarded.EpochRewards = []types.EpochReward{{EpochNum: 1, Amount: epochReward.Amount}}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -24,10 +96,82 @@ func TestGenesisState_Validate(t *testing.T) {
 				Params: types.Params{
 					BtcConfirmationDepth:          124,
 					CheckpointFinalizationTimeout: 12222,
+					ReporterRewardShare:           types.DefaultReporterRewardShare,
+					AcceptedFormatVersions:        types.DefaultAcceptedFormatVersions,
 				},
 			},
 			valid: true,
 		},
+		{
+			desc:     "epochs with submissions",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2),
+			valid:    true,
+		},
+		{
+			desc:     "duplicate epoch",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epoch2, epoch2),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate submission",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3, sub3}, epoch1, epoch2),
+			valid:    false,
+		},
+		{
+			desc:     "submission referenced by an epoch does not exist",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epochWithMissingKey),
+			valid:    false,
+		},
+		{
+			desc:     "submission referenced by a different epoch",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1, epochWithWrongKey),
+			valid:    false,
+		},
+		{
+			desc:     "submission not referenced by its epoch",
+			genState: newGenState([]types.SubmissionEntry{sub1, sub2, sub3}, epoch1),
+			valid:    false,
+		},
+		{
+			desc:     "submission not indexed",
+			genState: notIndexed,
+			valid:    false,
+		},
+		{
+			desc:     "submission indexed twice",
+			genState: indexedTwice,
+			valid:    false,
+		},
+		{
+			desc:     "rewarded epoch",
+			genState: rewarded,
+			valid:    true,
+		},
+		{
+			desc:     "epoch rewarded twice",
+			genState: rewardedTwice,
+			valid:    false,
+		},
+		{
+			desc:     "reward with invalid submitter",
+			genState: invalidRewardSubmitter,
+			valid:    false,
+		},
+		{
+			desc:     "reward set aside for epoch",
+			genState: setAside,
+			valid:    true,
+		},
+		{
+			desc:     "reward set aside twice for epoch",
+			genState: setAsideTwice,
+			valid:    false,
+		},
+		{
+			desc:     "reward set aside for rewarded epoch",
+			genState: setAsideForRewarded,
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/babylonchain/babylon/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,6 +29,9 @@ var (
 	ConfirmedIndexPrefix   = []byte{5}
 	FinalizedIndexPrefix   = []byte{6}
 	EpochDataPrefix        = []byte{7}
+	ReporterRewardPrefix   = []byte{8}
+	BlockHashIndexPrefix   = []byte{9}
+	EpochRewardPrefix      = []byte{10}
 )
 
 func KeyPrefix(p string) []byte {
@@ -50,6 +54,24 @@ func FinalizedSubmissionsKey(cdc codec.BinaryCodec, k *SubmissionKey) []byte {
 	return append(FinalizedIndexPrefix, cdc.MustMarshal(k)...)
 }
 
+// BlockHashIndexPrefixKey returns the prefix of the keys of the submissions
+// including a transaction from the block with the given hash
+func BlockHashIndexPrefixKey(hash *types.BTCHeaderHashBytes) []byte {
+	return append(BlockHashIndexPrefix, hash.MustMarshal()...)
+}
+
+func BlockHashIndexKey(cdc codec.BinaryCodec, hash *types.BTCHeaderHashBytes, k *SubmissionKey) []byte {
+	return append(BlockHashIndexPrefixKey(hash), cdc.MustMarshal(k)...)
+}
+
 func GetEpochIndexKey(e uint64) []byte {
 	return append(EpochDataPrefix, sdk.Uint64ToBigEndian(e)...)
 }
+
+func GetReporterRewardKey(e uint64) []byte {
+	return append(ReporterRewardPrefix, sdk.Uint64ToBigEndian(e)...)
+}
+
+func GetEpochRewardKey(e uint64) []byte {
+	return append(EpochRewardPrefix, sdk.Uint64ToBigEndian(e)...)
+}
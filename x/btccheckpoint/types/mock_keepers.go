@@ -4,23 +4,69 @@ import (
 	"errors"
 
 	bbn "github.com/babylonchain/babylon/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type MockBTCLightClientKeeper struct {
 	depth       int64
 	returnError bool
+	heights     map[string]uint64
+	mainChain   []bbn.BTCHeaderBytes
 }
 
 type MockCheckpointingKeeper struct {
-	epoch       uint64
-	returnError bool
+	epoch          uint64
+	returnError    bool
+	returnConflict bool
+	valSet         *ckpttypes.ValidatorWithBlsKeySet
+}
+
+// MockBankKeeper keeps the balances of the accounts in memory
+type MockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{balances: map[string]sdk.Coins{}}
+}
+
+// MintCoins adds the given amount to the balance of the module account
+func (mb *MockBankKeeper) MintCoins(moduleName string, amt sdk.Coins) {
+	addr := authtypes.NewModuleAddress(moduleName)
+	mb.balances[addr.String()] = mb.balances[addr.String()].Add(amt...)
+}
+
+func (mb *MockBankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return mb.GetAllBalances(ctx, addr)
+}
+
+func (mb *MockBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return mb.balances[addr.String()]
+}
+
+func (mb *MockBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return mb.SendCoinsFromModuleToAccount(ctx, senderModule, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (mb *MockBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	senderAddr := authtypes.NewModuleAddress(senderModule)
+	balance, negative := mb.balances[senderAddr.String()].SafeSub(amt)
+	if negative {
+		return errors.New("insufficient funds")
+	}
+	mb.balances[senderAddr.String()] = balance
+	mb.balances[recipientAddr.String()] = mb.balances[recipientAddr.String()].Add(amt...)
+	return nil
 }
 
 func NewMockBTCLightClientKeeper(initialDepth int64) *MockBTCLightClientKeeper {
 	lc := MockBTCLightClientKeeper{
 		depth:       initialDepth,
 		returnError: false,
+		heights:     map[string]uint64{},
 	}
 	return &lc
 }
@@ -37,12 +83,23 @@ func (mc *MockCheckpointingKeeper) SetEpoch(e uint64) {
 	mc.epoch = e
 }
 
+// SetValidatorSet sets the validator set returned for every epoch
+func (mc *MockCheckpointingKeeper) SetValidatorSet(valSet *ckpttypes.ValidatorWithBlsKeySet) {
+	mc.valSet = valSet
+}
+
 func (mc *MockCheckpointingKeeper) ReturnError() {
 	mc.returnError = true
 }
 
+// ReturnConflict makes CheckpointEpoch report a conflicting checkpoint
+func (mc *MockCheckpointingKeeper) ReturnConflict() {
+	mc.returnConflict = true
+}
+
 func (mc *MockCheckpointingKeeper) ReturnSuccess() {
 	mc.returnError = false
+	mc.returnConflict = false
 }
 
 func (mc *MockBTCLightClientKeeper) SetDepth(d int64) {
@@ -57,11 +114,33 @@ func (mc *MockBTCLightClientKeeper) ReturnSuccess() {
 	mc.returnError = false
 }
 
+// SetBlockHeight sets the height returned for the given header,
+// while the height of all the other headers is 10
+func (mc *MockBTCLightClientKeeper) SetBlockHeight(header *bbn.BTCHeaderHashBytes, height uint64) {
+	mc.heights[header.String()] = height
+}
+
 func (mb MockBTCLightClientKeeper) BlockHeight(ctx sdk.Context, header *bbn.BTCHeaderHashBytes) (uint64, error) {
-	// todo not used
+	if height, ok := mb.heights[header.String()]; ok {
+		return height, nil
+	}
 	return uint64(10), nil
 }
 
+// SetMainChain sets the headers of the main chain, in ascending order
+func (mc *MockBTCLightClientKeeper) SetMainChain(headers []bbn.BTCHeaderBytes) {
+	mc.mainChain = headers
+}
+
+func (mb MockBTCLightClientKeeper) MainChainFrom(ctx sdk.Context, headerHash *bbn.BTCHeaderHashBytes) ([]bbn.BTCHeaderBytes, error) {
+	for i := range mb.mainChain {
+		if mb.mainChain[i].Hash().Eq(headerHash) {
+			return mb.mainChain[i:], nil
+		}
+	}
+	return nil, errors.New("header not on the main chain")
+}
+
 func (mb MockBTCLightClientKeeper) IsAncestor(ctx sdk.Context, parentHash *bbn.BTCHeaderHashBytes, childHash *bbn.BTCHeaderHashBytes) (bool, error) {
 	return true, nil
 }
@@ -79,6 +158,10 @@ func (ck MockCheckpointingKeeper) CheckpointEpoch(ctx sdk.Context, rawCheckpoint
 		return 0, errors.New("bad checkpoints")
 	}
 
+	if ck.returnConflict {
+		return 0, ckpttypes.ErrConflictingCheckpoint
+	}
+
 	return ck.epoch, nil
 }
 
@@ -101,3 +184,19 @@ func (ck MockCheckpointingKeeper) SetCheckpointFinalized(ctx sdk.Context, epoch
 // lost all its checkpoints and is checkpoint empty
 func (ck MockCheckpointingKeeper) SetCheckpointForgotten(ctx sdk.Context, epoch uint64) {
 }
+
+func (ck MockCheckpointingKeeper) GetBLSPubKeySet(ctx sdk.Context, epochNumber uint64) (*ckpttypes.ValidatorWithBlsKeySet, error) {
+	if ck.valSet == nil {
+		return nil, errors.New("unknown validator set")
+	}
+	return ck.valSet, nil
+}
+
+// GetEpoch returns the epoch set in the mock, which started at the current block
+func (ck MockCheckpointingKeeper) GetEpoch(ctx sdk.Context) epochingtypes.Epoch {
+	return epochingtypes.Epoch{
+		EpochNumber:          ck.epoch,
+		CurrentEpochInterval: 10,
+		FirstBlockHeight:     uint64(ctx.BlockHeight()),
+	}
+}
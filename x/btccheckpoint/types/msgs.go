@@ -16,64 +16,81 @@ var (
 	_ sdk.Msg = (*MsgInsertBTCSpvProof)(nil)
 )
 
-// Parse and Validate transactions which should contain OP_RETURN data.
+// Parse and Validate transactions which should contain OP_RETURN data, or a witness
+// envelope in case of proofs with a witness commitment proof.
 // OP_RETURN bytes are not validated in any way. It is up to the caller attach
 // semantic meaning and validity to those bytes.
 // Returned ParsedProofs are in same order as raw proofs
-func ParseTwoProofs(
+func ParseProofs(
 	submitter sdk.AccAddress,
 	proofs []*BTCSpvProof,
 	powLimit *big.Int,
 	expectedTag txformat.BabylonTag) (*RawCheckpointSubmission, error) {
 	// Expecting as many proofs as many parts our checkpoint is composed of
-	if len(proofs) != txformat.NumberOfParts {
-		return nil, fmt.Errorf("expected at exactly valid op return transactions")
+	if len(proofs) < 1 || len(proofs) > txformat.MaxNumberOfParts {
+		return nil, fmt.Errorf("expected between 1 and %d transactions", txformat.MaxNumberOfParts)
 	}
 
-	var parsedProofs []*ParsedProof
+	var parsedProofs []ParsedProof
 
 	for _, proof := range proofs {
-		parsedProof, e :=
-			ParseProof(
-				proof.BtcTransaction,
-				proof.BtcTransactionIndex,
-				proof.MerkleNodes,
-				proof.ConfirmingBtcHeader,
-				powLimit,
-			)
+		var parsedProof *ParsedProof
+		var e error
+
+		if proof.WitnessCommitmentProof != nil {
+			parsedProof, e = ParseWitnessProof(proof, powLimit)
+		} else {
+			parsedProof, e =
+				ParseProof(
+					proof.BtcTransaction,
+					proof.BtcTransactionIndex,
+					proof.MerkleNodes,
+					proof.ConfirmingBtcHeader,
+					powLimit,
+				)
+		}
 
 		if e != nil {
 			return nil, e
 		}
 
-		parsedProofs = append(parsedProofs, parsedProof)
+		parsedProofs = append(parsedProofs, *parsedProof)
 	}
 
-	var checkpointData [][]byte
+	// all parts of the checkpoint are encoded in the same version, the one of the first part
+	var parts [][]byte
+	inWitness := len(parsedProofs[0].WitnessData) > 0
 
-	for i, proof := range parsedProofs {
-		data, err := txformat.GetCheckpointData(
-			expectedTag,
-			txformat.CurrentVersion,
-			uint8(i),
-			proof.OpReturnData,
-		)
+	for _, proof := range parsedProofs {
+		if inWitness != (len(proof.WitnessData) > 0) {
+			return nil, fmt.Errorf("all parts of the checkpoint should be carried in the same way")
+		}
 
-		if err != nil {
-			return nil, err
+		if inWitness {
+			parts = append(parts, proof.WitnessData)
+		} else {
+			parts = append(parts, proof.OpReturnData)
 		}
-		checkpointData = append(checkpointData, data)
 	}
 
-	// at this point we know we have two correctly formated babylon op return transacitons
-	// we need to check if parts match
-	fullTxData, err := txformat.ConnectParts(txformat.CurrentVersion, checkpointData[0], checkpointData[1])
+	version, fullTxData, err := txformat.DecodeCheckpoint(expectedTag, parts)
 
 	if err != nil {
 		return nil, err
 	}
 
-	sub := NewRawCheckpointSubmission(submitter, *parsedProofs[0], *parsedProofs[1], fullTxData)
+	spec, err := txformat.GetFormatSpec(version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.InWitness != inWitness {
+		return nil, fmt.Errorf("checkpoint in format version %d is not carried in the expected way", version)
+	}
+
+	sub := NewRawCheckpointSubmission(submitter, version, parsedProofs, fullTxData)
+	sub.spvProofs = proofs
 
 	return &sub, nil
 }
@@ -89,7 +106,7 @@ func (m *MsgInsertBTCSpvProof) ValidateBasic() error {
 	// whole parsing stuff is stateless
 	powLimit := bbl.GetGlobalPowLimit()
 
-	_, err = ParseTwoProofs(address, m.Proofs, &powLimit, bbl.GetGlobalCheckPointTag())
+	_, err = ParseProofs(address, m.Proofs, &powLimit, bbl.GetGlobalCheckPointTag())
 
 	if err != nil {
 		return err
@@ -3,6 +3,8 @@ package types
 import (
 	fmt "fmt"
 
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,9 +13,17 @@ const (
 	DefaultCheckpointFinalizationTimeout uint64 = 100
 )
 
+var (
+	DefaultReporterRewardShare = sdk.NewDecWithPrec(1, 2)
+	// all format versions registered in btctxformatter are accepted by default
+	DefaultAcceptedFormatVersions = defaultAcceptedFormatVersions()
+)
+
 var (
 	KeyBtcConfirmationDepth          = []byte("BtcConfirmationDepth")
 	KeyCheckpointFinalizationTimeout = []byte("CheckpointFinalizationTimeout")
+	KeyReporterRewardShare           = []byte("ReporterRewardShare")
+	KeyAcceptedFormatVersions        = []byte("AcceptedFormatVersions")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -23,11 +33,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+func defaultAcceptedFormatVersions() []uint32 {
+	var versions []uint32
+	for _, v := range txformat.RegisteredVersions() {
+		versions = append(versions, uint32(v))
+	}
+	return versions
+}
+
 // NewParams creates a new Params instance
-func NewParams(btcConfirmationDepth uint64, checkpointFinalizationTimeout uint64) Params {
+func NewParams(
+	btcConfirmationDepth uint64,
+	checkpointFinalizationTimeout uint64,
+	reporterRewardShare sdk.Dec,
+	acceptedFormatVersions []uint32,
+) Params {
 	return Params{
 		BtcConfirmationDepth:          btcConfirmationDepth,
 		CheckpointFinalizationTimeout: checkpointFinalizationTimeout,
+		ReporterRewardShare:           reporterRewardShare,
+		AcceptedFormatVersions:        acceptedFormatVersions,
 	}
 }
 
@@ -36,6 +61,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultBtcConfirmationDepth,
 		DefaultCheckpointFinalizationTimeout,
+		DefaultReporterRewardShare,
+		DefaultAcceptedFormatVersions,
 	)
 }
 
@@ -44,6 +71,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyBtcConfirmationDepth, &p.BtcConfirmationDepth, validateBtcConfirmationDepth),
 		paramtypes.NewParamSetPair(KeyCheckpointFinalizationTimeout, &p.CheckpointFinalizationTimeout, validateCheckpointFinalizationTimeout),
+		paramtypes.NewParamSetPair(KeyReporterRewardShare, &p.ReporterRewardShare, validateReporterRewardShare),
+		paramtypes.NewParamSetPair(KeyAcceptedFormatVersions, &p.AcceptedFormatVersions, validateAcceptedFormatVersions),
 	}
 }
 
@@ -55,6 +84,12 @@ func (p Params) Validate() error {
 	if err := validateCheckpointFinalizationTimeout(p.CheckpointFinalizationTimeout); err != nil {
 		return err
 	}
+	if err := validateReporterRewardShare(p.ReporterRewardShare); err != nil {
+		return err
+	}
+	if err := validateAcceptedFormatVersions(p.AcceptedFormatVersions); err != nil {
+		return err
+	}
 	if p.BtcConfirmationDepth >= p.CheckpointFinalizationTimeout {
 		return fmt.Errorf("BtcConfirmationDepth should be smaller than CheckpointFinalizationTimeout")
 	}
@@ -87,3 +122,72 @@ func validateCheckpointFinalizationTimeout(i interface{}) error {
 
 	return nil
 }
+
+func validateReporterRewardShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("ReporterRewardShare must not be nil")
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("ReporterRewardShare must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateAcceptedFormatVersions(i interface{}) error {
+	v, ok := i.([]uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("AcceptedFormatVersions must not be empty")
+	}
+
+	seen := make(map[uint32]bool)
+	for _, version := range v {
+		if seen[version] {
+			return fmt.Errorf("AcceptedFormatVersions contains duplicated version %d", version)
+		}
+		seen[version] = true
+
+		if version > 0xf {
+			return fmt.Errorf("AcceptedFormatVersions contains invalid version %d", version)
+		}
+
+		if _, err := txformat.GetFormatSpec(txformat.FormatVersion(version)); err != nil {
+			return fmt.Errorf("AcceptedFormatVersions contains unknown version %d", version)
+		}
+	}
+
+	return nil
+}
+
+// MaxBitMapLength returns the length of the longest bitmap of the checkpoints which
+// can be encoded in the accepted format versions
+func (p Params) MaxBitMapLength() int {
+	versions := make([]txformat.FormatVersion, len(p.AcceptedFormatVersions))
+	for i, v := range p.AcceptedFormatVersions {
+		versions[i] = txformat.FormatVersion(v)
+	}
+
+	return txformat.MaxBitMapLength(versions)
+}
+
+// IsFormatVersionAccepted returns true if checkpoints encoded in the given format
+// version are accepted
+func (p Params) IsFormatVersionAccepted(version txformat.FormatVersion) bool {
+	for _, v := range p.AcceptedFormatVersions {
+		if v == uint32(version) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,6 +36,14 @@ type Params struct {
 	// If a checkpoint has not been reported back within w BTC blocks, then BBN has dishonest majority and is stalling checkpoints
 	// (w in research paper)
 	CheckpointFinalizationTimeout uint64 `protobuf:"varint,2,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty" yaml:"checkpoint_finalization_timeout"`
+	// reporter_reward_share is the share of the block rewards (inflation and fees) collected
+	// by the fee collector which is transferred to the btccheckpoint module account in every block.
+	// The module account pays out the reporters of the checkpoints when their epochs are finalized.
+	ReporterRewardShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reporter_reward_share,json=reporterRewardShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reporter_reward_share" yaml:"reporter_reward_share"`
+	// accepted_format_versions lists the versions of the btctxformatter encoding in which
+	// checkpoints are accepted. New encodings can be rolled out, and old ones retired,
+	// by governance without a coordinated binary switch.
+	AcceptedFormatVersions []uint32 `protobuf:"varint,4,rep,packed,name=accepted_format_versions,json=acceptedFormatVersions,proto3" json:"accepted_format_versions,omitempty" yaml:"accepted_format_versions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -84,6 +93,13 @@ func (m *Params) GetCheckpointFinalizationTimeout() uint64 {
 	return 0
 }
 
+func (m *Params) GetAcceptedFormatVersions() []uint32 {
+	if m != nil {
+		return m.AcceptedFormatVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btccheckpoint.v1.Params")
 }
@@ -93,24 +109,32 @@ func init() {
 }
 
 var fileDescriptor_4beca7ec42c8d1bd = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0x4e, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc,
-	0x2b, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
-	0x80, 0xaa, 0xd1, 0x43, 0x51, 0xa3, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56,
-	0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xdd, 0x66, 0xe4, 0x62, 0x0b, 0x00, 0x1b, 0x20, 0x14, 0xce,
-	0x25, 0x96, 0x54, 0x92, 0x1c, 0x9f, 0x9c, 0x9f, 0x97, 0x96, 0x59, 0x94, 0x9b, 0x58, 0x92, 0x99,
-	0x9f, 0x17, 0x9f, 0x92, 0x5a, 0x50, 0x92, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe2, 0xa4, 0xf8,
-	0xe9, 0x9e, 0xbc, 0x6c, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x76, 0x75, 0x4a, 0x41, 0x22, 0x49,
-	0x25, 0xc9, 0xce, 0x48, 0xe2, 0x2e, 0x20, 0x61, 0xa1, 0x22, 0x2e, 0x79, 0x84, 0x53, 0xe2, 0xd3,
-	0x32, 0xf3, 0x12, 0x73, 0x32, 0xab, 0x20, 0xfa, 0x4a, 0x32, 0x73, 0x53, 0xf3, 0x4b, 0x4b, 0x24,
-	0x98, 0xc0, 0x36, 0x68, 0x7d, 0xba, 0x27, 0xaf, 0x06, 0xb1, 0x81, 0x80, 0x06, 0xa5, 0x20, 0x59,
-	0x84, 0x0a, 0x37, 0x24, 0x05, 0x21, 0x10, 0x79, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0xfc,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x64, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e,
-	0x7e, 0x05, 0x5a, 0x28, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43, 0xcd, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xd8, 0x05, 0x8f, 0x44, 0x8b, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6a, 0xdb, 0x30,
+	0x1c, 0xc7, 0xad, 0x25, 0x04, 0x66, 0xd8, 0xc5, 0xcb, 0x82, 0x19, 0x8b, 0x95, 0x79, 0x10, 0xc2,
+	0x60, 0x36, 0x63, 0xec, 0x92, 0xa3, 0x17, 0x72, 0xdc, 0x86, 0x37, 0x5a, 0x28, 0x14, 0x23, 0xcb,
+	0x4a, 0x2c, 0x12, 0x5b, 0x46, 0x52, 0xd2, 0xa6, 0xc7, 0x3e, 0x41, 0x1f, 0xa1, 0x6f, 0xd2, 0x6b,
+	0x8e, 0x39, 0x96, 0x1e, 0x4c, 0x49, 0x2e, 0x3d, 0xfb, 0x09, 0x8a, 0xff, 0x84, 0xa4, 0x25, 0xa5,
+	0x27, 0x49, 0xdf, 0xef, 0xe7, 0xf7, 0x4f, 0xfc, 0x54, 0xd3, 0x47, 0xfe, 0x62, 0xca, 0x62, 0xdb,
+	0x97, 0x18, 0x87, 0x04, 0x4f, 0x12, 0x46, 0x63, 0x69, 0x27, 0x88, 0xa3, 0x48, 0x58, 0x09, 0x67,
+	0x92, 0x69, 0x7a, 0xc5, 0x58, 0x4f, 0x18, 0x6b, 0xfe, 0xfd, 0x63, 0x73, 0xcc, 0xc6, 0xac, 0x80,
+	0xec, 0xfc, 0x56, 0xf2, 0xe6, 0x4d, 0x4d, 0x6d, 0xfc, 0x2d, 0x12, 0x68, 0xc7, 0x6a, 0xcb, 0x97,
+	0xd8, 0xc3, 0x2c, 0x1e, 0x51, 0x1e, 0x21, 0x49, 0x59, 0xec, 0x05, 0x24, 0x91, 0xa1, 0x0e, 0x3a,
+	0xa0, 0x57, 0x77, 0x3e, 0x67, 0x29, 0x6c, 0x2f, 0x50, 0x34, 0xed, 0x9b, 0x87, 0x39, 0xd3, 0x6d,
+	0xfa, 0x12, 0xff, 0xda, 0xd3, 0x07, 0xb9, 0xac, 0x71, 0x15, 0xee, 0x5a, 0xf1, 0x46, 0x34, 0x46,
+	0x53, 0x7a, 0x51, 0xc6, 0x49, 0x1a, 0x11, 0x36, 0x93, 0xfa, 0x9b, 0xa2, 0xc2, 0xd7, 0x2c, 0x85,
+	0xdd, 0xb2, 0xc2, 0x2b, 0x01, 0xa6, 0xdb, 0xde, 0x11, 0xc3, 0x3d, 0xe0, 0x7f, 0xe9, 0x6b, 0x97,
+	0x40, 0xfd, 0xc0, 0x49, 0xc2, 0xb8, 0x24, 0xdc, 0xe3, 0xe4, 0x0c, 0xf1, 0xc0, 0x13, 0x21, 0xe2,
+	0x44, 0xaf, 0x75, 0x40, 0xef, 0xad, 0xf3, 0x7b, 0x99, 0x42, 0xe5, 0x2e, 0x85, 0xdd, 0x31, 0x95,
+	0xe1, 0xcc, 0xb7, 0x30, 0x8b, 0x6c, 0xcc, 0x44, 0xc4, 0x44, 0x75, 0x7c, 0x13, 0xc1, 0xc4, 0x96,
+	0x8b, 0x84, 0x08, 0x6b, 0x40, 0x70, 0x96, 0xc2, 0x4f, 0x65, 0x63, 0x07, 0x93, 0x9a, 0xee, 0xfb,
+	0xad, 0xee, 0x16, 0xf2, 0xbf, 0x5c, 0xd5, 0x4e, 0x55, 0x1d, 0x61, 0x4c, 0x12, 0x49, 0x02, 0x6f,
+	0xc4, 0xf2, 0x3f, 0xf1, 0xe6, 0x84, 0x0b, 0xca, 0x62, 0xa1, 0xd7, 0x3b, 0xb5, 0xde, 0x3b, 0xe7,
+	0x4b, 0x96, 0x42, 0x58, 0x26, 0x7e, 0x89, 0x34, 0xdd, 0xd6, 0xd6, 0x1a, 0x16, 0xce, 0x51, 0x65,
+	0xf4, 0xeb, 0x0f, 0xd7, 0x10, 0x38, 0x7f, 0x96, 0x6b, 0x03, 0xac, 0xd6, 0x06, 0xb8, 0x5f, 0x1b,
+	0xe0, 0x6a, 0x63, 0x28, 0xab, 0x8d, 0xa1, 0xdc, 0x6e, 0x0c, 0xe5, 0xe4, 0xe7, 0xde, 0x6c, 0xd5,
+	0x5a, 0xe0, 0x10, 0xd1, 0x78, 0xfb, 0xb0, 0xcf, 0x9f, 0x6d, 0x52, 0x31, 0xae, 0xdf, 0x28, 0x36,
+	0xe3, 0xc7, 0x63, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0x35, 0x7d, 0x9e, 0x6f, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -138,6 +162,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CheckpointFinalizationTimeout != that1.CheckpointFinalizationTimeout {
 		return false
 	}
+	if !this.ReporterRewardShare.Equal(that1.ReporterRewardShare) {
+		return false
+	}
+	if len(this.AcceptedFormatVersions) != len(that1.AcceptedFormatVersions) {
+		return false
+	}
+	for i := range this.AcceptedFormatVersions {
+		if this.AcceptedFormatVersions[i] != that1.AcceptedFormatVersions[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -160,6 +195,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AcceptedFormatVersions) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptedFormatVersions)*10)
+		var j1 int
+		for _, num := range m.AcceptedFormatVersions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ReporterRewardShare.Size()
+		i -= size
+		if _, err := m.ReporterRewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.CheckpointFinalizationTimeout != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
 		i--
@@ -196,6 +259,15 @@ func (m *Params) Size() (n int) {
 	if m.CheckpointFinalizationTimeout != 0 {
 		n += 1 + sovParams(uint64(m.CheckpointFinalizationTimeout))
 	}
+	l = m.ReporterRewardShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AcceptedFormatVersions) > 0 {
+		l = 0
+		for _, e := range m.AcceptedFormatVersions {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -272,6 +344,116 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterRewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterRewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptedFormatVersions = append(m.AcceptedFormatVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcceptedFormatVersions) == 0 {
+					m.AcceptedFormatVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptedFormatVersions = append(m.AcceptedFormatVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFormatVersions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,3 +20,34 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsAcceptedFormatVersions(t *testing.T) {
+	p := types.DefaultParams()
+	require.NoError(t, p.Validate())
+
+	for _, v := range txformat.RegisteredVersions() {
+		require.True(t, p.IsFormatVersionAccepted(v))
+	}
+
+	require.Equal(t, txformat.MaxSupportedBitMapLength(), p.MaxBitMapLength())
+
+	p.AcceptedFormatVersions = []uint32{uint32(txformat.NPartsVersion)}
+	require.NoError(t, p.Validate())
+	require.False(t, p.IsFormatVersionAccepted(txformat.CurrentVersion))
+	require.True(t, p.IsFormatVersionAccepted(txformat.NPartsVersion))
+	require.Equal(t, txformat.MaxNPartsBitMapLength, p.MaxBitMapLength())
+
+	// only the bitmaps of the accepted format versions bound the validator sets
+	p.AcceptedFormatVersions = []uint32{uint32(txformat.CurrentVersion)}
+	require.NoError(t, p.Validate())
+	require.Equal(t, txformat.BitMapLength, p.MaxBitMapLength())
+
+	p.AcceptedFormatVersions = []uint32{}
+	require.Error(t, p.Validate())
+
+	p.AcceptedFormatVersions = []uint32{uint32(txformat.CurrentVersion), uint32(txformat.CurrentVersion)}
+	require.Error(t, p.Validate())
+
+	p.AcceptedFormatVersions = []uint32{15}
+	require.Error(t, p.Validate())
+}
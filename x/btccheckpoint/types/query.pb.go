@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -204,45 +204,443 @@ func (m *QueryBtcCheckpointHeightResponse) GetEarliestBtcBlockNumber() uint64 {
 	return 0
 }
 
+type QueryEpochSubmissionsRequest struct {
+	// Number of epoch for which submissions are requested
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryEpochSubmissionsRequest) Reset()         { *m = QueryEpochSubmissionsRequest{} }
+func (m *QueryEpochSubmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubmissionsRequest) ProtoMessage()    {}
+func (*QueryEpochSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{4}
+}
+func (m *QueryEpochSubmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubmissionsRequest.Merge(m, src)
+}
+func (m *QueryEpochSubmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubmissionsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSubmissionsRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryEpochSubmissionsResponse is response type for the Query/EpochSubmissions RPC method
+type QueryEpochSubmissionsResponse struct {
+	// Current status of the epoch
+	EpochStatus EpochStatus `protobuf:"varint,1,opt,name=epoch_status,json=epochStatus,proto3,enum=babylon.btccheckpoint.v1.EpochStatus" json:"epoch_status,omitempty"`
+	// All submissions of the epoch, sorted by order of submission
+	Submissions []*SubmissionInfo `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (m *QueryEpochSubmissionsResponse) Reset()         { *m = QueryEpochSubmissionsResponse{} }
+func (m *QueryEpochSubmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubmissionsResponse) ProtoMessage()    {}
+func (*QueryEpochSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{5}
+}
+func (m *QueryEpochSubmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubmissionsResponse.Merge(m, src)
+}
+func (m *QueryEpochSubmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSubmissionsResponse) GetEpochStatus() EpochStatus {
+	if m != nil {
+		return m.EpochStatus
+	}
+	return Submitted
+}
+
+func (m *QueryEpochSubmissionsResponse) GetSubmissions() []*SubmissionInfo {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+// SubmissionInfo describes a submission of an epoch and its current state on the btc chain
+type SubmissionInfo struct {
+	// Block hashes and transaction indexes of the transactions of the submission
+	SubmissionKey SubmissionKey `protobuf:"bytes,1,opt,name=submission_key,json=submissionKey,proto3" json:"submission_key"`
+	// Address of the submitter of the checkpoint
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// Whether all blocks of the submission are on the btc main chain
+	OnMainChain bool `protobuf:"varint,3,opt,name=on_main_chain,json=onMainChain,proto3" json:"on_main_chain,omitempty"`
+	// Depth of the shallowest block of the submission on the btc main chain.
+	// It is only meaningful if the submission is on the main chain.
+	Depth uint64 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *SubmissionInfo) Reset()         { *m = SubmissionInfo{} }
+func (m *SubmissionInfo) String() string { return proto.CompactTextString(m) }
+func (*SubmissionInfo) ProtoMessage()    {}
+func (*SubmissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{6}
+}
+func (m *SubmissionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionInfo.Merge(m, src)
+}
+func (m *SubmissionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionInfo proto.InternalMessageInfo
+
+func (m *SubmissionInfo) GetSubmissionKey() SubmissionKey {
+	if m != nil {
+		return m.SubmissionKey
+	}
+	return SubmissionKey{}
+}
+
+func (m *SubmissionInfo) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *SubmissionInfo) GetOnMainChain() bool {
+	if m != nil {
+		return m.OnMainChain
+	}
+	return false
+}
+
+func (m *SubmissionInfo) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type QueryEpochInclusionProofRequest struct {
+	// Number of epoch for which the proof is requested
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryEpochInclusionProofRequest) Reset()         { *m = QueryEpochInclusionProofRequest{} }
+func (m *QueryEpochInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInclusionProofRequest) ProtoMessage()    {}
+func (*QueryEpochInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{7}
+}
+func (m *QueryEpochInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryEpochInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryEpochInclusionProofRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryEpochInclusionProofResponse is response type for the Query/EpochInclusionProof RPC method
+type QueryEpochInclusionProofResponse struct {
+	// Proof built from the winning submission of the epoch, i.e. the one on the btc
+	// main chain whose last transaction is included in the lowest btc block
+	Proof *EpochInclusionProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryEpochInclusionProofResponse) Reset()         { *m = QueryEpochInclusionProofResponse{} }
+func (m *QueryEpochInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInclusionProofResponse) ProtoMessage()    {}
+func (*QueryEpochInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{8}
+}
+func (m *QueryEpochInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryEpochInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInclusionProofResponse) GetProof() *EpochInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryRewardsRequest is request type for the Query/Rewards RPC method
+type QueryRewardsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
+func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRequest) ProtoMessage()    {}
+func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{9}
+}
+func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+}
+func (m *QueryRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsResponse is response type for the Query/Rewards RPC method
+type QueryRewardsResponse struct {
+	// Rewards paid to the reporters, sorted by epoch number
+	Rewards []ReporterReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
+func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsResponse) ProtoMessage()    {}
+func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_009c1165ec392ace, []int{10}
+}
+func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+}
+func (m *QueryRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsResponse) GetRewards() []ReporterReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btccheckpoint.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btccheckpoint.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryBtcCheckpointHeightRequest)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointHeightRequest")
 	proto.RegisterType((*QueryBtcCheckpointHeightResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointHeightResponse")
+	proto.RegisterType((*QueryEpochSubmissionsRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsRequest")
+	proto.RegisterType((*QueryEpochSubmissionsResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsResponse")
+	proto.RegisterType((*SubmissionInfo)(nil), "babylon.btccheckpoint.v1.SubmissionInfo")
+	proto.RegisterType((*QueryEpochInclusionProofRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochInclusionProofRequest")
+	proto.RegisterType((*QueryEpochInclusionProofResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochInclusionProofResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "babylon.btccheckpoint.v1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "babylon.btccheckpoint.v1.QueryRewardsResponse")
 }
 
 func init() { proto.RegisterFile("babylon/btccheckpoint/query.proto", fileDescriptor_009c1165ec392ace) }
 
 var fileDescriptor_009c1165ec392ace = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0xa5, 0x2e, 0x3a, 0xde, 0xa6, 0x45, 0x6a, 0x94, 0x34, 0x06, 0xc4, 0x22, 0x36,
-	0x43, 0x2a, 0x1e, 0xea, 0xa1, 0x87, 0x78, 0xf1, 0x54, 0x75, 0xc1, 0x8b, 0x20, 0xcb, 0xcc, 0x30,
-	0x24, 0x43, 0x93, 0x79, 0xd3, 0xcc, 0x64, 0x71, 0x11, 0x2f, 0x7e, 0x01, 0x05, 0x3f, 0x8e, 0x5f,
-	0xa0, 0xc7, 0x82, 0x07, 0x3d, 0x89, 0xec, 0xfa, 0x41, 0x24, 0x93, 0xe9, 0x42, 0x75, 0x83, 0xe2,
-	0x2d, 0xbc, 0xf7, 0xff, 0xff, 0xde, 0xff, 0xcd, 0x0b, 0xba, 0xc3, 0x28, 0x9b, 0x57, 0xa0, 0x08,
-	0xb3, 0x9c, 0x97, 0x82, 0x9f, 0x68, 0x90, 0xca, 0x92, 0xd3, 0x56, 0x34, 0xf3, 0x54, 0x37, 0x60,
-	0x01, 0xef, 0x78, 0x49, 0x7a, 0x49, 0x92, 0xce, 0xb2, 0x70, 0xbb, 0x80, 0x02, 0x9c, 0x88, 0x74,
-	0x5f, 0xbd, 0x3e, 0xbc, 0x5d, 0x00, 0x14, 0x95, 0x20, 0x54, 0x4b, 0x42, 0x95, 0x02, 0x4b, 0xad,
-	0x04, 0x65, 0x7c, 0xf7, 0x3e, 0x07, 0x53, 0x83, 0x21, 0x8c, 0x1a, 0xd1, 0x8f, 0x21, 0xb3, 0x8c,
-	0x09, 0x4b, 0x33, 0xa2, 0x69, 0x21, 0x95, 0x13, 0x7b, 0x6d, 0xb2, 0x3e, 0x9c, 0xa6, 0x0d, 0xad,
-	0x3d, 0x2f, 0xd9, 0x46, 0xf8, 0x45, 0x47, 0x79, 0xee, 0x8a, 0x13, 0x71, 0xda, 0x0a, 0x63, 0x93,
-	0x97, 0x68, 0xeb, 0x52, 0xd5, 0x68, 0x50, 0x46, 0xe0, 0x23, 0x34, 0xee, 0xcd, 0x3b, 0x41, 0x1c,
-	0xec, 0x5d, 0x3f, 0x88, 0xd3, 0xa1, 0xdd, 0xd2, 0xde, 0x99, 0x6f, 0x9e, 0x7d, 0xdf, 0x1d, 0x4d,
-	0xbc, 0x2b, 0x39, 0x42, 0xbb, 0x0e, 0x9b, 0x5b, 0xfe, 0x64, 0xa5, 0x7e, 0x2a, 0x64, 0x51, 0x5a,
-	0x3f, 0x19, 0xdf, 0x42, 0xd7, 0x84, 0x06, 0x5e, 0x4e, 0x55, 0x5b, 0xbb, 0x29, 0x9b, 0x93, 0xab,
-	0xae, 0x70, 0xdc, 0xd6, 0xc9, 0x6b, 0x14, 0x0f, 0xfb, 0x7d, 0xc6, 0x43, 0x74, 0x53, 0xd0, 0xa6,
-	0x92, 0xc2, 0xd8, 0x29, 0xb3, 0x7c, 0xca, 0x2a, 0xe0, 0x27, 0x1d, 0x8d, 0x89, 0xc6, 0x03, 0x6f,
-	0x5c, 0x08, 0x72, 0xcb, 0xf3, 0xae, 0x7d, 0xec, 0xba, 0x07, 0x5f, 0x37, 0xd0, 0x15, 0xc7, 0xc7,
-	0x1f, 0x02, 0x34, 0xee, 0x37, 0xc0, 0x0f, 0x86, 0x77, 0xfc, 0xf3, 0xe1, 0xc2, 0xfd, 0x7f, 0x54,
-	0xf7, 0x61, 0x93, 0xbd, 0xf7, 0x5f, 0x7e, 0x7e, 0xda, 0x48, 0x70, 0x4c, 0xd6, 0x9f, 0x6a, 0x96,
-	0xf9, 0x6b, 0xe1, 0xcf, 0x01, 0xda, 0x5a, 0xb3, 0x36, 0x3e, 0xfc, 0xcb, 0xc0, 0xe1, 0xa7, 0x0e,
-	0x1f, 0xff, 0x8f, 0xd5, 0x07, 0xdf, 0x77, 0xc1, 0xef, 0xe1, 0xbb, 0xc3, 0xc1, 0xdf, 0xae, 0xee,
-	0xf8, 0x2e, 0x7f, 0x76, 0xb6, 0x88, 0x82, 0xf3, 0x45, 0x14, 0xfc, 0x58, 0x44, 0xc1, 0xc7, 0x65,
-	0x34, 0x3a, 0x5f, 0x46, 0xa3, 0x6f, 0xcb, 0x68, 0xf4, 0xea, 0x51, 0x21, 0x6d, 0xd9, 0xb2, 0x94,
-	0x43, 0x7d, 0x81, 0xe2, 0x25, 0x95, 0x6a, 0xc5, 0x7d, 0xf3, 0x1b, 0xd9, 0xce, 0xb5, 0x30, 0x6c,
-	0xec, 0xfe, 0xde, 0x87, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x5a, 0xb8, 0x44, 0x80, 0x03,
-	0x00, 0x00,
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
+	0x18, 0xef, 0x14, 0x28, 0x30, 0x7d, 0x21, 0x6f, 0x86, 0xe6, 0x4d, 0xdf, 0x8a, 0xa5, 0xac, 0x41,
+	0x8a, 0x91, 0xdd, 0xb4, 0x04, 0x09, 0x1a, 0x39, 0x94, 0xa8, 0xa0, 0x11, 0x71, 0xd5, 0x8b, 0x09,
+	0x69, 0x66, 0x97, 0x61, 0xbb, 0xa1, 0xdd, 0x59, 0x76, 0x67, 0xd1, 0xc6, 0x78, 0xf1, 0x0b, 0x68,
+	0xc2, 0xa7, 0xf0, 0x03, 0x78, 0x32, 0xe1, 0x4c, 0xe2, 0x85, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x8f,
+	0xe1, 0xc1, 0xec, 0xcc, 0xf4, 0x1f, 0x76, 0xdd, 0xea, 0x8d, 0x3e, 0xf3, 0xfc, 0x7e, 0xcf, 0x6f,
+	0x9f, 0x3f, 0xbf, 0x00, 0x67, 0x0d, 0x6c, 0x34, 0xeb, 0xd4, 0xd1, 0x0c, 0x66, 0x9a, 0x35, 0x62,
+	0xee, 0xbb, 0xd4, 0x76, 0x98, 0x76, 0x10, 0x10, 0xaf, 0xa9, 0xba, 0x1e, 0x65, 0x14, 0x65, 0x65,
+	0x8a, 0xda, 0x93, 0xa2, 0x1e, 0x96, 0x72, 0x19, 0x8b, 0x5a, 0x94, 0x27, 0x69, 0xe1, 0x5f, 0x22,
+	0x3f, 0x37, 0x6d, 0x51, 0x6a, 0xd5, 0x89, 0x86, 0x5d, 0x5b, 0xc3, 0x8e, 0x43, 0x19, 0x66, 0x36,
+	0x75, 0x7c, 0xf9, 0x7a, 0xcd, 0xa4, 0x7e, 0x83, 0xfa, 0x9a, 0x81, 0x7d, 0x22, 0xca, 0x68, 0x87,
+	0x25, 0x83, 0x30, 0x5c, 0xd2, 0x5c, 0x6c, 0xd9, 0x0e, 0x4f, 0x96, 0xb9, 0x4a, 0x7f, 0x71, 0x2e,
+	0xf6, 0x70, 0xa3, 0xc5, 0xb7, 0xd0, 0x3f, 0xa7, 0x57, 0x2b, 0x4f, 0x55, 0x32, 0x10, 0x3d, 0x0e,
+	0x0b, 0x6e, 0x73, 0xbc, 0x4e, 0x0e, 0x02, 0xe2, 0x33, 0xe5, 0x19, 0x9c, 0xea, 0x89, 0xfa, 0x2e,
+	0x75, 0x7c, 0x82, 0xd6, 0x60, 0x4a, 0xd4, 0xc9, 0x82, 0x02, 0x28, 0xa6, 0xcb, 0x05, 0x35, 0xaa,
+	0x0d, 0xaa, 0x40, 0x56, 0x86, 0x4f, 0xbe, 0xce, 0x24, 0x74, 0x89, 0x52, 0xd6, 0xe0, 0x0c, 0xa7,
+	0xad, 0x30, 0x73, 0xbd, 0x9d, 0xbd, 0x41, 0x6c, 0xab, 0xc6, 0x64, 0x65, 0x74, 0x09, 0x8e, 0x13,
+	0x97, 0x9a, 0xb5, 0xaa, 0x13, 0x34, 0x78, 0x95, 0x61, 0x7d, 0x8c, 0x07, 0xb6, 0x82, 0x86, 0xb2,
+	0x03, 0x0b, 0xd1, 0x78, 0xa9, 0x71, 0x15, 0xfe, 0x4f, 0xb0, 0x57, 0xb7, 0x89, 0xcf, 0xaa, 0x06,
+	0x33, 0xab, 0x46, 0x9d, 0x9a, 0xfb, 0x21, 0x9b, 0x41, 0x3c, 0x49, 0xf8, 0x5f, 0x2b, 0xa1, 0xc2,
+	0xcc, 0x4a, 0xf8, 0xbc, 0xc5, 0x5f, 0x95, 0x5b, 0x70, 0x9a, 0xd3, 0xdf, 0x09, 0xeb, 0x3d, 0x09,
+	0x8c, 0x86, 0xed, 0xfb, 0xe1, 0x94, 0x06, 0xd2, 0xf6, 0x01, 0xc0, 0xcb, 0x11, 0x68, 0xa9, 0x6c,
+	0x03, 0xfe, 0x23, 0xe0, 0x3e, 0xc3, 0x2c, 0x10, 0x3d, 0x9c, 0x2c, 0xcf, 0x45, 0xf7, 0x50, 0x30,
+	0xf1, 0x64, 0x3d, 0x4d, 0x3a, 0x3f, 0xd0, 0x7d, 0x98, 0xf6, 0x3b, 0x05, 0xb2, 0xc9, 0xc2, 0x50,
+	0x31, 0x5d, 0x2e, 0x46, 0x13, 0x75, 0xd4, 0x6c, 0x3a, 0x7b, 0x54, 0xef, 0x06, 0x2b, 0xc7, 0x00,
+	0x4e, 0xf6, 0xbe, 0xa3, 0xa7, 0x70, 0xb2, 0x93, 0x51, 0xdd, 0x27, 0x4d, 0x39, 0xee, 0xf9, 0x41,
+	0x2a, 0x3c, 0x20, 0x4d, 0x39, 0xf5, 0x09, 0xbf, 0x3b, 0x88, 0xa6, 0xe1, 0x38, 0x0f, 0x30, 0x46,
+	0xbc, 0x6c, 0xb2, 0x00, 0x8a, 0xe3, 0x7a, 0x27, 0x80, 0x14, 0x38, 0x41, 0x9d, 0x6a, 0x03, 0xdb,
+	0x4e, 0xd5, 0xac, 0x61, 0xdb, 0xc9, 0x0e, 0x15, 0x40, 0x71, 0x4c, 0x4f, 0x53, 0xe7, 0x21, 0xb6,
+	0x9d, 0xf5, 0x30, 0x84, 0x32, 0x70, 0x64, 0x97, 0xb8, 0xac, 0x96, 0x1d, 0xe6, 0xbd, 0x17, 0x3f,
+	0xda, 0x4b, 0xc5, 0xbb, 0xb5, 0xe9, 0x98, 0xf5, 0x20, 0xac, 0xb8, 0xed, 0x51, 0xba, 0x37, 0xd0,
+	0xe0, 0x2c, 0xb9, 0x54, 0x7d, 0xf1, 0x72, 0x74, 0xeb, 0x70, 0xc4, 0x0d, 0x03, 0xb2, 0x11, 0x8b,
+	0x31, 0x33, 0xbb, 0xc0, 0x22, 0xb0, 0xca, 0x8e, 0x3c, 0x2a, 0x9d, 0xbc, 0xc0, 0xde, 0x6e, 0x7b,
+	0xab, 0xee, 0x42, 0xd8, 0x39, 0x72, 0x59, 0xe0, 0xaa, 0x2a, 0x1c, 0x41, 0x0d, 0x1d, 0x41, 0x15,
+	0xc6, 0x23, 0x1d, 0x41, 0xdd, 0xc6, 0x16, 0x91, 0x58, 0xbd, 0x0b, 0xa9, 0xbc, 0x07, 0x30, 0xd3,
+	0xcb, 0xdf, 0xde, 0xbb, 0x51, 0x4f, 0x84, 0xb2, 0x20, 0x6e, 0x53, 0x74, 0xe2, 0x52, 0x8f, 0x11,
+	0x4f, 0x70, 0xc8, 0x41, 0xb6, 0xe0, 0xe8, 0x5e, 0x8f, 0xd4, 0xa4, 0x5c, 0x8a, 0x38, 0xa9, 0x42,
+	0x46, 0xb7, 0xd6, 0xf2, 0x8f, 0x14, 0x1c, 0xe1, 0x5a, 0xd1, 0x5b, 0x00, 0x53, 0xc2, 0x2b, 0xd0,
+	0xf5, 0x68, 0x59, 0xbf, 0x5a, 0x54, 0x6e, 0x71, 0xc0, 0x6c, 0x51, 0x5d, 0x29, 0xbe, 0xf9, 0xfc,
+	0xfd, 0x28, 0xa9, 0xa0, 0x82, 0xd6, 0xdf, 0x1b, 0x0f, 0x4b, 0xd2, 0x42, 0xd1, 0x47, 0x00, 0xa7,
+	0xfa, 0x18, 0x0c, 0x5a, 0x8d, 0x29, 0x18, 0x6d, 0x6a, 0xb9, 0x9b, 0x7f, 0x03, 0x95, 0xc2, 0x17,
+	0xb9, 0xf0, 0x79, 0x34, 0x17, 0x2d, 0xfc, 0x55, 0x7b, 0xb9, 0x5f, 0xa3, 0x63, 0x00, 0xff, 0xbd,
+	0xe8, 0x40, 0xe8, 0x46, 0x4c, 0xfd, 0x08, 0xc3, 0xcb, 0xad, 0xfc, 0x31, 0x4e, 0x8a, 0x5e, 0xe5,
+	0xa2, 0x97, 0x50, 0x69, 0x20, 0xd1, 0x5a, 0x97, 0x1f, 0xa1, 0x4f, 0x00, 0x4e, 0xf5, 0x39, 0xa2,
+	0xd8, 0xf6, 0x47, 0x9f, 0x7f, 0x6c, 0xfb, 0x7f, 0x73, 0xf9, 0xca, 0x6d, 0xfe, 0x25, 0x2b, 0x68,
+	0x79, 0xb0, 0x2f, 0xb1, 0x5b, 0x2c, 0x55, 0x7e, 0xf3, 0xe8, 0x08, 0xc0, 0x51, 0x79, 0x8f, 0x28,
+	0x6e, 0x63, 0x7b, 0x7d, 0x21, 0xa7, 0x0e, 0x9a, 0x2e, 0x95, 0x2e, 0x70, 0xa5, 0x57, 0xd0, 0x6c,
+	0xb4, 0x52, 0x79, 0xc7, 0x95, 0x47, 0x27, 0x67, 0x79, 0x70, 0x7a, 0x96, 0x07, 0xdf, 0xce, 0xf2,
+	0xe0, 0xdd, 0x79, 0x3e, 0x71, 0x7a, 0x9e, 0x4f, 0x7c, 0x39, 0xcf, 0x27, 0x9e, 0x2f, 0x5b, 0x36,
+	0xab, 0x05, 0x86, 0x6a, 0xd2, 0x46, 0x8b, 0x86, 0xdb, 0x71, 0x9b, 0xf3, 0xe5, 0x05, 0x56, 0xd6,
+	0x74, 0x89, 0x6f, 0xa4, 0xf8, 0x3f, 0x13, 0x4b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xf3,
+	0xdf, 0xe4, 0x3a, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -261,6 +659,13 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BtcCheckpointHeight returns earliest block height for given rawcheckpoint
 	BtcCheckpointHeight(ctx context.Context, in *QueryBtcCheckpointHeightRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointHeightResponse, error)
+	// EpochSubmissions returns the status of an epoch along with all its submissions
+	EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error)
+	// EpochInclusionProof returns a self-contained proof that the checkpoint of an
+	// epoch is included in the btc main chain
+	EpochInclusionProof(ctx context.Context, in *QueryEpochInclusionProofRequest, opts ...grpc.CallOption) (*QueryEpochInclusionProofResponse, error)
+	// Rewards returns the history of the rewards paid to the reporters of finalized epochs
+	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -289,12 +694,46 @@ func (c *queryClient) BtcCheckpointHeight(ctx context.Context, in *QueryBtcCheck
 	return out, nil
 }
 
+func (c *queryClient) EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error) {
+	out := new(QueryEpochSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/EpochSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInclusionProof(ctx context.Context, in *QueryEpochInclusionProofRequest, opts ...grpc.CallOption) (*QueryEpochInclusionProofResponse, error) {
+	out := new(QueryEpochInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/EpochInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BtcCheckpointHeight returns earliest block height for given rawcheckpoint
 	BtcCheckpointHeight(context.Context, *QueryBtcCheckpointHeightRequest) (*QueryBtcCheckpointHeightResponse, error)
+	// EpochSubmissions returns the status of an epoch along with all its submissions
+	EpochSubmissions(context.Context, *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error)
+	// EpochInclusionProof returns a self-contained proof that the checkpoint of an
+	// epoch is included in the btc main chain
+	EpochInclusionProof(context.Context, *QueryEpochInclusionProofRequest) (*QueryEpochInclusionProofResponse, error)
+	// Rewards returns the history of the rewards paid to the reporters of finalized epochs
+	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -307,6 +746,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BtcCheckpointHeight(ctx context.Context, req *QueryBtcCheckpointHeightRequest) (*QueryBtcCheckpointHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BtcCheckpointHeight not implemented")
 }
+func (*UnimplementedQueryServer) EpochSubmissions(ctx context.Context, req *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubmissions not implemented")
+}
+func (*UnimplementedQueryServer) EpochInclusionProof(ctx context.Context, req *QueryEpochInclusionProofRequest) (*QueryEpochInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -348,35 +796,101 @@ func _Query_BtcCheckpointHeight_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btccheckpoint.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BtcCheckpointHeight",
-			Handler:    _Query_BtcCheckpointHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btccheckpoint/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EpochSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubmissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/EpochSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubmissions(ctx, req.(*QueryEpochSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/EpochInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInclusionProof(ctx, req.(*QueryEpochInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btccheckpoint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BtcCheckpointHeight",
+			Handler:    _Query_BtcCheckpointHeight_Handler,
+		},
+		{
+			MethodName: "EpochSubmissions",
+			Handler:    _Query_EpochSubmissions_Handler,
+		},
+		{
+			MethodName: "EpochInclusionProof",
+			Handler:    _Query_EpochInclusionProof_Handler,
+		},
+		{
+			MethodName: "Rewards",
+			Handler:    _Query_Rewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btccheckpoint/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -477,68 +991,1045 @@ func (m *QueryBtcCheckpointHeightResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochSubmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryEpochSubmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSubmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubmissionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OnMainChain {
+		i--
+		if m.OnMainChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBtcCheckpointHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBtcCheckpointHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBtcCheckpointHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EarliestBtcBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EarliestBtcBlockNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochSubmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEpochSubmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStatus != 0 {
+		n += 1 + sovQuery(uint64(m.EpochStatus))
+	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubmissionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubmissionKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnMainChain {
+		n += 2
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryEpochInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEpochInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBtcBlockNumber", wireType)
+			}
+			m.EarliestBtcBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestBtcBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStatus", wireType)
+			}
+			m.EpochStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStatus |= EpochStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &SubmissionInfo{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.EarliestBtcBlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EarliestBtcBlockNumber))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *SubmissionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnMainChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnMainChain = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInclusionProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -561,12 +2052,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInclusionProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -588,7 +2098,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -611,15 +2121,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -646,7 +2156,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proof == nil {
+				m.Proof = &EpochInclusionProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -671,7 +2184,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,17 +2207,17 @@ func (m *QueryBtcCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.EpochNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -714,11 +2227,28 @@ func (m *QueryBtcCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -740,7 +2270,7 @@ func (m *QueryBtcCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -763,17 +2293,17 @@ func (m *QueryBtcCheckpointHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBtcBlockNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.EarliestBtcBlockNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -783,11 +2313,62 @@ func (m *QueryBtcCheckpointHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EarliestBtcBlockNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, ReporterReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -28,12 +28,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Consider we have a Merkle tree with following structure:
-//            ROOT
-//           /    \
-//      H1234      H5555
-//     /     \       \
-//   H12     H34      H55
-//  /  \    /  \     /
+//
+//	          ROOT
+//	         /    \
+//	    H1234      H5555
+//	   /     \       \
+//	 H12     H34      H55
+//	/  \    /  \     /
+//
 // H1  H2  H3  H4  H5
 // L1  L2  L3  L4  L5
 // To prove L3 was part of ROOT we need:
@@ -57,6 +59,12 @@ type BTCSpvProof struct {
 	// Valid btc header which confirms btc_transaction.
 	// Should have exactly 80 bytes
 	ConfirmingBtcHeader []byte `protobuf:"bytes,4,opt,name=confirming_btc_header,json=confirmingBtcHeader,proto3" json:"confirming_btc_header,omitempty"`
+	// Proof of the witness commitment of the block. Required for transactions
+	// carrying the checkpoint in their witness data, optional for transactions
+	// carrying it in their OP_RETURN output. If provided,
+	// btc_transaction_index and merkle_nodes prove the inclusion of the wtxid of
+	// btc_transaction in the witness merkle tree instead of the transaction merkle tree.
+	WitnessCommitmentProof *WitnessCommitmentProof `protobuf:"bytes,5,opt,name=witness_commitment_proof,json=witnessCommitmentProof,proto3" json:"witness_commitment_proof,omitempty"`
 }
 
 func (m *BTCSpvProof) Reset()         { *m = BTCSpvProof{} }
@@ -120,6 +128,70 @@ func (m *BTCSpvProof) GetConfirmingBtcHeader() []byte {
 	return nil
 }
 
+func (m *BTCSpvProof) GetWitnessCommitmentProof() *WitnessCommitmentProof {
+	if m != nil {
+		return m.WitnessCommitmentProof
+	}
+	return nil
+}
+
+// WitnessCommitmentProof proves that the witness merkle tree of the block is
+// committed to by its coinbase transaction, as defined in BIP141
+type WitnessCommitmentProof struct {
+	// Coinbase transaction of the block, including its witness nonce
+	CoinbaseTransaction []byte `protobuf:"bytes,1,opt,name=coinbase_transaction,json=coinbaseTransaction,proto3" json:"coinbase_transaction,omitempty"`
+	// List of concatenated intermediate nodes of the transaction merkle tree, proving
+	// the inclusion of the coinbase transaction at index 0
+	CoinbaseMerkleNodes []byte `protobuf:"bytes,2,opt,name=coinbase_merkle_nodes,json=coinbaseMerkleNodes,proto3" json:"coinbase_merkle_nodes,omitempty"`
+}
+
+func (m *WitnessCommitmentProof) Reset()         { *m = WitnessCommitmentProof{} }
+func (m *WitnessCommitmentProof) String() string { return proto.CompactTextString(m) }
+func (*WitnessCommitmentProof) ProtoMessage()    {}
+func (*WitnessCommitmentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeec89810b39ea83, []int{1}
+}
+func (m *WitnessCommitmentProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WitnessCommitmentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WitnessCommitmentProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WitnessCommitmentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessCommitmentProof.Merge(m, src)
+}
+func (m *WitnessCommitmentProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *WitnessCommitmentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessCommitmentProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessCommitmentProof proto.InternalMessageInfo
+
+func (m *WitnessCommitmentProof) GetCoinbaseTransaction() []byte {
+	if m != nil {
+		return m.CoinbaseTransaction
+	}
+	return nil
+}
+
+func (m *WitnessCommitmentProof) GetCoinbaseMerkleNodes() []byte {
+	if m != nil {
+		return m.CoinbaseMerkleNodes
+	}
+	return nil
+}
+
 type MsgInsertBTCSpvProof struct {
 	Submitter string         `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
 	Proofs    []*BTCSpvProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
@@ -129,7 +201,7 @@ func (m *MsgInsertBTCSpvProof) Reset()         { *m = MsgInsertBTCSpvProof{} }
 func (m *MsgInsertBTCSpvProof) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBTCSpvProof) ProtoMessage()    {}
 func (*MsgInsertBTCSpvProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aeec89810b39ea83, []int{1}
+	return fileDescriptor_aeec89810b39ea83, []int{2}
 }
 func (m *MsgInsertBTCSpvProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +251,7 @@ func (m *MsgInsertBTCSpvProofResponse) Reset()         { *m = MsgInsertBTCSpvPro
 func (m *MsgInsertBTCSpvProofResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInsertBTCSpvProofResponse) ProtoMessage()    {}
 func (*MsgInsertBTCSpvProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aeec89810b39ea83, []int{2}
+	return fileDescriptor_aeec89810b39ea83, []int{3}
 }
 func (m *MsgInsertBTCSpvProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,6 +282,7 @@ var xxx_messageInfo_MsgInsertBTCSpvProofResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*BTCSpvProof)(nil), "babylon.btccheckpoint.v1.BTCSpvProof")
+	proto.RegisterType((*WitnessCommitmentProof)(nil), "babylon.btccheckpoint.v1.WitnessCommitmentProof")
 	proto.RegisterType((*MsgInsertBTCSpvProof)(nil), "babylon.btccheckpoint.v1.MsgInsertBTCSpvProof")
 	proto.RegisterType((*MsgInsertBTCSpvProofResponse)(nil), "babylon.btccheckpoint.v1.MsgInsertBTCSpvProofResponse")
 }
@@ -217,30 +290,35 @@ func init() {
 func init() { proto.RegisterFile("babylon/btccheckpoint/tx.proto", fileDescriptor_aeec89810b39ea83) }
 
 var fileDescriptor_aeec89810b39ea83 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x4e, 0x2a, 0x41,
-	0x18, 0xc5, 0x19, 0xb8, 0x21, 0x61, 0xe0, 0xde, 0x1b, 0x17, 0x4d, 0x36, 0x86, 0x4c, 0x90, 0xc4,
-	0x48, 0x35, 0x1b, 0x31, 0xda, 0xd9, 0x60, 0x23, 0x05, 0x6a, 0x56, 0x2a, 0x9b, 0xcd, 0xce, 0x30,
-	0xec, 0x4e, 0x60, 0x67, 0x36, 0x33, 0x03, 0x81, 0xd8, 0xf9, 0x04, 0xbe, 0x92, 0x9d, 0x25, 0xa5,
-	0xa5, 0x81, 0x17, 0x31, 0xbb, 0x42, 0xf8, 0x23, 0x14, 0x96, 0x7b, 0xce, 0xd9, 0xdf, 0xf9, 0xbe,
-	0xc9, 0x07, 0x11, 0xf1, 0xc9, 0x64, 0x20, 0x85, 0x43, 0x0c, 0xa5, 0x21, 0xa3, 0xfd, 0x58, 0x72,
-	0x61, 0x1c, 0x33, 0xc6, 0xb1, 0x92, 0x46, 0x5a, 0xf6, 0xc2, 0xc7, 0x1b, 0x3e, 0x1e, 0x9d, 0xd7,
-	0xde, 0x00, 0x2c, 0x36, 0x3b, 0x37, 0x8f, 0xf1, 0xe8, 0x41, 0x49, 0xd9, 0xb3, 0xce, 0xe0, 0x7f,
-	0x62, 0xa8, 0x67, 0x94, 0x2f, 0xb4, 0x4f, 0x0d, 0x97, 0xc2, 0x06, 0x55, 0x50, 0x2f, 0xb9, 0xff,
-	0x88, 0xa1, 0x9d, 0x95, 0x6a, 0x35, 0xe0, 0xd1, 0x56, 0xd0, 0xe3, 0xa2, 0xcb, 0xc6, 0x76, 0xb6,
-	0x0a, 0xea, 0x7f, 0xdd, 0xf2, 0x66, 0xbc, 0x95, 0x58, 0xd6, 0x09, 0x2c, 0x45, 0x4c, 0xf5, 0x07,
-	0xcc, 0x13, 0xb2, 0xcb, 0xb4, 0x9d, 0x4b, 0xc9, 0xc5, 0x6f, 0xed, 0x2e, 0x91, 0x12, 0x2c, 0x95,
-	0xa2, 0xc7, 0x55, 0xc4, 0x45, 0xe0, 0x25, 0x0d, 0x21, 0xf3, 0xbb, 0x4c, 0xd9, 0x7f, 0xd2, 0x6c,
-	0x79, 0x65, 0x36, 0x0d, 0xbd, 0x4d, 0xad, 0x9a, 0x86, 0x87, 0x6d, 0x1d, 0xb4, 0x84, 0x66, 0xca,
-	0xac, 0xef, 0x52, 0x81, 0x05, 0x3d, 0x24, 0x11, 0x37, 0x86, 0xa9, 0x74, 0x8b, 0x82, 0xbb, 0x12,
-	0xac, 0x6b, 0x98, 0x8f, 0x93, 0x98, 0xb6, 0xb3, 0xd5, 0x5c, 0xbd, 0xd8, 0x38, 0xc5, 0xfb, 0x1e,
-	0x09, 0xaf, 0x41, 0xdd, 0xc5, 0x4f, 0x35, 0x04, 0x2b, 0xbb, 0x4a, 0x5d, 0xa6, 0x63, 0x29, 0x34,
-	0x6b, 0xbc, 0x00, 0x98, 0x6b, 0xeb, 0xc0, 0x7a, 0x86, 0x07, 0x3f, 0x27, 0xc3, 0xfb, 0xbb, 0x76,
-	0x41, 0x8f, 0xaf, 0x7e, 0x97, 0x5f, 0x0e, 0xd1, 0xbc, 0x7f, 0x9f, 0x21, 0x30, 0x9d, 0x21, 0xf0,
-	0x39, 0x43, 0xe0, 0x75, 0x8e, 0x32, 0xd3, 0x39, 0xca, 0x7c, 0xcc, 0x51, 0xe6, 0xe9, 0x32, 0xe0,
-	0x26, 0x1c, 0x12, 0x4c, 0x65, 0xe4, 0x2c, 0xd8, 0x34, 0xf4, 0xb9, 0x58, 0x7e, 0x38, 0xe3, 0xed,
-	0x5b, 0x9a, 0xc4, 0x4c, 0x93, 0x7c, 0x7a, 0x4f, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x33,
-	0x5d, 0x52, 0xc8, 0x71, 0x02, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0x16, 0x26, 0xcd, 0x1d, 0x20, 0xdc, 0x31, 0x45, 0x68, 0xb2, 0x4a, 0x24, 0x44,
+	0x4e, 0x09, 0x0b, 0x82, 0x1b, 0x97, 0xee, 0xc2, 0x0e, 0x05, 0x14, 0x26, 0x21, 0x71, 0x89, 0x62,
+	0xd7, 0x4b, 0xcc, 0x16, 0x3b, 0xb2, 0xff, 0xdb, 0x3a, 0x71, 0x40, 0xe2, 0x09, 0x78, 0x11, 0xde,
+	0x83, 0xe3, 0x8e, 0x1c, 0x51, 0xfb, 0x22, 0x28, 0x59, 0xbb, 0x34, 0x25, 0x3d, 0xec, 0x98, 0xef,
+	0xfb, 0x7f, 0xdf, 0xdf, 0xf9, 0x59, 0xc6, 0x94, 0x25, 0xec, 0xea, 0x4c, 0xab, 0x80, 0x01, 0xe7,
+	0x99, 0xe0, 0xa7, 0x85, 0x96, 0x0a, 0x02, 0x98, 0xfa, 0x85, 0xd1, 0xa0, 0x89, 0xb3, 0xf0, 0xfd,
+	0x86, 0xef, 0x5f, 0x1c, 0xb8, 0xbf, 0xba, 0xb8, 0x3f, 0x3a, 0x3e, 0xfc, 0x54, 0x5c, 0x7c, 0x34,
+	0x5a, 0x9f, 0x90, 0x17, 0xf8, 0x11, 0x03, 0x1e, 0x83, 0x49, 0x94, 0x4d, 0x38, 0x48, 0xad, 0x1c,
+	0x34, 0x44, 0xde, 0x4e, 0xf4, 0x90, 0x01, 0x3f, 0xae, 0x55, 0x12, 0xe2, 0x27, 0x6b, 0x83, 0xb1,
+	0x54, 0x13, 0x31, 0x75, 0xba, 0x43, 0xe4, 0x3d, 0x88, 0x06, 0xcd, 0xf1, 0xa3, 0xd2, 0x22, 0xcf,
+	0xf0, 0x4e, 0x2e, 0xcc, 0xe9, 0x99, 0x88, 0x95, 0x9e, 0x08, 0xeb, 0xf4, 0xaa, 0xe6, 0xfe, 0x8d,
+	0xf6, 0xbe, 0x94, 0xca, 0x5a, 0xae, 0xd5, 0x89, 0x34, 0xb9, 0x54, 0x69, 0x5c, 0x6e, 0xc8, 0x44,
+	0x32, 0x11, 0xc6, 0xb9, 0x57, 0xcd, 0x0e, 0x6a, 0x73, 0x04, 0xfc, 0x5d, 0x65, 0x91, 0xaf, 0xd8,
+	0xb9, 0x94, 0xa0, 0x84, 0xb5, 0x31, 0xd7, 0x79, 0x2e, 0x21, 0x17, 0x0a, 0xe2, 0xa2, 0xfc, 0x1f,
+	0xe7, 0xfe, 0x10, 0x79, 0xfd, 0xf0, 0xa5, 0xbf, 0x09, 0x80, 0xff, 0xf9, 0x26, 0x79, 0x78, 0x1b,
+	0xac, 0x38, 0x44, 0x7b, 0x97, 0xad, 0xba, 0xfb, 0x1d, 0xef, 0xb5, 0x27, 0xc8, 0x01, 0xde, 0xe5,
+	0x5a, 0x2a, 0x96, 0x58, 0xd1, 0x82, 0x6f, 0xb0, 0xf4, 0xd6, 0x18, 0xde, 0x46, 0x1a, 0x60, 0xba,
+	0xcd, 0xcc, 0xb8, 0x06, 0xe4, 0x5a, 0xbc, 0x3b, 0xb6, 0xe9, 0x91, 0xb2, 0xc2, 0xc0, 0xea, 0xc5,
+	0xed, 0xe3, 0x6d, 0x7b, 0xce, 0x72, 0x09, 0x20, 0x4c, 0xb5, 0x73, 0x3b, 0xaa, 0x05, 0xf2, 0x16,
+	0x6f, 0x55, 0x3c, 0xca, 0xea, 0x9e, 0xd7, 0x0f, 0x9f, 0x6f, 0x06, 0xb2, 0x52, 0x1a, 0x2d, 0x42,
+	0x2e, 0xc5, 0xfb, 0x6d, 0x4b, 0x23, 0x61, 0x0b, 0xad, 0xac, 0x08, 0x7f, 0x20, 0xdc, 0x1b, 0xdb,
+	0x94, 0x7c, 0xc3, 0x8f, 0xff, 0x3f, 0x99, 0xbf, 0x79, 0x57, 0x5b, 0xe9, 0xd3, 0x37, 0x77, 0x9b,
+	0x5f, 0x1e, 0x62, 0xf4, 0xe1, 0xf7, 0x8c, 0xa2, 0xeb, 0x19, 0x45, 0x7f, 0x67, 0x14, 0xfd, 0x9c,
+	0xd3, 0xce, 0xf5, 0x9c, 0x76, 0xfe, 0xcc, 0x69, 0xe7, 0xcb, 0xeb, 0x54, 0x42, 0x76, 0xce, 0x7c,
+	0xae, 0xf3, 0x60, 0xd1, 0xcd, 0xb3, 0x44, 0xaa, 0xe5, 0x47, 0x30, 0x5d, 0x7f, 0x38, 0x57, 0x85,
+	0xb0, 0x6c, 0xab, 0x7a, 0x3c, 0xaf, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x73, 0xf1, 0x7f, 0x09,
+	0x5e, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -343,6 +421,18 @@ func (m *BTCSpvProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WitnessCommitmentProof != nil {
+		{
+			size, err := m.WitnessCommitmentProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ConfirmingBtcHeader) > 0 {
 		i -= len(m.ConfirmingBtcHeader)
 		copy(dAtA[i:], m.ConfirmingBtcHeader)
@@ -372,6 +462,43 @@ func (m *BTCSpvProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WitnessCommitmentProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WitnessCommitmentProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WitnessCommitmentProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinbaseMerkleNodes) > 0 {
+		i -= len(m.CoinbaseMerkleNodes)
+		copy(dAtA[i:], m.CoinbaseMerkleNodes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinbaseMerkleNodes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CoinbaseTransaction) > 0 {
+		i -= len(m.CoinbaseTransaction)
+		copy(dAtA[i:], m.CoinbaseTransaction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinbaseTransaction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgInsertBTCSpvProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -471,6 +598,27 @@ func (m *BTCSpvProof) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.WitnessCommitmentProof != nil {
+		l = m.WitnessCommitmentProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WitnessCommitmentProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CoinbaseTransaction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoinbaseMerkleNodes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -658,6 +806,160 @@ func (m *BTCSpvProof) Unmarshal(dAtA []byte) error {
 				m.ConfirmingBtcHeader = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WitnessCommitmentProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WitnessCommitmentProof == nil {
+				m.WitnessCommitmentProof = &WitnessCommitmentProof{}
+			}
+			if err := m.WitnessCommitmentProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WitnessCommitmentProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WitnessCommitmentProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WitnessCommitmentProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinbaseTransaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinbaseTransaction = append(m.CoinbaseTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.CoinbaseTransaction == nil {
+				m.CoinbaseTransaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinbaseMerkleNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinbaseMerkleNodes = append(m.CoinbaseMerkleNodes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CoinbaseMerkleNodes == nil {
+				m.CoinbaseMerkleNodes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
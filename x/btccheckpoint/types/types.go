@@ -1,32 +1,34 @@
 package types
 
 import (
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Semantically valid checkpoint submission with:
 // - valid submitter address
-// - at least 2 parsed proof
-// Modelling proofs as separate Proof1 and Proof2, as this is more explicit than
-// []*ParsedProof.
+// - at least 1 parsed proof, one for each part of the checkpoint, in order
+// - format version in which the checkpoint was encoded
 type RawCheckpointSubmission struct {
 	Submitter      sdk.AccAddress
-	Proof1         ParsedProof
-	Proof2         ParsedProof
+	Version        txformat.FormatVersion
+	Proofs         []ParsedProof
 	checkpointData []byte
+	// proofs as submitted, stored to be served to external consumers
+	spvProofs []*BTCSpvProof
 }
 
 func NewRawCheckpointSubmission(
 	a sdk.AccAddress,
-	p1 ParsedProof,
-	p2 ParsedProof,
+	version txformat.FormatVersion,
+	proofs []ParsedProof,
 	checkpointData []byte,
 ) RawCheckpointSubmission {
 	r := RawCheckpointSubmission{
 		Submitter:      a,
-		Proof1:         p1,
-		Proof2:         p2,
+		Version:        version,
+		Proofs:         proofs,
 		checkpointData: checkpointData,
 	}
 
@@ -34,7 +36,11 @@ func NewRawCheckpointSubmission(
 }
 
 func (s *RawCheckpointSubmission) GetProofs() []*ParsedProof {
-	return []*ParsedProof{&s.Proof1, &s.Proof2}
+	var proofs []*ParsedProof
+	for i := range s.Proofs {
+		proofs = append(proofs, &s.Proofs[i])
+	}
+	return proofs
 }
 
 func (s *RawCheckpointSubmission) GetRawCheckPointBytes() []byte {
@@ -45,11 +51,17 @@ func (s *RawCheckpointSubmission) GetRawCheckPointBytes() []byte {
 }
 
 func (s *RawCheckpointSubmission) GetFirstBlockHash() types.BTCHeaderHashBytes {
-	return s.Proof1.BlockHash
+	return s.Proofs[0].BlockHash
 }
 
-func (s *RawCheckpointSubmission) GetSecondBlockHash() types.BTCHeaderHashBytes {
-	return s.Proof2.BlockHash
+// GetBlockHashes returns the hashes of the blocks including the parts of the
+// checkpoint, in order of the parts
+func (s *RawCheckpointSubmission) GetBlockHashes() []types.BTCHeaderHashBytes {
+	var hashes []types.BTCHeaderHashBytes
+	for _, p := range s.Proofs {
+		hashes = append(hashes, p.BlockHash)
+	}
+	return hashes
 }
 
 func toTransactionKey(p *ParsedProof) TransactionKey {
@@ -62,22 +74,25 @@ func toTransactionKey(p *ParsedProof) TransactionKey {
 
 func (rsc *RawCheckpointSubmission) GetSubmissionKey() SubmissionKey {
 	var keys []*TransactionKey
-	k1 := toTransactionKey(&rsc.Proof1)
-	keys = append(keys, &k1)
-	k2 := toTransactionKey(&rsc.Proof2)
-	keys = append(keys, &k2)
+	for i := range rsc.Proofs {
+		k := toTransactionKey(&rsc.Proofs[i])
+		keys = append(keys, &k)
+	}
 	return SubmissionKey{
 		Key: keys,
 	}
 }
 
 func (rsc *RawCheckpointSubmission) GetSubmissionData(epochNum uint64) SubmissionData {
-
-	tBytes := [][]byte{rsc.Proof1.TransactionBytes, rsc.Proof2.TransactionBytes}
+	var tBytes [][]byte
+	for _, p := range rsc.Proofs {
+		tBytes = append(tBytes, p.TransactionBytes)
+	}
 	return SubmissionData{
 		Submitter:      rsc.Submitter.Bytes(),
 		Btctransaction: tBytes,
 		Epoch:          epochNum,
+		Proofs:         rsc.spvProofs,
 	}
 }
 
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	// first byte of the annex of a taproot witness as defined in BIP341
+	taprootAnnexTag = 0x50
+)
+
+// merkleRootFromProof computes the root of the merkle tree from the leaf, the
+// intermediate nodes and the index of the leaf
+func merkleRootFromProof(leaf *chainhash.Hash, intermediateNodes []byte, index uint32) (*chainhash.Hash, error) {
+	if len(intermediateNodes)%32 != 0 {
+		return nil, errors.New("invalid length of merkle proof")
+	}
+
+	current := *leaf
+	idx := index
+
+	for start := 0; start < len(intermediateNodes); start += 32 {
+		next := intermediateNodes[start : start+32]
+		if idx%2 == 1 {
+			current = hashConcat(next, current[:])
+		} else {
+			current = hashConcat(current[:], next)
+		}
+		idx >>= 1
+	}
+
+	return &current, nil
+}
+
+// verifyWitnessCommitment checks that the wtxid of the transaction is part of the
+// witness merkle tree committed to by the coinbase transaction of the block
+func verifyWitnessCommitment(
+	tx *btcutil.Tx,
+	transactionIndex uint32,
+	witnessMerkleProof []byte,
+	commitmentProof *WitnessCommitmentProof,
+	header *types.BTCHeaderBytes,
+) error {
+	// wtxid of the coinbase transaction is always zero, so it can't carry a checkpoint
+	if transactionIndex == 0 {
+		return errors.New("coinbase transaction can't carry witness checkpoint data")
+	}
+
+	coinbase, err := ParseTransaction(commitmentProof.CoinbaseTransaction)
+	if err != nil {
+		return err
+	}
+
+	if !blockchain.IsCoinBase(coinbase) {
+		return errors.New("provided transaction is not a coinbase transaction")
+	}
+
+	blockHeader := header.ToBlockHeader()
+
+	if !verify(coinbase, &blockHeader.MerkleRoot, commitmentProof.CoinbaseMerkleNodes, 0) {
+		return errors.New("coinbase transaction failed validation due to failed proof")
+	}
+
+	commitment, found := blockchain.ExtractWitnessCommitment(coinbase)
+	if !found {
+		return errors.New("coinbase transaction does not contain witness commitment")
+	}
+
+	coinbaseWitness := coinbase.MsgTx().TxIn[0].Witness
+	if len(coinbaseWitness) != 1 || len(coinbaseWitness[0]) != blockchain.CoinbaseWitnessDataLen {
+		return errors.New("coinbase transaction has invalid witness nonce")
+	}
+
+	if len(witnessMerkleProof) == 0 {
+		return errors.New("witness merkle proof should not be empty")
+	}
+
+	witnessRoot, err := merkleRootFromProof(tx.WitnessHash(), witnessMerkleProof, transactionIndex)
+	if err != nil {
+		return err
+	}
+
+	var preimage []byte
+	preimage = append(preimage, witnessRoot[:]...)
+	preimage = append(preimage, coinbaseWitness[0]...)
+
+	if !bytes.Equal(chainhash.DoubleHashB(preimage), commitment) {
+		return errors.New("witness merkle root does not match witness commitment")
+	}
+
+	return nil
+}
+
+// ExtractWitnessData returns the data carried by the first witness envelope found
+// in the tapscripts of the inputs of the transaction
+func ExtractWitnessData(tx *btcutil.Tx) []byte {
+	for _, input := range tx.MsgTx().TxIn {
+		stack := input.Witness
+
+		// drop the annex if present
+		if len(stack) >= 2 && len(stack[len(stack)-1]) > 0 && stack[len(stack)-1][0] == taprootAnnexTag {
+			stack = stack[:len(stack)-1]
+		}
+
+		// script path spend has at least the script and the control block
+		if len(stack) < 2 {
+			continue
+		}
+
+		if data := txformat.ExtractWitnessEnvelope(stack[len(stack)-2]); len(data) > 0 {
+			return data
+		}
+	}
+
+	return nil
+}
+
+// ParseWitnessProof parses proof of a transaction whose inclusion is proven through
+// the witness commitment of the block, i.e. by the merkle path of its wtxid and the
+// merkle path of the coinbase transaction. The transaction can carry the checkpoint
+// data either in its OP_RETURN output or in its witness envelope.
+func ParseWitnessProof(proof *BTCSpvProof, powLimit *big.Int) (*ParsedProof, error) {
+	if proof.WitnessCommitmentProof == nil {
+		return nil, errors.New("witness proof should contain witness commitment proof")
+	}
+
+	tx, e := ParseTransaction(proof.BtcTransaction)
+
+	if e != nil {
+		return nil, e
+	}
+
+	headerBytes := types.BTCHeaderBytes(proof.ConfirmingBtcHeader)
+	header := headerBytes.ToBlockHeader()
+
+	e = types.ValidateBTCHeader(header, powLimit)
+
+	if e != nil {
+		return nil, e
+	}
+
+	e = verifyWitnessCommitment(
+		tx,
+		proof.BtcTransactionIndex,
+		proof.MerkleNodes,
+		proof.WitnessCommitmentProof,
+		&headerBytes,
+	)
+
+	if e != nil {
+		return nil, fmt.Errorf("header failed validation due to failed witness proof: %w", e)
+	}
+
+	opReturnData := ExtractOpReturnData(tx)
+	witnessData := ExtractWitnessData(tx)
+
+	if len(opReturnData) == 0 && len(witnessData) == 0 {
+		return nil, fmt.Errorf("provided transaction should provide op return or witness envelope data")
+	}
+
+	bh := header.BlockHash()
+	parsedProof := &ParsedProof{
+		BlockHash:        types.NewBTCHeaderHashBytesFromChainhash(&bh),
+		Transaction:      tx,
+		TransactionBytes: proof.BtcTransaction,
+		TransactionIdx:   proof.BtcTransactionIndex,
+		OpReturnData:     opReturnData,
+		WitnessData:      witnessData,
+	}
+
+	return parsedProof, nil
+}
+
+// WitnessSpvProofFromHeaderAndTransactions creates the proof of the transaction with
+// the given index through the witness commitment of the block. The first transaction
+// must be the coinbase transaction containing the witness commitment.
+func WitnessSpvProofFromHeaderAndTransactions(headerBytes []byte, transactions [][]byte, transactionIdx uint) (*BTCSpvProof, error) {
+	witnessProof, e := CreateWitnessProofForIdx(transactions, transactionIdx)
+
+	if e != nil {
+		return nil, e
+	}
+
+	coinbaseProof, e := CreateProofForIdx(transactions, 0)
+
+	if e != nil {
+		return nil, e
+	}
+
+	return &BTCSpvProof{
+		BtcTransaction:      transactions[transactionIdx],
+		BtcTransactionIndex: uint32(transactionIdx),
+		MerkleNodes:         flattenProof(witnessProof),
+		ConfirmingBtcHeader: headerBytes,
+		WitnessCommitmentProof: &WitnessCommitmentProof{
+			CoinbaseTransaction: transactions[0],
+			CoinbaseMerkleNodes: flattenProof(coinbaseProof),
+		},
+	}, nil
+}
+
+func flattenProof(proof []*chainhash.Hash) []byte {
+	var flatProof []byte
+
+	for _, h := range proof {
+		flatProof = append(flatProof, h.CloneBytes()...)
+	}
+
+	return flatProof
+}
@@ -0,0 +1,170 @@
+// Package verifier checks the proofs of inclusion of the checkpoints of Babylon in
+// the btc chain offline, i.e. without access to the state of Babylon nor of btc.
+// It is intended for the light clients of other chains which consume the proofs
+// returned by the EpochInclusionProof query of btccheckpoint.
+package verifier
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// VerifiedEpoch is the outcome of a successful verification of an epoch inclusion proof
+type VerifiedEpoch struct {
+	// Checkpoint of the epoch, signed by the validator set of the proof
+	Checkpoint *ckpttypes.RawCheckpoint
+	// BtcBlockHash is the hash of the highest btc block including a transaction of
+	// the checkpoint, i.e. the block in which the checkpoint is complete
+	BtcBlockHash bbn.BTCHeaderHashBytes
+	// Depth of that block in the header chain of the proof, the last header having depth 0
+	Depth uint64
+	// TipHash is the hash of the last header of the header chain of the proof
+	TipHash bbn.BTCHeaderHashBytes
+}
+
+// VerifyEpochInclusionProof checks that:
+// - the btc headers form a chain and each of them has a valid proof of work
+// - the transactions are included in blocks of that chain and carry the checkpoint,
+// encoded with the given tag
+// - the validators selected by the bitmap of the checkpoint reach the quorum threshold
+// of the voting power of the validator set, and the aggregate of their bls public
+// keys verifies the multi signature of the checkpoint.
+//
+// The verifier does not know the btc chain, the validator sets nor the parameters of
+// Babylon. It is up to the caller to check that the header chain is part of the btc
+// main chain, that the depth of the block is sufficient, that the validator set is
+// the one of the epoch and to provide the quorum threshold of checkpointing.
+func VerifyEpochInclusionProof(
+	proof *btcctypes.EpochInclusionProof,
+	tag txformat.BabylonTag,
+	powLimit *big.Int,
+	quorumThreshold ckpttypes.Fraction,
+) (*VerifiedEpoch, error) {
+	if proof == nil || proof.RawCheckpoint == nil || proof.ValidatorSet == nil || proof.BlsAggrPk == nil {
+		return nil, errors.New("incomplete epoch inclusion proof")
+	}
+
+	if len(proof.Proofs) == 0 || len(proof.BtcHeaders) == 0 {
+		return nil, errors.New("epoch inclusion proof does not contain any btc data")
+	}
+
+	headerIdx, err := verifyHeaderChain(proof.BtcHeaders, powLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	ckpt := proof.RawCheckpoint
+	if err := ckpt.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the submitter is not part of the proof, parsing does not depend on it
+	sub, err := btcctypes.ParseProofs(nil, proof.Proofs, powLimit, tag)
+	if err != nil {
+		return nil, fmt.Errorf("invalid btc transaction proofs: %w", err)
+	}
+
+	submitted, err := ckpttypes.FromBTCCkptBytesToRawCkpt(sub.GetRawCheckPointBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if !ckpt.Equal(submitted) {
+		return nil, errors.New("btc transactions do not carry the checkpoint of the proof")
+	}
+
+	highestIdx := -1
+	for _, hash := range sub.GetBlockHashes() {
+		idx, ok := headerIdx[hash.String()]
+		if !ok {
+			return nil, fmt.Errorf("block %s is not part of the header chain", hash.String())
+		}
+
+		if idx > highestIdx {
+			highestIdx = idx
+		}
+	}
+
+	if err := verifyCheckpointSignature(ckpt, proof.ValidatorSet, *proof.BlsAggrPk, quorumThreshold); err != nil {
+		return nil, err
+	}
+
+	lastIdx := len(proof.BtcHeaders) - 1
+
+	return &VerifiedEpoch{
+		Checkpoint:   ckpt,
+		BtcBlockHash: *proof.BtcHeaders[highestIdx].Hash(),
+		Depth:        uint64(lastIdx - highestIdx),
+		TipHash:      *proof.BtcHeaders[lastIdx].Hash(),
+	}, nil
+}
+
+// verifyHeaderChain checks that every header has a valid proof of work and extends
+// the previous one. It returns the index of each header keyed by its hash.
+func verifyHeaderChain(headers []bbn.BTCHeaderBytes, powLimit *big.Int) (map[string]int, error) {
+	headerIdx := make(map[string]int, len(headers))
+
+	for i := range headers {
+		if err := bbn.ValidateBTCHeader(headers[i].ToBlockHeader(), powLimit); err != nil {
+			return nil, fmt.Errorf("invalid btc header %d: %w", i, err)
+		}
+
+		if i > 0 && !headers[i].HasParent(&headers[i-1]) {
+			return nil, fmt.Errorf("btc header %d does not extend the preceding header", i)
+		}
+
+		headerIdx[headers[i].Hash().String()] = i
+	}
+
+	return headerIdx, nil
+}
+
+// verifyCheckpointSignature checks the multi signature of the checkpoint against the
+// validator set, following the rules checkpointing uses to seal checkpoints
+func verifyCheckpointSignature(
+	ckpt *ckpttypes.RawCheckpoint,
+	valSet *ckpttypes.ValidatorWithBlsKeySet,
+	aggrPk bls12381.PublicKey,
+	quorumThreshold ckpttypes.Fraction,
+) error {
+	signers, power, err := valSet.FindSubsetWithPowerSum(ckpt.Bitmap)
+	if err != nil {
+		return err
+	}
+
+	if !quorumThreshold.IsReachedBy(power, valSet.GetTotalPower()) {
+		return errors.New("insufficient voting power")
+	}
+
+	signerKeys, err := signers.GetBLSKeySet()
+	if err != nil {
+		return err
+	}
+
+	expectedAggrPk, err := bls12381.AggrPKList(signerKeys)
+	if err != nil {
+		return err
+	}
+
+	if !expectedAggrPk.Equal(aggrPk) {
+		return errors.New("aggregate public key does not match the signers of the checkpoint")
+	}
+
+	ok, err := bls12381.Verify(*ckpt.BlsMultiSig, aggrPk, ckpt.LastCommitHash.MustMarshal())
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errors.New("invalid BLS multi-sig")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package verifier_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	dg "github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/babylonchain/babylon/x/btccheckpoint/verifier"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+func toProof(t *testing.T, block *dg.BlockCreationResult) *btcctypes.BTCSpvProof {
+	var txs [][]byte
+	for _, tx := range block.Transactions {
+		txBytes, err := hex.DecodeString(tx)
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	proof, err := btcctypes.SpvProofFromHeaderAndTransactions(block.HeaderBytes, txs, uint(block.BbnTxIndex))
+	require.NoError(t, err)
+	return proof
+}
+
+// genEpochInclusionProof generates a valid proof of a checkpoint signed by numSigners
+// of numVals validators, whose parts are included in the first two of three blocks
+func genEpochInclusionProof(t *testing.T, numVals int, numSigners int) *btcctypes.EpochInclusionProof {
+	ckpt, valSet := dg.GenSignedRawCheckpoint(1, numVals, numSigners)
+
+	data1, data2 := txformat.MustEncodeCheckpointData(
+		txformat.MainTag(),
+		txformat.CurrentVersion,
+		ckpt.EpochNum,
+		*ckpt.LastCommitHash,
+		ckpt.Bitmap,
+		*ckpt.BlsMultiSig,
+		dg.GenRandomByteArray(txformat.AddressLength),
+	)
+
+	blocks := []*dg.BlockCreationResult{
+		dg.CreateBlock(1, 3, 2, data1),
+		dg.CreateBlock(2, 5, 1, data2),
+		dg.CreateBlock(3, 0, 0, nil),
+	}
+	dg.ChainBlocks(blocks)
+
+	signers, _, err := valSet.FindSubsetWithPowerSum(ckpt.Bitmap)
+	require.NoError(t, err)
+	signerKeys, err := signers.GetBLSKeySet()
+	require.NoError(t, err)
+	aggrPk, err := bls12381.AggrPKList(signerKeys)
+	require.NoError(t, err)
+
+	var headers []bbn.BTCHeaderBytes
+	for _, b := range blocks {
+		headers = append(headers, b.HeaderBytes)
+	}
+
+	return &btcctypes.EpochInclusionProof{
+		RawCheckpoint: ckpt,
+		BlsAggrPk:     &aggrPk,
+		ValidatorSet:  valSet,
+		Proofs:        []*btcctypes.BTCSpvProof{toProof(t, blocks[0]), toProof(t, blocks[1])},
+		BtcHeaders:    headers,
+	}
+}
+
+func TestVerifyEpochInclusionProof(t *testing.T) {
+	powLimit := chaincfg.SimNetParams.PowLimit
+	tag := txformat.MainTag()
+
+	proof := genEpochInclusionProof(t, 4, 2)
+	verified, err := verifier.VerifyEpochInclusionProof(proof, tag, powLimit, ckpttypes.DefaultQuorumThreshold)
+	require.NoError(t, err)
+	require.True(t, proof.RawCheckpoint.Equal(verified.Checkpoint))
+	require.Equal(t, *proof.BtcHeaders[1].Hash(), verified.BtcBlockHash)
+	require.Equal(t, uint64(1), verified.Depth)
+	require.Equal(t, *proof.BtcHeaders[2].Hash(), verified.TipHash)
+
+	testCases := []struct {
+		name   string
+		tamper func(p *btcctypes.EpochInclusionProof)
+	}{
+		{"no headers", func(p *btcctypes.EpochInclusionProof) {
+			p.BtcHeaders = nil
+		}},
+		{"broken header chain", func(p *btcctypes.EpochInclusionProof) {
+			p.BtcHeaders = []bbn.BTCHeaderBytes{p.BtcHeaders[0], p.BtcHeaders[2]}
+		}},
+		{"block missing in header chain", func(p *btcctypes.EpochInclusionProof) {
+			p.BtcHeaders = p.BtcHeaders[1:]
+		}},
+		{"other checkpoint", func(p *btcctypes.EpochInclusionProof) {
+			p.RawCheckpoint = genEpochInclusionProof(t, 4, 2).RawCheckpoint
+		}},
+		{"other aggregate public key", func(p *btcctypes.EpochInclusionProof) {
+			p.BlsAggrPk = genEpochInclusionProof(t, 4, 2).BlsAggrPk
+		}},
+		{"other validator set", func(p *btcctypes.EpochInclusionProof) {
+			p.ValidatorSet = genEpochInclusionProof(t, 4, 2).ValidatorSet
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := genEpochInclusionProof(t, 4, 2)
+			tc.tamper(p)
+			_, err := verifier.VerifyEpochInclusionProof(p, tag, powLimit, ckpttypes.DefaultQuorumThreshold)
+			require.Error(t, err)
+		})
+	}
+
+	// the transactions are tagged with the tag of another chain
+	_, err = verifier.VerifyEpochInclusionProof(genEpochInclusionProof(t, 4, 2), txformat.TestTag(1), powLimit, ckpttypes.DefaultQuorumThreshold)
+	require.Error(t, err)
+
+	// a third of the voting power is not enough
+	_, err = verifier.VerifyEpochInclusionProof(genEpochInclusionProof(t, 3, 1), tag, powLimit, ckpttypes.DefaultQuorumThreshold)
+	require.Error(t, err)
+
+	// half of the voting power is not enough for a higher threshold
+	_, err = verifier.VerifyEpochInclusionProof(proof, tag, powLimit, ckpttypes.Fraction{Numerator: 2, Denominator: 3})
+	require.Error(t, err)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
+	"os"
+	"strings"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -20,6 +22,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdTxInsertHeader())
+	cmd.AddCommand(CmdTxInsertHeaders())
 
 	return cmd
 }
@@ -48,3 +51,52 @@ func CmdTxInsertHeader() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTxInsertHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insert-headers [headers-file]",
+		Short: "submit a chain of BTC headers from a file",
+		Long: "submit a chain of BTC headers from a file containing one hex encoded header per line. " +
+			"The headers should be ordered by height, with each header extending the one in the previous line.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			headersHex, err := readHeadersFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgInsertHeaders(clientCtx.GetFromAddress(), headersHex)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readHeadersFile reads the hex encoded headers of a file, one per line, skipping empty lines
+func readHeadersFile(path string) ([]string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var headersHex []string
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		headersHex = append(headersHex, line)
+	}
+	return headersHex, nil
+}
@@ -11,6 +11,7 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetBaseBTCHeader(ctx, genState.BaseBtcHeader)
+	k.SetHeaders(ctx, genState.Headers, genState.TipHash)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -22,6 +23,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic("A base BTC Header has not been set")
 	}
 	genesis.BaseBtcHeader = *baseBTCHeader
+	genesis.Headers = k.GetHeaders(ctx)
+	genesis.TipHash = k.GetTip(ctx).Hash
 
 	return genesis
 }
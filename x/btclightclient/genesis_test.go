@@ -1,13 +1,17 @@
 package btclightclient_test
 
 import (
-	bbn "github.com/babylonchain/babylon/types"
+	"math/rand"
 	"testing"
 
+	bbn "github.com/babylonchain/babylon/types"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/testutil/nullify"
 	"github.com/babylonchain/babylon/x/btclightclient"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,3 +35,43 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 }
+
+func FuzzGenesisHeaders(f *testing.F) {
+	/*
+		Checks:
+		1. the exported genesis state is valid and contains all the headers apart from the base and the tip
+		2. importing the exported genesis state leads to the same headers, base BTC header and tip
+
+		Data generation:
+		- Build a tree of headers with the same difficulty by randomly extending existing headers.
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		k, ctx := keepertest.BTCLightClientKeeper(t)
+
+		bits := sdk.NewUint(datagen.RandomInt(1000000) + 1)
+		base := datagen.GenRandomBTCHeaderInfoWithBits(&bits)
+		k.SetBaseBTCHeader(ctx, *base)
+		tree := []*types.BTCHeaderInfo{base}
+		for i := 0; i < int(datagen.RandomInt(30)); i++ {
+			parent := tree[datagen.RandomInt(len(tree))]
+			header := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parent, &bits)
+			err := k.InsertHeader(ctx, header.Header)
+			require.NoError(t, err)
+			tree = append(tree, header)
+		}
+
+		genState := btclightclient.ExportGenesis(ctx, *k)
+		require.NoError(t, genState.Validate())
+		require.True(t, genState.BaseBtcHeader.Eq(base))
+		require.Len(t, genState.Headers, len(tree)-1)
+		require.True(t, k.GetTip(ctx).Hash.Eq(genState.TipHash))
+
+		importedK, importedCtx := keepertest.BTCLightClientKeeper(t)
+		btclightclient.InitGenesis(importedCtx, *importedK, *genState)
+		require.True(t, importedK.GetBaseBTCHeader(importedCtx).Eq(base))
+		require.True(t, importedK.GetTip(importedCtx).Eq(k.GetTip(ctx)))
+		require.Equal(t, genState, btclightclient.ExportGenesis(importedCtx, *importedK))
+	})
+}
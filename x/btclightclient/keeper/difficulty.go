@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blocksPerRetarget returns the number of blocks between two difficulty adjustments
+func (k Keeper) blocksPerRetarget() uint64 {
+	return uint64(k.btcParams.TargetTimespan / k.btcParams.TargetTimePerBlock)
+}
+
+// powNoRetargeting returns true for networks that never adjust their difficulty.
+// Following bitcoind's `fPowNoRetargeting` this is only the case for regtest.
+func (k Keeper) powNoRetargeting() bool {
+	return k.btcParams.Name == chaincfg.RegressionNetParams.Name
+}
+
+// RequiredDifficultyBits calculates the difficulty bits that a header extending `parent`
+// and having the `newBlockTime` timestamp should have according to the Bitcoin
+// retarget rules of the configured network.
+// It follows the `calcNextRequiredDifficulty` function of btcd
+// https://github.com/btcsuite/btcd/blob/master/blockchain/difficulty.go
+// An ErrHeaderDoesNotExist error is returned if the calculation requires ancestors
+// that are older than the base BTC header.
+func (k Keeper) RequiredDifficultyBits(ctx sdk.Context, parent *types.BTCHeaderInfo, newBlockTime time.Time) (uint32, error) {
+	params := k.btcParams
+	blocksPerRetarget := k.blocksPerRetarget()
+
+	// The new block is not at a difficulty retarget interval
+	if (parent.Height+1)%blocksPerRetarget != 0 {
+		// For networks that support it, allow special reduction of the
+		// required difficulty once too much time has elapsed without mining a block.
+		if params.ReduceMinDifficulty {
+			// Return minimum difficulty when more than the desired
+			// amount of time has elapsed without mining a block.
+			allowMinTime := parent.Header.Time().Add(params.MinDiffReductionTime)
+			if newBlockTime.After(allowMinTime) {
+				return params.PowLimitBits, nil
+			}
+			// The block was mined within the desired timeframe, so
+			// return the difficulty for the last block which did
+			// not have the special minimum difficulty rule applied.
+			return k.findPrevTestNetDifficulty(ctx, parent)
+		}
+		// For the main network, the difficulty remains the same as the parent
+		return parent.Header.Bits(), nil
+	}
+
+	if k.powNoRetargeting() {
+		return parent.Header.Bits(), nil
+	}
+
+	// Get the header at the previous retarget (targetTimespan days worth of blocks)
+	first, err := k.headersState(ctx).GetAncestorAtHeight(parent, parent.Height+1-blocksPerRetarget)
+	if err != nil {
+		return 0, err
+	}
+
+	// Limit the amount of adjustment that can occur to the previous difficulty
+	targetTimespan := int64(params.TargetTimespan / time.Second)
+	minRetargetTimespan := targetTimespan / params.RetargetAdjustmentFactor
+	maxRetargetTimespan := targetTimespan * params.RetargetAdjustmentFactor
+	actualTimespan := parent.Header.Time().Unix() - first.Header.Time().Unix()
+	adjustedTimespan := actualTimespan
+	if actualTimespan < minRetargetTimespan {
+		adjustedTimespan = minRetargetTimespan
+	} else if actualTimespan > maxRetargetTimespan {
+		adjustedTimespan = maxRetargetTimespan
+	}
+
+	// Calculate new target difficulty as:
+	//  currentDifficulty * (adjustedTimespan / targetTimespan)
+	// The result uses integer division which means it will be slightly rounded down,
+	// same as bitcoind.
+	oldTarget := blockchain.CompactToBig(parent.Header.Bits())
+	newTarget := new(big.Int).Mul(oldTarget, big.NewInt(adjustedTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+
+	// Limit new value to the proof of work limit
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
+	}
+
+	return blockchain.BigToCompact(newTarget), nil
+}
+
+// findPrevTestNetDifficulty returns the difficulty of the last ancestor of `header`
+// (inclusive) that did not have the special minimum difficulty rule applied.
+func (k Keeper) findPrevTestNetDifficulty(ctx sdk.Context, header *types.BTCHeaderInfo) (uint32, error) {
+	blocksPerRetarget := k.blocksPerRetarget()
+	powLimitBits := k.btcParams.PowLimitBits
+
+	current := header
+	for current.Height%blocksPerRetarget != 0 && current.Header.Bits() == powLimitBits {
+		parent, err := k.headersState(ctx).GetParent(current)
+		if err != nil {
+			return 0, err
+		}
+		current = parent
+	}
+	return current.Header.Bits(), nil
+}
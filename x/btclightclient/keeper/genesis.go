@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetHeaders returns all the maintained headers apart from the base BTC header
+// in ascending height order
+func (k Keeper) GetHeaders(ctx sdk.Context) []types.BTCHeaderInfo {
+	var headers []types.BTCHeaderInfo
+	baseHeader := k.headersState(ctx).GetBaseBTCHeader()
+	k.headersState(ctx).iterateHeaders(func(header *types.BTCHeaderInfo) bool {
+		if !header.Eq(baseHeader) {
+			headers = append(headers, *header)
+		}
+		return false
+	})
+	return headers
+}
+
+// GetTip returns the tip of the main chain
+func (k Keeper) GetTip(ctx sdk.Context) *types.BTCHeaderInfo {
+	return k.headersState(ctx).GetTip()
+}
+
+// SetHeaders stores headers that extend the base BTC header and sets the tip.
+// The headers should have been validated and be provided in ascending height order,
+// as it is the case for the headers of the genesis state.
+// No hooks or events are triggered and no headers are pruned.
+// If no tip hash is provided, the header with the most cumulative work becomes the tip.
+func (k Keeper) SetHeaders(ctx sdk.Context, headers []types.BTCHeaderInfo, tipHash *bbn.BTCHeaderHashBytes) {
+	if k.headersState(ctx).GetBaseBTCHeader() == nil {
+		panic("A base BTC Header has not been set")
+	}
+	for i := range headers {
+		k.headersState(ctx).CreateHeader(&headers[i])
+	}
+
+	if tipHash == nil {
+		return
+	}
+	tip, err := k.headersState(ctx).GetHeaderByHash(tipHash)
+	if err != nil {
+		panic("The tip is not maintained")
+	}
+	k.headersState(ctx).CreateTip(tip)
+}
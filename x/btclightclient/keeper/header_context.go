@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"math/big"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/blockchain"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const BTCDifficultyMultiplier = 4 // TODO: get from config file
+
+// checkHeaderContext performs the checks that checkBlockHeaderContext of btcd does
+// https://github.com/btcsuite/btcd/blob/master/blockchain/validate.go#L644
+// for a header that extends `parent`.
+// We skip the checkpoint and version checks
+func (k Keeper) checkHeaderContext(ctx sdk.Context, parent *types.BTCHeaderInfo, header *bbn.BTCHeaderBytes) error {
+	// The timestamp of the new block should be after
+	// the median time of the last several blocks (median time past)
+	medianTime := k.MedianTimePast(ctx, parent)
+	if !header.Time().After(medianTime) {
+		return types.ErrInvalidTimestamp.Wrapf("header timestamp %s is not after the median time past %s", header.Time(), medianTime)
+	}
+
+	// The difficulty bits of the new block should be the ones that
+	// the Bitcoin retarget rules of the configured network dictate.
+	// See: https://github.com/bitcoinbook/bitcoinbook/blob/develop/ch10.asciidoc#retargeting-to-adjust-difficulty
+	expectedBits, err := k.RequiredDifficultyBits(ctx, parent, header.Time())
+	if err == nil {
+		if header.Bits() != expectedBits {
+			return types.ErrInvalidDifficulty.Wrapf("expected difficulty bits %08x, got %08x", expectedBits, header.Bits())
+		}
+	} else if types.ErrHeaderDoesNotExist.Is(err) {
+		// The ancestors required for calculating the difficulty are older than the base header.
+		// Fall back to checking that the difficulty is within the maximum
+		// adjustment factor from the difficulty of the parent.
+		oldDifficulty := blockchain.CompactToBig(parent.Header.Bits())
+		currentDifficulty := blockchain.CompactToBig(header.Bits())
+		maxCurrentDifficulty := new(big.Int).Mul(oldDifficulty, big.NewInt(BTCDifficultyMultiplier))
+		minCurrentDifficulty := new(big.Int).Div(oldDifficulty, big.NewInt(BTCDifficultyMultiplier))
+		if currentDifficulty.Cmp(maxCurrentDifficulty) > 0 || currentDifficulty.Cmp(minCurrentDifficulty) < 0 {
+			return types.ErrInvalidDifficulty.Wrap("difficulty not relevant to parent difficulty")
+		}
+	} else {
+		return err
+	}
+	return nil
+}
@@ -28,3 +28,17 @@ func (k Keeper) AfterBTCRollForward(ctx sdk.Context, headerInfo *types.BTCHeader
 		k.hooks.AfterBTCRollForward(ctx, headerInfo)
 	}
 }
+
+// AfterBTCTipUpdated - call hook if registered
+func (k Keeper) AfterBTCTipUpdated(ctx sdk.Context, headerInfo *types.BTCHeaderInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBTCTipUpdated(ctx, headerInfo)
+	}
+}
+
+// BeforeBTCHeaderPruned - call hook if registered
+func (k Keeper) BeforeBTCHeaderPruned(ctx sdk.Context, headerInfo *types.BTCHeaderInfo) {
+	if k.hooks != nil {
+		k.hooks.BeforeBTCHeaderPruned(ctx, headerInfo)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -18,6 +19,7 @@ type (
 		memKey     sdk.StoreKey
 		hooks      types.BTCLightClientHooks
 		paramstore paramtypes.Subspace
+		btcParams  *chaincfg.Params
 	}
 )
 
@@ -26,7 +28,7 @@ func NewKeeper(
 	storeKey,
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
-
+	btcParams *chaincfg.Params,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -39,6 +41,7 @@ func NewKeeper(
 		memKey:     memKey,
 		hooks:      nil,
 		paramstore: ps,
+		btcParams:  btcParams,
 	}
 }
 
@@ -57,9 +60,86 @@ func (k *Keeper) SetHooks(bh types.BTCLightClientHooks) *Keeper {
 }
 
 // InsertHeader inserts a btcd header into the header state
+// and prunes the headers that are not needed anymore if the tip has changed
 func (k Keeper) InsertHeader(ctx sdk.Context, header *bbn.BTCHeaderBytes) error {
+	// Retrieve the previous tip for future usage
+	previousTip := k.headersState(ctx).GetTip()
+
+	headerInfo, err := k.insertHeader(ctx, header)
+	if err != nil {
+		return err
+	}
+
+	// Get the new tip
+	currentTip := k.headersState(ctx).GetTip()
+	if !currentTip.Eq(previousTip) && !currentTip.Eq(headerInfo) {
+		panic("The tip was updated but with a different header than the one provided")
+	}
+	k.triggerTipUpdate(ctx, previousTip, currentTip)
+	k.pruneHeaders(ctx, previousTip, currentTip)
+
+	return nil
+}
+
+// InsertValidHeaders validates and inserts a chain of btcd headers into the header state.
+// Each header should extend the header that precedes it, while the first header should extend
+// an existing header. Every header is validated against the context of its ancestors,
+// including the ones that precede it in the chain, right before its insertion.
+// Hooks and events for changes of the tip are triggered only once for the net change
+// between the tip before the first and after the last insertion, with a single tip
+// updated hook for the whole chain, after which the headers that are not needed
+// anymore are pruned.
+// If an error is returned, the caller should discard the state changes.
+func (k Keeper) InsertValidHeaders(ctx sdk.Context, headers []*bbn.BTCHeaderBytes) error {
+	if len(headers) == 0 {
+		return types.ErrEmptyMessage.Wrap("no headers provided")
+	}
+
+	// Retrieve the previous tip for future usage
+	previousTip := k.headersState(ctx).GetTip()
+
+	var headerInfo *types.BTCHeaderInfo
+	for i, header := range headers {
+		if header == nil {
+			return types.ErrEmptyMessage.Wrapf("header %d is nil", i)
+		}
+		if i > 0 && !header.HasParent(headers[i-1]) {
+			return types.ErrHeaderParentDoesNotExist.Wrapf("header %d does not extend the preceding header", i)
+		}
+		// Retrieve the parent, which has been inserted in the previous iteration
+		// for all headers apart from the first one
+		parent, err := k.headersState(ctx).GetHeaderByHash(header.ParentHash())
+		if err != nil {
+			return err
+		}
+		err = k.checkHeaderContext(ctx, parent, header)
+		if err != nil {
+			return err
+		}
+		headerInfo, err = k.insertHeader(ctx, header)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Get the new tip. If it has changed, it should be the last header of the chain
+	// as it has more cumulative work than all the other headers of the chain.
+	currentTip := k.headersState(ctx).GetTip()
+	if !currentTip.Eq(previousTip) && !currentTip.Eq(headerInfo) {
+		panic("The tip was updated but with a different header than the last one provided")
+	}
+	k.triggerTipUpdate(ctx, previousTip, currentTip)
+	k.pruneHeaders(ctx, previousTip, currentTip)
+
+	return nil
+}
+
+// insertHeader stores a header that extends an existing header
+// and triggers the header inserted hook and event.
+// The caller is responsible for triggering the hooks and events for changes of the tip.
+func (k Keeper) insertHeader(ctx sdk.Context, header *bbn.BTCHeaderBytes) (*types.BTCHeaderInfo, error) {
 	if header == nil {
-		return types.ErrEmptyMessage
+		return nil, types.ErrEmptyMessage
 	}
 	headerHash := header.Hash()
 	parentHash := header.ParentHash()
@@ -67,13 +147,13 @@ func (k Keeper) InsertHeader(ctx sdk.Context, header *bbn.BTCHeaderBytes) error
 	// Check whether the header already exists, if yes reject
 	headerExists := k.headersState(ctx).HeaderExists(headerHash)
 	if headerExists {
-		return types.ErrDuplicateHeader.Wrap("header with provided hash already exists")
+		return nil, types.ErrDuplicateHeader.Wrap("header with provided hash already exists")
 	}
 
 	// Check whether the parent exists, if not reject
 	parentExists := k.headersState(ctx).HeaderExists(parentHash)
 	if !parentExists {
-		return types.ErrHeaderParentDoesNotExist.Wrap("parent for provided hash is not maintained")
+		return nil, types.ErrHeaderParentDoesNotExist.Wrap("parent for provided hash is not maintained")
 	}
 
 	// Retrieve the height of the parent to calculate the current height
@@ -90,6 +170,12 @@ func (k Keeper) InsertHeader(ctx sdk.Context, header *bbn.BTCHeaderBytes) error
 		panic("Work for parent is not maintained")
 	}
 
+	// Reject headers that fork from a header that is deeper than the fork retention depth
+	err = k.checkForkDepth(ctx, parentHeight+1)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate the cumulative work
 	headerWork := types.CalcWork(header)
 	cumulativeWork := types.CumulativeWork(headerWork, *parentWork)
@@ -97,49 +183,48 @@ func (k Keeper) InsertHeader(ctx sdk.Context, header *bbn.BTCHeaderBytes) error
 	// Construct the BTCHeaderInfo object
 	headerInfo := types.NewBTCHeaderInfo(header, headerHash, parentHeight+1, &cumulativeWork)
 
-	// Retrieve the previous tip for future usage
-	previousTip := k.headersState(ctx).GetTip()
-
 	// Create the header
 	k.headersState(ctx).CreateHeader(headerInfo)
 
-	// Get the new tip
-	currentTip := k.headersState(ctx).GetTip()
+	k.triggerHeaderInserted(ctx, headerInfo)
 
-	// Variable maintaining the headers that have been added to the main chain
-	var addedToMainChain []*types.BTCHeaderInfo
+	return headerInfo, nil
+}
 
-	k.triggerHeaderInserted(ctx, headerInfo)
-	// The tip has changed, we need to send events
-	if !currentTip.Eq(previousTip) {
-		if !currentTip.Eq(headerInfo) {
-			panic("The tip was updated but with a different header than the one provided")
-		}
-		// Get the highest common ancestor between the new tip and the old tip
-		// There are two cases:
-		// 	 1. The new tip extends the old tip
-		//	    - The highest common ancestor is the old tip
-		// 		- No need to send a roll-back event
-		//   2. There has been a chain re-org
-		// 		- Need to send a roll-back event
-		var hca *types.BTCHeaderInfo
-		if currentTip.HasParent(previousTip) {
-			hca = previousTip
-		} else {
-			hca = k.headersState(ctx).GetHighestCommonAncestor(previousTip, currentTip)
+// triggerTipUpdate triggers the roll-back and roll-forward hooks and events
+// for the change of the tip from `previousTip` to `currentTip`,
+// followed by a single tip updated hook for the new tip
+func (k Keeper) triggerTipUpdate(ctx sdk.Context, previousTip *types.BTCHeaderInfo, currentTip *types.BTCHeaderInfo) {
+	// The tip has not changed, there is nothing to send
+	if currentTip.Eq(previousTip) {
+		return
+	}
+
+	// Get the highest common ancestor between the new tip and the old tip
+	// There are two cases:
+	// 	 1. The new tip extends the old tip
+	//	    - The highest common ancestor is the old tip
+	// 		- No need to send a roll-back event
+	//   2. There has been a chain re-org
+	// 		- Need to send a roll-back event
+	var hca *types.BTCHeaderInfo
+	if currentTip.HasParent(previousTip) {
+		hca = previousTip
+	} else {
+		hca = k.headersState(ctx).GetHighestCommonAncestor(previousTip, currentTip)
+		// If the old tip is an ancestor of the new tip, the chain has been extended
+		if !hca.Eq(previousTip) {
 			// chain re-org: trigger a roll-back event to the highest common ancestor
 			k.triggerRollBack(ctx, hca)
 		}
-		// Find the newly added headers to the main chain
-		addedToMainChain = k.headersState(ctx).GetInOrderAncestorsUntil(currentTip, hca)
-		// Iterate through the added headers and trigger a roll-forward event
-		for _, added := range addedToMainChain {
-			// tipHeight + 1 - len(addedToMainChain) -> height of the highest common ancestor
-			k.triggerRollForward(ctx, added)
-		}
 	}
-
-	return nil
+	// Find the newly added headers to the main chain
+	addedToMainChain := k.headersState(ctx).GetInOrderAncestorsUntil(currentTip, hca)
+	// Iterate through the added headers and trigger a roll-forward event
+	for _, added := range addedToMainChain {
+		k.triggerRollForward(ctx, added)
+	}
+	k.AfterBTCTipUpdated(ctx, currentTip)
 }
 
 // BlockHeight returns the height of the provided header
@@ -201,6 +286,36 @@ func (k Keeper) IsHeaderKDeep(ctx sdk.Context, headerHashBytes *bbn.BTCHeaderHas
 	return depth >= uint64(mainchainDepth), nil
 }
 
+// MainChainFrom returns the headers of the main chain starting from the provided
+// header up to the tip, in ascending height order.
+// Returns an error if the header is unknown or not on the main chain.
+func (k Keeper) MainChainFrom(ctx sdk.Context, headerHashBytes *bbn.BTCHeaderHashBytes) ([]bbn.BTCHeaderBytes, error) {
+	if headerHashBytes == nil {
+		return nil, types.ErrEmptyMessage
+	}
+	headerInfo, err := k.headersState(ctx).GetHeaderByHash(headerHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tipInfo := k.headersState(ctx).GetTip()
+	if tipInfo.Height < headerInfo.Height {
+		return nil, types.ErrHeaderDoesNotExist.Wrapf("header is not on the main chain")
+	}
+
+	// main chain is returned from the tip down to the header
+	mainchain := k.headersState(ctx).GetMainChainUpTo(tipInfo.Height - headerInfo.Height)
+	if len(mainchain) == 0 || !headerInfo.Eq(mainchain[len(mainchain)-1]) {
+		return nil, types.ErrHeaderDoesNotExist.Wrapf("header is not on the main chain")
+	}
+
+	headers := make([]bbn.BTCHeaderBytes, len(mainchain))
+	for i, h := range mainchain {
+		headers[len(mainchain)-1-i] = *h.Header
+	}
+	return headers, nil
+}
+
 // IsAncestor returns true/false depending on whether `parent` is an ancestor of `child`.
 // Returns false if the parent and the child are the same header.
 func (k Keeper) IsAncestor(ctx sdk.Context, parentHashBytes *bbn.BTCHeaderHashBytes, childHashBytes *bbn.BTCHeaderHashBytes) (bool, error) {
@@ -154,6 +154,64 @@ func FuzzKeeperMainChainDepth(f *testing.F) {
 	})
 }
 
+func FuzzKeeperMainChainFrom(f *testing.F) {
+	/*
+		Checks:
+		1. if the BTCHeaderHashBytes object is nil, an error is returned
+		2. if the header does not exist, an error is returned
+		3. if the header is not on the main chain, an error is returned
+		4. if the header is on the main chain, the headers from it up to the tip are returned in ascending order
+
+		Data Generation:
+		- Generate a random tree of headers.
+		- Random selection of a header from the main chain and outside of it.
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 100)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		blcKeeper, ctx := testkeeper.BTCLightClientKeeper(t)
+
+		if _, err := blcKeeper.MainChainFrom(ctx, nil); err == nil {
+			t.Errorf("Nil input led to nil error")
+		}
+
+		nonExistentHeader := datagen.GenRandomBTCHeaderBytes(nil, nil)
+		if _, err := blcKeeper.MainChainFrom(ctx, nonExistentHeader.Hash()); err == nil {
+			t.Errorf("Non existent header led to nil error")
+		}
+
+		tree := genRandomTree(blcKeeper, ctx, 1, 10)
+		header := tree.RandomNode()
+		tip := tree.GetTip()
+
+		headers, err := blcKeeper.MainChainFrom(ctx, header.Hash)
+		if !tree.IsOnNodeChain(tip, header) {
+			if err == nil {
+				t.Errorf("Non-mainchain header led to nil error")
+			}
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("Mainchain header led to error %s", err)
+		}
+		if uint64(len(headers)) != tip.Height-header.Height+1 {
+			t.Fatalf("Expected %d headers, got %d", tip.Height-header.Height+1, len(headers))
+		}
+		if !headers[0].Eq(header.Header) {
+			t.Errorf("First header is not the requested one")
+		}
+		if !headers[len(headers)-1].Eq(tip.Header) {
+			t.Errorf("Last header is not the tip")
+		}
+		for i := 1; i < len(headers); i++ {
+			if !headers[i].HasParent(&headers[i-1]) {
+				t.Errorf("Header %d does not extend the previous one", i)
+			}
+		}
+	})
+}
+
 func FuzzKeeperBlockHeight(f *testing.F) {
 	/*
 		Checks:
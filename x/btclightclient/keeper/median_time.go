@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// medianTimeBlocks is the number of previous headers which should be
+// used to calculate the median time used to validate header timestamps.
+const medianTimeBlocks = 11
+
+// MedianTimePast calculates the median of the timestamps of `header`
+// and its previous `medianTimeBlocks-1` ancestors.
+// If there are less ancestors maintained, because the base BTC header is reached,
+// the median is calculated from the timestamps that are available.
+// It follows the `CalcPastMedianTime` function of btcd
+// https://github.com/btcsuite/btcd/blob/master/blockchain/blockindex.go
+func (k Keeper) MedianTimePast(ctx sdk.Context, header *types.BTCHeaderInfo) time.Time {
+	timestamps := k.headersState(ctx).GetAncestorTimestamps(header, medianTimeBlocks)
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i].Before(timestamps[j])
+	})
+
+	// NOTE: The consensus rules incorrectly calculate the median for even
+	// numbers of blocks. A true median averages the middle two elements
+	// for a set with an even number of elements in it. Since the constant
+	// for the previous number of blocks to be used is odd, this is only an
+	// issue for a few blocks near the base header. Nevertheless, follow the
+	// same logic as btcd and bitcoind.
+	return timestamps[len(timestamps)/2]
+}
@@ -2,10 +2,9 @@ package keeper
 
 import (
 	"context"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
-	"github.com/btcsuite/btcd/blockchain"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"math/big"
 )
 
 type msgServer struct {
@@ -13,12 +12,7 @@ type msgServer struct {
 	k Keeper
 }
 
-const BTCDifficultyMultiplier = 4 // TODO: get from config file
-
 func (m msgServer) InsertHeader(ctx context.Context, msg *types.MsgInsertHeader) (*types.MsgInsertHeaderResponse, error) {
-	// Perform the checks that checkBlockHeaderContext of btcd does
-	// https://github.com/btcsuite/btcd/blob/master/blockchain/validate.go#L644
-	// We skip the time, checkpoint, and version checks
 	// TODO: Implement an AnteHandler that performs these checks
 	// 		 so as to not pollute the mempool with transactions
 	// 		 that will get rejected.
@@ -41,18 +35,9 @@ func (m msgServer) InsertHeader(ctx context.Context, msg *types.MsgInsertHeader)
 		return nil, err
 	}
 
-	// The new block will either be the first block of a recalculation event
-	// which happens every 2,016 blocks or a normal block.
-	// In the second case, it's difficulty should be exactly the same as it's parent
-	// while in the second case it should have a maximum difference of a factor of 4 from it
-	// See: https://github.com/bitcoinbook/bitcoinbook/blob/develop/ch10.asciidoc#retargeting-to-adjust-difficulty
-	// We consolidate those into a single check.
-	oldDifficulty := blockchain.CompactToBig(parent.Header.Bits())
-	currentDifficulty := blockchain.CompactToBig(msg.Header.Bits())
-	maxCurrentDifficulty := new(big.Int).Mul(oldDifficulty, big.NewInt(BTCDifficultyMultiplier))
-	minCurrentDifficulty := new(big.Int).Div(oldDifficulty, big.NewInt(BTCDifficultyMultiplier))
-	if currentDifficulty.Cmp(maxCurrentDifficulty) > 0 || currentDifficulty.Cmp(minCurrentDifficulty) < 0 {
-		return nil, types.ErrInvalidDifficulty.Wrap("difficulty not relevant to parent difficulty")
+	err = m.k.checkHeaderContext(sdkCtx, parent, msg.Header)
+	if err != nil {
+		return nil, err
 	}
 
 	// All good, insert the header
@@ -63,6 +48,31 @@ func (m msgServer) InsertHeader(ctx context.Context, msg *types.MsgInsertHeader)
 	return &types.MsgInsertHeaderResponse{}, nil
 }
 
+func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeaders) (*types.MsgInsertHeadersResponse, error) {
+	if msg == nil {
+		return nil, types.ErrEmptyMessage.Wrapf("message is nil")
+	}
+
+	if len(msg.Headers) == 0 {
+		return nil, types.ErrEmptyMessage.Wrapf("message headers are empty")
+	}
+
+	// Get the SDK wrapped context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	headers := make([]*bbn.BTCHeaderBytes, len(msg.Headers))
+	for i := range msg.Headers {
+		headers[i] = &msg.Headers[i]
+	}
+
+	// Validate and insert the headers as a contiguous segment
+	err := m.k.InsertValidHeaders(sdkCtx, headers)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgInsertHeadersResponse{}, nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -3,14 +3,20 @@ package keeper_test
 import (
 	"context"
 	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
 	"math/big"
 	"math/rand"
+	"sort"
 	"testing"
+	"time"
 
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, *keeper.Keeper, context.Context) {
@@ -24,19 +30,16 @@ func FuzzMsgServerInsertHeader(f *testing.F) {
 		1. if the input message is nil, (nil, error) is returned
 		2. if the msg does not contain a header, (nil, error) is returned
 		3. if the parent of the header does not exist, (nil, error) is returned
-		4. if the work of the header is not within the limits of the new header, (nil, error) is returned
-		5. if all checks pass, the header is inserted into storage and an (empty MsgInsertHeaderResponse, nil) is returned
+		4. if the header is not at a retarget boundary and its bits are different from the parent's, (nil, error) is returned
+		5. if the header is mined more than 20 minutes after its parent, it can have the minimum difficulty (simnet rule)
+		6. if the header is mined less than 20 minutes after its parent, it cannot have the minimum difficulty
+		7. if the header timestamp is not after the median of the timestamps of the last 11 headers, (nil, error) is returned
+		8. if all checks pass, the header is inserted into storage and an (empty MsgInsertHeaderResponse, nil) is returned
 		   - we do not need to perform insertion checks since those are performed on FuzzKeeperInsertHeader
 		Building:
 		- Construct a random tree and insert into storage
 		- Generate a random header for which its parent does not exist
-		- Select a random header from the tree and construct BTCHeaderBytes objects on top of it with different work
-			 1. 4 times the work of parent
-			 2. 1 < work < 4 times the work of parent
-			 3. work > 4 times the work of the parent
-			 4. parent 4 times the work of the header
-			 5. parent 1 < work < 4 times the work of the header
-			 6. parent > 4 times the work of the header
+		- Select a random header from the tree and construct BTCHeaderBytes objects on top of it with different bits and timestamps
 	*/
 	datagen.AddRandomSeedsToFuzzer(f, 100)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -78,42 +81,333 @@ func FuzzMsgServerInsertHeader(f *testing.F) {
 		// Construct a tree and insert it into storage
 		tree := genRandomTree(blcKeeper, ctx, uint64(2), 10)
 		parentHeader := tree.RandomNode()
-		// Do not work with different cases. Select a random integer between 1-BTCDifficultyMultiplier+1
-		// 1/BTCDifficultyMultiplier times, the work is going to be invalid
-		parentHeaderDifficulty := parentHeader.Header.Difficulty()
-		// Avoid BTCDifficultyMultiplier itself, since the many conversions might lead to inconsistencies
-		mul := datagen.RandomInt(keeper.BTCDifficultyMultiplier-1) + 1
-		if datagen.OneInN(10) { // Give an invalid mul sometimes
-			mul = keeper.BTCDifficultyMultiplier + 1
+		parentTime := parentHeader.Header.Time()
+		// The tree is not deep enough to contain the ancestors needed for a retarget,
+		// so at a retarget boundary only the maximum adjustment factor is checked.
+		params := &chaincfg.SimNetParams
+		blocksPerRetarget := uint64(params.TargetTimespan / params.TargetTimePerBlock)
+		atRetarget := (parentHeader.Height+1)%blocksPerRetarget == 0
+
+		// A header with the same bits as its parent is always valid
+		headerSameBits := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, parentHeader.Header.Bits(), parentTime.Add(10*time.Minute))
+		msg = &types.MsgInsertHeader{Header: headerSameBits.Header}
+		resp, err = msgServer.InsertHeader(sdkCtx, msg)
+		if err != nil || resp == nil {
+			t.Errorf("Header with the same bits as its parent led to an error %s", err)
 		}
-		headerDifficultyMul := sdk.NewUintFromBigInt(new(big.Int).Mul(parentHeaderDifficulty, big.NewInt(int64(mul))))
-		headerDifficultyDiv := sdk.NewUintFromBigInt(new(big.Int).Div(parentHeaderDifficulty, big.NewInt(int64(mul))))
 
-		// Do tests
-		headerMoreWork := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parentHeader, &headerDifficultyMul)
-		msg = &types.MsgInsertHeader{Header: headerMoreWork.Header}
+		// A header with a timestamp that is not after the median time past is invalid
+		ancestry := tree.GetNodeAncestry(parentHeader)
+		if len(ancestry) > 11 {
+			ancestry = ancestry[:11]
+		}
+		var timestamps []time.Time
+		for _, ancestor := range ancestry {
+			timestamps = append(timestamps, ancestor.Header.Time())
+		}
+		sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+		medianTime := timestamps[len(timestamps)/2]
+		headerMedianTime := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, parentHeader.Header.Bits(), medianTime)
+		msg = &types.MsgInsertHeader{Header: headerMedianTime.Header}
 		resp, err = msgServer.InsertHeader(sdkCtx, msg)
-		if mul > keeper.BTCDifficultyMultiplier && resp != nil {
-			t.Errorf("Invalid header work led to a response getting returned")
+		if resp != nil || !types.ErrInvalidTimestamp.Is(err) {
+			t.Errorf("Header with a timestamp equal to the median time past did not lead to an error")
 		}
-		if mul > keeper.BTCDifficultyMultiplier && err == nil {
-			t.Errorf("Invalid header work did not lead to an error %d %s %s %s", mul, headerDifficultyMul, headerDifficultyDiv, parentHeaderDifficulty)
+		headerAfterMedianTime := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, parentHeader.Header.Bits(), medianTime.Add(time.Second))
+		msg = &types.MsgInsertHeader{Header: headerAfterMedianTime.Header}
+		resp, err = msgServer.InsertHeader(sdkCtx, msg)
+		if err != nil || resp == nil {
+			t.Errorf("Header with a timestamp after the median time past led to an error %s", err)
+		}
+
+		// A header with different bits than its parent is only valid at a retarget boundary
+		// if the difference is within the adjustment factor.
+		mul := datagen.RandomInt(keeper.BTCDifficultyMultiplier-1) + 2
+		parentHeaderDifficulty := parentHeader.Header.Difficulty()
+		var headerDifficulty sdk.Uint
+		if datagen.OneInN(2) {
+			headerDifficulty = sdk.NewUintFromBigInt(new(big.Int).Mul(parentHeaderDifficulty, big.NewInt(int64(mul))))
+		} else {
+			headerDifficulty = sdk.NewUintFromBigInt(new(big.Int).Div(parentHeaderDifficulty, big.NewInt(int64(mul))))
+		}
+		headerOtherBits := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, blockchain.BigToCompact(headerDifficulty.BigInt()), parentTime.Add(10*time.Minute))
+		msg = &types.MsgInsertHeader{Header: headerOtherBits.Header}
+		resp, err = msgServer.InsertHeader(sdkCtx, msg)
+		expectValid := atRetarget && mul <= keeper.BTCDifficultyMultiplier
+		if expectValid && err != nil {
+			t.Errorf("Valid header difficulty led to an error %d %s", mul, err)
 		}
-		if mul <= keeper.BTCDifficultyMultiplier && err != nil {
-			t.Errorf("Valid header work led to an error")
+		if !expectValid && (err == nil || resp != nil) {
+			t.Errorf("Invalid header difficulty did not lead to an error %d %s %s", mul, headerDifficulty, parentHeaderDifficulty)
+		}
+		if !expectValid && !types.ErrInvalidDifficulty.Is(err) {
+			t.Errorf("Invalid header difficulty led to an unexpected error %s", err)
+		}
+
+		if atRetarget {
+			return
+		}
+
+		// A header mined more than 20 minutes after its parent can have the minimum difficulty
+		delay := params.MinDiffReductionTime + time.Duration(datagen.RandomInt(3600)+1)*time.Second
+		headerMinDifficulty := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, params.PowLimitBits, parentTime.Add(delay))
+		msg = &types.MsgInsertHeader{Header: headerMinDifficulty.Header}
+		resp, err = msgServer.InsertHeader(sdkCtx, msg)
+		if err != nil || resp == nil {
+			t.Errorf("Header with minimum difficulty after %s led to an error %s", delay, err)
 		}
 
-		headerLessWork := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parentHeader, &headerDifficultyDiv)
-		msg = &types.MsgInsertHeader{Header: headerLessWork.Header}
+		// A header mined less than 20 minutes after its parent cannot have the minimum difficulty
+		delay = time.Duration(datagen.RandomInt(int(params.MinDiffReductionTime/time.Second))) * time.Second
+		headerMinDifficulty = datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(parentHeader, params.PowLimitBits, parentTime.Add(delay))
+		msg = &types.MsgInsertHeader{Header: headerMinDifficulty.Header}
 		resp, err = msgServer.InsertHeader(sdkCtx, msg)
-		if mul > keeper.BTCDifficultyMultiplier && resp != nil {
-			t.Errorf("Invalid header work led to a response getting returned")
+		if err == nil || resp != nil {
+			t.Errorf("Header with minimum difficulty after %s did not lead to an error", delay)
+		}
+	})
+}
+
+func FuzzMsgServerInsertHeaderRetarget(f *testing.F) {
+	/*
+		Test that at a retarget boundary:
+		1. a header with the bits calculated from the timespan of the previous 2016 headers is accepted
+		2. a header with any other bits is rejected
+		Building:
+		- Set a base header right before a retarget boundary
+		- Insert 2016 headers on top of it, optionally with a fork at the first retarget height
+		  so that the first header of the interval cannot be found by its height alone
+		- The timestamp of the last header is random so that the timespan limits are also exercised
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		msgServer, blcKeeper, sdkCtx := setupMsgServer(t)
+		ctx := sdk.UnwrapSDKContext(sdkCtx)
+		params := &chaincfg.SimNetParams
+		blocksPerRetarget := uint64(params.TargetTimespan / params.TargetTimePerBlock)
+
+		bits := datagen.GenRandomBTCHeaderBits()
+		if datagen.OneInN(4) {
+			bits = params.PowLimitBits
+		}
+		// Generate a base header with the chosen bits
+		randomHeader := datagen.GenRandomBTCHeaderInfo()
+		base := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(randomHeader, bits, randomHeader.Header.Time())
+		base.Height = blocksPerRetarget*(datagen.RandomInt(1000)+1) - 1
+		baseWork := types.CalcWork(base.Header)
+		base.Work = &baseWork
+		blcKeeper.SetBaseBTCHeader(ctx, *base)
+
+		first := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(base, bits, base.Header.Time().Add(10*time.Minute))
+		if err := blcKeeper.InsertHeader(ctx, first.Header); err != nil {
+			t.Fatalf("Header insertion failed %s", err)
+		}
+		if datagen.OneInN(2) {
+			fork := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(base, bits, base.Header.Time().Add(10*time.Minute))
+			if err := blcKeeper.InsertHeader(ctx, fork.Header); err != nil {
+				t.Fatalf("Header insertion failed %s", err)
+			}
+		}
+
+		last := first
+		for i := uint64(1); i < blocksPerRetarget-2; i++ {
+			last = datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, bits, last.Header.Time().Add(10*time.Minute))
+			if err := blcKeeper.InsertHeader(ctx, last.Header); err != nil {
+				t.Fatalf("Header insertion failed %s", err)
+			}
+		}
+		// The timespan of the interval is random, ranging up to 8 times the target timespan.
+		// The header before the last one is set to an even later time, so that the
+		// median time past of the new header does not depend on the random timespan.
+		lastTime := first.Header.Time().Add(time.Duration(datagen.RandomInt(int(8*params.TargetTimespan/time.Second))) * time.Second)
+		secondToLastTime := last.Header.Time().Add(8 * params.TargetTimespan)
+		last = datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, bits, secondToLastTime)
+		if err := blcKeeper.InsertHeader(ctx, last.Header); err != nil {
+			t.Fatalf("Header insertion failed %s", err)
+		}
+		last = datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, bits, lastTime)
+		if err := blcKeeper.InsertHeader(ctx, last.Header); err != nil {
+			t.Fatalf("Header insertion failed %s", err)
+		}
+		newTime := secondToLastTime.Add(10 * time.Minute)
+
+		// Calculate the expected bits
+		targetTimespan := int64(params.TargetTimespan / time.Second)
+		timespan := last.Header.Time().Unix() - first.Header.Time().Unix()
+		if timespan < targetTimespan/params.RetargetAdjustmentFactor {
+			timespan = targetTimespan / params.RetargetAdjustmentFactor
+		}
+		if timespan > targetTimespan*params.RetargetAdjustmentFactor {
+			timespan = targetTimespan * params.RetargetAdjustmentFactor
+		}
+		target := new(big.Int).Mul(blockchain.CompactToBig(bits), big.NewInt(timespan))
+		target.Div(target, big.NewInt(targetTimespan))
+		if target.Cmp(params.PowLimit) > 0 {
+			target = params.PowLimit
+		}
+		expectedBits := blockchain.BigToCompact(target)
+
+		if expectedBits != bits {
+			header := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, bits, newTime)
+			resp, err := msgServer.InsertHeader(sdkCtx, &types.MsgInsertHeader{Header: header.Header})
+			if resp != nil || !types.ErrInvalidDifficulty.Is(err) {
+				t.Errorf("Header with non-adjusted bits at a retarget boundary did not lead to an error")
+			}
+		}
+
+		header := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, expectedBits, newTime)
+		resp, err := msgServer.InsertHeader(sdkCtx, &types.MsgInsertHeader{Header: header.Header})
+		if err != nil || resp == nil {
+			t.Errorf("Header with the adjusted bits at a retarget boundary led to an error %s", err)
+		}
+		tipResp, err := blcKeeper.Tip(sdkCtx, &types.QueryTipRequest{})
+		if err != nil || !tipResp.Header.Eq(header) {
+			t.Errorf("Header with the adjusted bits at a retarget boundary did not become the tip")
+		}
+	})
+}
+
+func FuzzMsgServerInsertHeaders(f *testing.F) {
+	/*
+		Test that:
+		1. if the message does not contain headers, (nil, error) is returned
+		2. if the headers do not form a chain, (nil, error) is returned
+		3. if a header of the chain is invalid with respect to the previous headers of the chain, (nil, error) is returned
+		4. if all checks pass:
+			4a. all headers are inserted and a BTCHeaderInserted hook is invoked for each one of them
+			4b. if the chain has more work than the tip, its last header becomes the tip,
+			    a single roll-back hook is invoked if the chain does not extend the tip
+			    a roll-forward hook is invoked for each header added to the main chain
+			    and a single tip updated hook is invoked for the new tip
+		Building:
+		- Construct a random tree and insert into storage
+		- Select a random header from the tree and construct a chain of headers on top of it
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 100)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		msgServer, blcKeeper, sdkCtx := setupMsgServer(t)
+		ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+		// If the message does not contain headers, (nil, error) is returned.
+		resp, err := msgServer.InsertHeaders(sdkCtx, &types.MsgInsertHeaders{})
+		if resp != nil || err == nil {
+			t.Errorf("Message without headers did not return an error")
+		}
+
+		// Construct a tree and insert it into storage
+		tree := genRandomTree(blcKeeper, ctx, uint64(2), 10)
+		parentHeader := tree.RandomNode()
+
+		// Construct a chain of headers on top of the parent
+		chainLen := int(datagen.RandomInt(10)) + 2
+		chain := make([]*types.BTCHeaderInfo, 0, chainLen)
+		headers := make([]bbn.BTCHeaderBytes, 0, chainLen)
+		last := parentHeader
+		for i := 0; i < chainLen; i++ {
+			last = datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(last, last.Header.Bits(), last.Header.Time().Add(10*time.Minute))
+			chain = append(chain, last)
+			headers = append(headers, *last.Header)
+		}
+
+		// If the headers do not form a chain, (nil, error) is returned
+		idx := datagen.RandomInt(chainLen - 1)
+		unordered := make([]bbn.BTCHeaderBytes, chainLen)
+		copy(unordered, headers)
+		unordered[idx], unordered[idx+1] = unordered[idx+1], unordered[idx]
+		cacheCtx, _ := ctx.CacheContext()
+		resp, err = msgServer.InsertHeaders(sdk.WrapSDKContext(cacheCtx), &types.MsgInsertHeaders{Headers: unordered})
+		if resp != nil || err == nil {
+			t.Errorf("Headers that do not form a chain did not return an error")
+		}
+
+		// If a header of the chain has a timestamp that is not after the median time past
+		// of the previous headers of the chain, (nil, error) is returned
+		invalidLast := datagen.GenRandomBTCHeaderInfoWithParentBitsAndTime(chain[chainLen-2], last.Header.Bits(), time.Unix(0, 0))
+		invalid := make([]bbn.BTCHeaderBytes, chainLen)
+		copy(invalid, headers)
+		invalid[chainLen-1] = *invalidLast.Header
+		cacheCtx, _ = ctx.CacheContext()
+		resp, err = msgServer.InsertHeaders(sdk.WrapSDKContext(cacheCtx), &types.MsgInsertHeaders{Headers: invalid})
+		if resp != nil || !types.ErrInvalidTimestamp.Is(err) {
+			t.Errorf("Chain with an invalid header did not return an error")
+		}
+
+		// Insert the valid chain
+		mockHooks := NewMockHooks()
+		blcKeeper.SetHooks(mockHooks)
+		// The message server holds a copy of the keeper, so it needs to be recreated after setting the hooks
+		msgServer = keeper.NewMsgServerImpl(*blcKeeper)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		oldTip := blcKeeper.HeadersState(ctx).GetTip()
+		resp, err = msgServer.InsertHeaders(sdk.WrapSDKContext(ctx), &types.MsgInsertHeaders{Headers: headers})
+		if err != nil || resp == nil {
+			t.Fatalf("Valid chain of headers led to an error %s", err)
+		}
+
+		if len(mockHooks.AfterBTCHeaderInsertedStore) != chainLen {
+			t.Fatalf("Expected %d BTCHeaderInserted hooks to be invoked. Got %d", chainLen, len(mockHooks.AfterBTCHeaderInsertedStore))
+		}
+		for i, header := range chain {
+			if !blcKeeper.HeadersState(ctx).HeaderExists(header.Hash) {
+				t.Errorf("Header %d of the chain was not inserted", i)
+			}
+			if !mockHooks.AfterBTCHeaderInsertedStore[i].Eq(header) {
+				t.Errorf("The BTCHeaderInserted hook %d does not contain the header %d of the chain", i, i)
+			}
+		}
+
+		newTip := blcKeeper.HeadersState(ctx).GetTip()
+		if oldTip.Work.GT(*last.Work) {
+			if !newTip.Eq(oldTip) {
+				t.Errorf("Chain with less work than the tip changed the tip")
+			}
+			if len(mockHooks.AfterBTCRollForwardStore) != 0 || len(mockHooks.AfterBTCRollBackStore) != 0 {
+				t.Errorf("Chain with less work than the tip led to roll-forward or roll-back hooks")
+			}
+			if len(mockHooks.AfterBTCTipUpdatedStore) != 0 {
+				t.Errorf("Chain with less work than the tip led to a tip updated hook")
+			}
+			return
+		}
+		if !newTip.Eq(last) {
+			t.Fatalf("Chain with more work than the tip did not lead to its last header becoming the tip")
+		}
+		hca := blcKeeper.HeadersState(ctx).GetHighestCommonAncestor(oldTip, newTip)
+		if hca.Eq(oldTip) {
+			if len(mockHooks.AfterBTCRollBackStore) != 0 {
+				t.Errorf("Chain extending the tip led to a roll-back hook")
+			}
+		} else {
+			if len(mockHooks.AfterBTCRollBackStore) != 1 {
+				t.Fatalf("Expected a single roll-back hook. Got %d", len(mockHooks.AfterBTCRollBackStore))
+			}
+			if !mockHooks.AfterBTCRollBackStore[0].Eq(hca) {
+				t.Errorf("The roll-back hook does not contain the highest common ancestor")
+			}
+		}
+		if uint64(len(mockHooks.AfterBTCRollForwardStore)) != newTip.Height-hca.Height {
+			t.Fatalf("Expected %d roll-forward hooks. Got %d", newTip.Height-hca.Height, len(mockHooks.AfterBTCRollForwardStore))
+		}
+		if !mockHooks.AfterBTCRollForwardStore[len(mockHooks.AfterBTCRollForwardStore)-1].Eq(newTip) {
+			t.Errorf("The last roll-forward hook does not contain the new tip")
+		}
+		if len(mockHooks.AfterBTCTipUpdatedStore) != 1 {
+			t.Fatalf("Expected a single tip updated hook for the whole chain. Got %d", len(mockHooks.AfterBTCTipUpdatedStore))
+		}
+		if !mockHooks.AfterBTCTipUpdatedStore[0].Eq(newTip) {
+			t.Errorf("The tip updated hook does not contain the new tip")
 		}
-		if mul > keeper.BTCDifficultyMultiplier && err == nil {
-			t.Errorf("Invalid header work did not lead to an error")
+		rollBackName := proto.MessageName(&types.EventBTCRollBack{})
+		rollBackEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == rollBackName {
+				rollBackEvents++
+			}
 		}
-		if mul <= keeper.BTCDifficultyMultiplier && err != nil {
-			t.Errorf("Valid header work led to an error %d %s", mul, err)
+		if rollBackEvents != len(mockHooks.AfterBTCRollBackStore) {
+			t.Errorf("Expected %d roll-back events. Got %d", len(mockHooks.AfterBTCRollBackStore), rollBackEvents)
 		}
 	})
 }
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkForkDepth rejects headers that would create a fork at a height
+// that has already been considered for pruning. Such forks would
+// never become part of the main chain and would not be pruned.
+func (k Keeper) checkForkDepth(ctx sdk.Context, height uint64) error {
+	tip := k.headersState(ctx).GetTip()
+	if tip == nil {
+		return nil
+	}
+	forkRetentionDepth := k.GetParams(ctx).ForkRetentionDepth
+	if height+forkRetentionDepth <= tip.Height {
+		return types.ErrForkTooDeep.Wrapf("header height %d, tip height %d, fork retention depth %d", height, tip.Height, forkRetentionDepth)
+	}
+	return nil
+}
+
+// pruneHeaders removes the headers that are not needed anymore after the tip
+// changed from `previousTip` to `currentTip`. These are:
+//   - headers that do not belong to the main chain and are at least `ForkRetentionDepth` deep,
+//     along with their descendants
+//   - headers of the main chain that are more than `MainChainRetentionDepth` deep,
+//     if pruning of the main chain is enabled
+//
+// The oldest header of the main chain that is not pruned becomes the new base BTC header.
+// A BeforeBTCHeaderPruned hook is triggered right before each header is removed.
+func (k Keeper) pruneHeaders(ctx sdk.Context, previousTip *types.BTCHeaderInfo, currentTip *types.BTCHeaderInfo) {
+	params := k.GetParams(ctx)
+	k.pruneForks(ctx, previousTip, currentTip, params.ForkRetentionDepth)
+	if params.PruneMainChain() {
+		k.pruneMainChain(ctx, currentTip, params.MainChainRetentionDepth)
+	}
+}
+
+// pruneForks removes the headers that do not belong to the main chain
+// and have a height between the previous and the current pruning height,
+// along with all their descendants.
+// The pruning height is the height of the main chain header that is `depth` deep.
+// Headers below the previous pruning height have been pruned in a previous invocation,
+// while no new headers can be inserted there due to checkForkDepth.
+func (k Keeper) pruneForks(ctx sdk.Context, previousTip *types.BTCHeaderInfo, currentTip *types.BTCHeaderInfo, depth uint64) {
+	if currentTip.Height < depth {
+		return
+	}
+	pruneHeight := currentTip.Height - depth
+
+	// Find the first height that has not been considered in a previous invocation.
+	// The base BTC header is the only header at its height, so start at least from its children.
+	fromHeight := k.headersState(ctx).GetBaseBTCHeader().Height + 1
+	if previousTip != nil && previousTip.Height >= depth && previousTip.Height-depth+1 > fromHeight {
+		fromHeight = previousTip.Height - depth + 1
+	}
+	if fromHeight > pruneHeight {
+		return
+	}
+
+	// Collect the main chain headers in the heights of interest, in ascending height order
+	mainChain := make([]*types.BTCHeaderInfo, pruneHeight-fromHeight+1)
+	mainHeader, err := k.headersState(ctx).GetAncestorAtHeight(currentTip, pruneHeight)
+	if err != nil {
+		panic("Main chain header at the pruning height is not maintained")
+	}
+	for i := len(mainChain) - 1; i >= 0; i-- {
+		mainChain[i] = mainHeader
+		if i > 0 {
+			mainHeader, err = k.headersState(ctx).GetParent(mainHeader)
+			if err != nil {
+				panic("Parent of a main chain header is not maintained")
+			}
+		}
+	}
+
+	// Prune everything that is not on the main chain in the heights of interest
+	var pruned map[string]bool
+	for _, mainHeader := range mainChain {
+		pruned = k.pruneHeadersAtHeight(ctx, mainHeader.Height, func(header *types.BTCHeaderInfo) bool {
+			return !header.Eq(mainHeader)
+		})
+	}
+
+	// Prune the descendants of the headers that were pruned at the pruning height.
+	// Those cover the descendants of all the pruned headers, as they extend the main chain
+	// only above the pruning height and each one of them has an ancestor at it.
+	for height := pruneHeight + 1; len(pruned) > 0; height++ {
+		parents := pruned
+		pruned = k.pruneHeadersAtHeight(ctx, height, func(header *types.BTCHeaderInfo) bool {
+			return parents[header.Header.ParentHash().String()]
+		})
+	}
+}
+
+// pruneMainChain removes the headers of the main chain that are more than `depth` deep.
+// The main chain header that is `depth` deep becomes the new base BTC header.
+func (k Keeper) pruneMainChain(ctx sdk.Context, currentTip *types.BTCHeaderInfo, depth uint64) {
+	if currentTip.Height <= depth {
+		return
+	}
+	baseHeight := currentTip.Height - depth
+	oldBase := k.headersState(ctx).GetBaseBTCHeader()
+	if oldBase.Height >= baseHeight {
+		return
+	}
+
+	newBase, err := k.headersState(ctx).GetAncestorAtHeight(currentTip, baseHeight)
+	if err != nil {
+		panic("Main chain header at the new base height is not maintained")
+	}
+
+	// Collect the main chain headers below the new base, in ascending height order
+	toPrune := make([]*types.BTCHeaderInfo, baseHeight-oldBase.Height)
+	current := newBase
+	for i := len(toPrune) - 1; i >= 0; i-- {
+		current, err = k.headersState(ctx).GetParent(current)
+		if err != nil {
+			panic("Parent of a main chain header is not maintained")
+		}
+		toPrune[i] = current
+	}
+
+	for _, header := range toPrune {
+		k.pruneHeader(ctx, header)
+	}
+}
+
+// pruneHeadersAtHeight prunes the headers at `height` for which `shouldPrune` returns true
+// and returns the set of the hashes of the pruned headers
+func (k Keeper) pruneHeadersAtHeight(ctx sdk.Context, height uint64, shouldPrune func(*types.BTCHeaderInfo) bool) map[string]bool {
+	// Collect the headers before deleting them, as the storage cannot be modified while iterating
+	var toPrune []*types.BTCHeaderInfo
+	k.headersState(ctx).HeadersByHeight(height, func(header *types.BTCHeaderInfo) bool {
+		if shouldPrune(header) {
+			toPrune = append(toPrune, header)
+		}
+		return false
+	})
+
+	pruned := make(map[string]bool, len(toPrune))
+	for _, header := range toPrune {
+		k.pruneHeader(ctx, header)
+		pruned[header.Hash.String()] = true
+	}
+	return pruned
+}
+
+// pruneHeader triggers the header pruned hook and event and then removes the header
+func (k Keeper) pruneHeader(ctx sdk.Context, header *types.BTCHeaderInfo) {
+	k.triggerHeaderPruned(ctx, header)
+	k.headersState(ctx).DeleteHeader(header)
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func FuzzKeeperPruneForks(f *testing.F) {
+	/*
+		Checks:
+		1. headers that do not belong to the main chain and fork from a header
+		   that is at least `ForkRetentionDepth` deep are pruned along with their descendants
+		2. a BeforeBTCHeaderPruned hook is invoked for each one of the pruned headers
+		3. all the other headers are maintained and the base BTC header does not change
+		4. inserting a header that forks deeper than `ForkRetentionDepth` leads to an ErrForkTooDeep error
+
+		Data generation:
+		- Set a random fork retention depth.
+		- Build a main chain header by header. All headers have the same difficulty,
+		  so that headers that do not extend the tip do not change it.
+		- After each main chain header, randomly create a fork from a recent main chain header
+		  or extend a previously created fork header.
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 20)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		blcKeeper, ctx := testkeeper.BTCLightClientKeeper(t)
+		forkRetentionDepth := datagen.RandomInt(5) + 2
+		blcKeeper.SetParams(ctx, types.NewParams(forkRetentionDepth, 0))
+		mockHooks := NewMockHooks()
+		blcKeeper.SetHooks(mockHooks)
+
+		bits := sdk.NewUint(datagen.RandomInt(1000000) + 1)
+		base := datagen.GenRandomBTCHeaderInfoWithBits(&bits)
+		base.Height = datagen.RandomInt(1000000)
+		blcKeeper.SetBaseBTCHeader(ctx, *base)
+
+		mainChain := []*types.BTCHeaderInfo{base}
+		var forkHeaders []*types.BTCHeaderInfo
+		// The height of the first fork header on the branch of each fork header
+		forkRootHeight := make(map[string]uint64)
+
+		chainLen := int(datagen.RandomInt(30)) + 10
+		for i := 0; i < chainLen; i++ {
+			tip := mainChain[len(mainChain)-1]
+			header := datagen.GenRandomBTCHeaderInfoWithParentAndBits(tip, &bits)
+			if err := blcKeeper.InsertHeader(ctx, header.Header); err != nil {
+				t.Fatalf("Header extending the tip led to an error %s", err)
+			}
+			mainChain = append(mainChain, header)
+
+			if datagen.OneInN(2) && len(mainChain) > 2 {
+				// Fork from a main chain header that is not the tip and is not too deep
+				maxForkDepth := uint64(len(mainChain) - 1)
+				if maxForkDepth > forkRetentionDepth {
+					maxForkDepth = forkRetentionDepth
+				}
+				parent := mainChain[len(mainChain)-1-int(datagen.RandomInt(int(maxForkDepth))+1)]
+				fork := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parent, &bits)
+				if err := blcKeeper.InsertHeader(ctx, fork.Header); err != nil {
+					t.Fatalf("Header forking from a shallow header led to an error %s", err)
+				}
+				forkHeaders = append(forkHeaders, fork)
+				forkRootHeight[fork.Hash.String()] = fork.Height
+			}
+			if datagen.OneInN(2) && len(forkHeaders) > 0 {
+				// Extend a fork header that has not been pruned and is lower than the tip,
+				// so that its child does not have more work than the tip
+				parent := forkHeaders[datagen.RandomInt(len(forkHeaders))]
+				if parent.Height < header.Height && blcKeeper.HeadersState(ctx).HeaderExists(parent.Hash) {
+					fork := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parent, &bits)
+					if err := blcKeeper.InsertHeader(ctx, fork.Header); err != nil {
+						t.Fatalf("Header extending a fork header led to an error %s", err)
+					}
+					forkHeaders = append(forkHeaders, fork)
+					forkRootHeight[fork.Hash.String()] = forkRootHeight[parent.Hash.String()]
+				}
+			}
+		}
+
+		tip := blcKeeper.HeadersState(ctx).GetTip()
+		if !tip.Eq(mainChain[len(mainChain)-1]) {
+			t.Fatalf("Fork headers changed the tip")
+		}
+		if !blcKeeper.GetBaseBTCHeader(ctx).Eq(base) {
+			t.Errorf("Pruning of forks changed the base BTC header")
+		}
+
+		for _, header := range mainChain {
+			if !blcKeeper.HeadersState(ctx).HeaderExists(header.Hash) {
+				t.Errorf("Main chain header at height %d was pruned", header.Height)
+			}
+		}
+
+		prunedHooks := make(map[string]bool)
+		for _, header := range mockHooks.BeforeBTCHeaderPrunedStore {
+			prunedHooks[header.Hash.String()] = true
+		}
+		if len(prunedHooks) != len(mockHooks.BeforeBTCHeaderPrunedStore) {
+			t.Errorf("A BeforeBTCHeaderPruned hook was invoked more than once for the same header")
+		}
+		expectedPruned := 0
+		for _, header := range forkHeaders {
+			shouldBePruned := forkRootHeight[header.Hash.String()]+forkRetentionDepth <= tip.Height
+			exists := blcKeeper.HeadersState(ctx).HeaderExists(header.Hash)
+			if shouldBePruned {
+				expectedPruned++
+				if exists {
+					t.Errorf("Fork header at height %d forking at height %d was not pruned", header.Height, forkRootHeight[header.Hash.String()])
+				}
+				if !prunedHooks[header.Hash.String()] {
+					t.Errorf("No BeforeBTCHeaderPruned hook was invoked for a pruned header")
+				}
+			} else if !exists {
+				t.Errorf("Fork header at height %d forking at height %d was pruned", header.Height, forkRootHeight[header.Hash.String()])
+			}
+		}
+		if expectedPruned != len(mockHooks.BeforeBTCHeaderPrunedStore) {
+			t.Errorf("Expected %d BeforeBTCHeaderPruned hooks to be invoked. Got %d", expectedPruned, len(mockHooks.BeforeBTCHeaderPrunedStore))
+		}
+
+		// Headers that fork from a header that is deeper than the fork retention depth are rejected
+		if uint64(len(mainChain)) > forkRetentionDepth+1 {
+			parent := mainChain[len(mainChain)-2-int(forkRetentionDepth)]
+			deepFork := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parent, &bits)
+			err := blcKeeper.InsertHeader(ctx, deepFork.Header)
+			if !types.ErrForkTooDeep.Is(err) {
+				t.Errorf("Header forking deeper than the fork retention depth did not lead to an ErrForkTooDeep error")
+			}
+		}
+	})
+}
+
+func FuzzKeeperPruneMainChain(f *testing.F) {
+	/*
+		Checks:
+		1. main chain headers that are more than `MainChainRetentionDepth` deep are pruned
+		2. a BeforeBTCHeaderPruned hook is invoked for each one of them in ascending height order
+		3. the main chain header that is `MainChainRetentionDepth` deep becomes the base BTC header
+
+		Data generation:
+		- Build a main chain that is longer than the minimum main chain retention depth.
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		blcKeeper, ctx := testkeeper.BTCLightClientKeeper(t)
+		depth := types.MinMainChainRetentionDepth + datagen.RandomInt(10)
+		blcKeeper.SetParams(ctx, types.NewParams(types.DefaultForkRetentionDepth, depth))
+		mockHooks := NewMockHooks()
+		blcKeeper.SetHooks(mockHooks)
+
+		base := datagen.GenRandomBTCHeaderInfo()
+		base.Height = datagen.RandomInt(1000000)
+		blcKeeper.SetBaseBTCHeader(ctx, *base)
+
+		mainChain := []*types.BTCHeaderInfo{base}
+		chainLen := int(depth + datagen.RandomInt(20))
+		for i := 0; i < chainLen; i++ {
+			header := datagen.GenRandomBTCHeaderInfoWithParent(mainChain[len(mainChain)-1])
+			if err := blcKeeper.InsertHeader(ctx, header.Header); err != nil {
+				t.Fatalf("Header extending the tip led to an error %s", err)
+			}
+			mainChain = append(mainChain, header)
+		}
+
+		tip := blcKeeper.HeadersState(ctx).GetTip()
+		if !tip.Eq(mainChain[len(mainChain)-1]) {
+			t.Fatalf("The last header of the main chain is not the tip")
+		}
+		prunedNum := len(mainChain) - 1 - int(depth)
+		if prunedNum < 0 {
+			prunedNum = 0
+		}
+		if !blcKeeper.GetBaseBTCHeader(ctx).Eq(mainChain[prunedNum]) {
+			t.Errorf("The main chain header that is %d deep did not become the base BTC header", depth)
+		}
+		if len(mockHooks.BeforeBTCHeaderPrunedStore) != prunedNum {
+			t.Fatalf("Expected %d BeforeBTCHeaderPruned hooks to be invoked. Got %d", prunedNum, len(mockHooks.BeforeBTCHeaderPrunedStore))
+		}
+		for i, header := range mainChain {
+			exists := blcKeeper.HeadersState(ctx).HeaderExists(header.Hash)
+			if i < prunedNum {
+				if exists {
+					t.Errorf("Main chain header at height %d was not pruned", header.Height)
+				}
+				if !mockHooks.BeforeBTCHeaderPrunedStore[i].Eq(header) {
+					t.Errorf("The BeforeBTCHeaderPruned hook %d does not contain the main chain header at height %d", i, header.Height)
+				}
+			} else if !exists {
+				t.Errorf("Main chain header at height %d was pruned", header.Height)
+			}
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -56,6 +58,17 @@ func (s headersState) CreateHeader(headerInfo *types.BTCHeaderInfo) {
 	s.updateLongestChain(headerInfo)
 }
 
+// DeleteHeader removes the header from the following storages:
+// - hash->height
+// - hash->work
+// - (height, hash)->header storage
+// The caller is responsible for not deleting the tip or headers that have maintained descendants.
+func (s headersState) DeleteHeader(headerInfo *types.BTCHeaderInfo) {
+	s.headers.Delete(types.HeadersObjectKey(headerInfo.Height, headerInfo.Hash))
+	s.hashToHeight.Delete(types.HeadersObjectHeightKey(headerInfo.Hash))
+	s.hashToWork.Delete(types.HeadersObjectWorkKey(headerInfo.Hash))
+}
+
 // CreateTip sets the provided header as the tip
 func (s headersState) CreateTip(headerInfo *types.BTCHeaderInfo) {
 	// Retrieve the key for the tip storage
@@ -123,16 +136,85 @@ func (s headersState) GetHeaderByHash(hash *bbn.BTCHeaderHashBytes) (*types.BTCH
 	return s.GetHeader(height, hash)
 }
 
+// GetParent retrieves the parent of the provided header
+func (s headersState) GetParent(header *types.BTCHeaderInfo) (*types.BTCHeaderInfo, error) {
+	if header.Height == 0 {
+		return nil, types.ErrHeaderDoesNotExist.Wrap("header at height 0 has no parent")
+	}
+	// The height of the parent is known, so we can directly access
+	// the (height, hash) storage without looking up the height of the parent
+	return s.GetHeader(header.Height-1, header.Header.ParentHash())
+}
+
+// GetAncestorTimestamps returns the timestamps of the provided header and its ancestors,
+// starting from the header and going back until `num` timestamps have been collected
+// or the base BTC header has been reached.
+func (s headersState) GetAncestorTimestamps(header *types.BTCHeaderInfo, num uint64) []time.Time {
+	timestamps := make([]time.Time, 0, num)
+	current := header
+	for uint64(len(timestamps)) < num {
+		timestamps = append(timestamps, current.Header.Time())
+		parent, err := s.GetParent(current)
+		if err != nil {
+			// The base BTC header has been reached
+			break
+		}
+		current = parent
+	}
+	return timestamps
+}
+
+// GetAncestorAtHeight retrieves the ancestor of the provided header that has the provided height.
+// If the ancestor is older than the base BTC header, an error is returned.
+func (s headersState) GetAncestorAtHeight(header *types.BTCHeaderInfo, height uint64) (*types.BTCHeaderInfo, error) {
+	if height > header.Height {
+		return nil, types.ErrHeaderDoesNotExist.Wrap("ancestor height is higher than the header height")
+	}
+	if height == header.Height {
+		return header, nil
+	}
+
+	// Every maintained header descends from the base BTC header.
+	// As a result, if there is a single header at the requested height,
+	// then it is the ancestor that we are looking for and we can avoid
+	// traversing the ancestry one header at a time.
+	var candidates []*types.BTCHeaderInfo
+	s.HeadersByHeight(height, func(candidate *types.BTCHeaderInfo) bool {
+		candidates = append(candidates, candidate)
+		return len(candidates) > 1
+	})
+	if len(candidates) == 0 {
+		return nil, types.ErrHeaderDoesNotExist.Wrapf("no header at height %d", height)
+	}
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	// There is a fork at the requested height, follow the parent links
+	ancestor := header
+	for ancestor.Height > height {
+		parent, err := s.GetParent(ancestor)
+		if err != nil {
+			return nil, err
+		}
+		ancestor = parent
+	}
+	return ancestor, nil
+}
+
 // GetBaseBTCHeader retrieves the BTC header with the minimum height
 func (s headersState) GetBaseBTCHeader() *types.BTCHeaderInfo {
-	// Retrieve the canonical chain
-	canonicalChain := s.GetMainChain()
-	// If the canonical chain is empty, then there is no base header
-	if len(canonicalChain) == 0 {
+	// All maintained headers descend from the base BTC header,
+	// which is the only header with the minimum height.
+	// The headers storage is keyed by (height, hash), so it is the first entry.
+	iter := s.headers.Iterator(nil, nil)
+	defer iter.Close()
+
+	// If the storage is empty, then there is no base header
+	if !iter.Valid() {
 		return nil
 	}
-	// The base btc header is the oldest one from the canonical chain
-	return canonicalChain[len(canonicalChain)-1]
+	return headerInfoFromStoredBytes(s.cdc, iter.Value())
 }
 
 // GetTip returns the tip of the canonical chain
@@ -364,3 +446,17 @@ func (s headersState) iterateReverseHeaders(fn func(*types.BTCHeaderInfo) bool)
 		}
 	}
 }
+
+func (s headersState) iterateHeaders(fn func(*types.BTCHeaderInfo) bool) {
+	// Iterate it in ascending order of heights
+	iter := s.headers.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		header := headerInfoFromStoredBytes(s.cdc, iter.Value())
+		stop := fn(header)
+		if stop {
+			break
+		}
+	}
+}
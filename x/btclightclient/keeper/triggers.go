@@ -25,3 +25,10 @@ func (k Keeper) triggerRollForward(ctx sdk.Context, headerInfo *types.BTCHeaderI
 	// Emit BTCRollForward event
 	ctx.EventManager().EmitTypedEvent(&types.EventBTCRollForward{Header: headerInfo})
 }
+
+func (k Keeper) triggerHeaderPruned(ctx sdk.Context, headerInfo *types.BTCHeaderInfo) {
+	// Trigger BeforeBTCHeaderPruned hook
+	k.BeforeBTCHeaderPruned(ctx, headerInfo)
+	// Emit BTCHeaderPruned event
+	ctx.EventManager().EmitTypedEvent(&types.EventBTCHeaderPruned{Header: headerInfo})
+}
@@ -17,16 +17,22 @@ type MockHooks struct {
 	AfterBTCRollForwardStore    []*types.BTCHeaderInfo
 	AfterBTCRollBackStore       []*types.BTCHeaderInfo
 	AfterBTCHeaderInsertedStore []*types.BTCHeaderInfo
+	AfterBTCTipUpdatedStore     []*types.BTCHeaderInfo
+	BeforeBTCHeaderPrunedStore  []*types.BTCHeaderInfo
 }
 
 func NewMockHooks() *MockHooks {
 	rollForwardStore := make([]*types.BTCHeaderInfo, 0)
 	rollBackwardStore := make([]*types.BTCHeaderInfo, 0)
 	headerInsertedStore := make([]*types.BTCHeaderInfo, 0)
+	tipUpdatedStore := make([]*types.BTCHeaderInfo, 0)
+	headerPrunedStore := make([]*types.BTCHeaderInfo, 0)
 	return &MockHooks{
 		AfterBTCRollForwardStore:    rollForwardStore,
 		AfterBTCRollBackStore:       rollBackwardStore,
 		AfterBTCHeaderInsertedStore: headerInsertedStore,
+		AfterBTCTipUpdatedStore:     tipUpdatedStore,
+		BeforeBTCHeaderPrunedStore:  headerPrunedStore,
 	}
 }
 
@@ -42,6 +48,14 @@ func (m *MockHooks) AfterBTCHeaderInserted(_ sdk.Context, headerInfo *types.BTCH
 	m.AfterBTCHeaderInsertedStore = append(m.AfterBTCHeaderInsertedStore, headerInfo)
 }
 
+func (m *MockHooks) AfterBTCTipUpdated(_ sdk.Context, headerInfo *types.BTCHeaderInfo) {
+	m.AfterBTCTipUpdatedStore = append(m.AfterBTCTipUpdatedStore, headerInfo)
+}
+
+func (m *MockHooks) BeforeBTCHeaderPruned(_ sdk.Context, headerInfo *types.BTCHeaderInfo) {
+	m.BeforeBTCHeaderPrunedStore = append(m.BeforeBTCHeaderPrunedStore, headerInfo)
+}
+
 // Methods for generating trees
 
 // genRandomTree generates a tree of headers. It accomplishes this by generating a root
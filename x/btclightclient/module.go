@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/client/cli"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
@@ -68,7 +69,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
-	return genState.Validate()
+	if err := genState.Validate(); err != nil {
+		return err
+	}
+	// The base BTC header should belong to the bitcoin network the node is configured with
+	return genState.ValidateBaseBTCHeader(bbn.GetGlobalBtcNetParams())
 }
 
 // RegisterRESTRoutes registers the capability module's REST service handlers.
@@ -15,6 +15,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
     // Register messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgInsertHeader{},
+		&MsgInsertHeaders{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
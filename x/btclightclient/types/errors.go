@@ -13,4 +13,6 @@ var (
 	ErrHeaderParentDoesNotExist = sdkerrors.Register(ModuleName, 1102, "header parent does not exist")
 	ErrInvalidDifficulty        = sdkerrors.Register(ModuleName, 1103, "invalid difficulty bits")
 	ErrEmptyMessage             = sdkerrors.Register(ModuleName, 1104, "empty message provided")
+	ErrInvalidTimestamp         = sdkerrors.Register(ModuleName, 1105, "invalid header timestamp")
+	ErrForkTooDeep              = sdkerrors.Register(ModuleName, 1106, "header forks deeper than the fork retention depth")
 )
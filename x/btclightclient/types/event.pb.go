@@ -166,10 +166,59 @@ func (m *EventBTCHeaderInserted) GetHeader() *BTCHeaderInfo {
 	return nil
 }
 
+// EventBTCHeaderPruned is emitted when a header is removed from the
+// on chain BTC storage, either because it belongs to a fork that is
+// deeper than the fork retention depth or because it belongs to the
+// main chain and is deeper than the main chain retention depth.
+type EventBTCHeaderPruned struct {
+	Header *BTCHeaderInfo `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *EventBTCHeaderPruned) Reset()         { *m = EventBTCHeaderPruned{} }
+func (m *EventBTCHeaderPruned) String() string { return proto.CompactTextString(m) }
+func (*EventBTCHeaderPruned) ProtoMessage()    {}
+func (*EventBTCHeaderPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbeb7d7d6407e7ec, []int{3}
+}
+func (m *EventBTCHeaderPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCHeaderPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCHeaderPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCHeaderPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCHeaderPruned.Merge(m, src)
+}
+func (m *EventBTCHeaderPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCHeaderPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCHeaderPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCHeaderPruned proto.InternalMessageInfo
+
+func (m *EventBTCHeaderPruned) GetHeader() *BTCHeaderInfo {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventBTCRollBack)(nil), "babylon.btclightclient.v1.EventBTCRollBack")
 	proto.RegisterType((*EventBTCRollForward)(nil), "babylon.btclightclient.v1.EventBTCRollForward")
 	proto.RegisterType((*EventBTCHeaderInserted)(nil), "babylon.btclightclient.v1.EventBTCHeaderInserted")
+	proto.RegisterType((*EventBTCHeaderPruned)(nil), "babylon.btclightclient.v1.EventBTCHeaderPruned")
 }
 
 func init() {
@@ -177,7 +226,7 @@ func init() {
 }
 
 var fileDescriptor_dbeb7d7d6407e7ec = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
+	// 249 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0x35,
@@ -187,12 +236,13 @@ var fileDescriptor_dbeb7d7d6407e7ec = []byte{
 	0xc5, 0x96, 0x91, 0x9a, 0x98, 0x92, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa1,
 	0x87, 0xd3, 0x09, 0x7a, 0x4e, 0x21, 0xce, 0x1e, 0x60, 0xb5, 0x9e, 0x79, 0x69, 0xf9, 0x41, 0x50,
 	0x7d, 0x4a, 0xe1, 0x5c, 0xc2, 0xc8, 0xa6, 0xba, 0xe5, 0x17, 0x95, 0x27, 0x16, 0xa5, 0x50, 0xc1,
-	0xe0, 0x28, 0x2e, 0x31, 0x98, 0xc1, 0x30, 0xd9, 0xe2, 0xd4, 0xa2, 0x92, 0x54, 0x2a, 0x98, 0xed,
-	0x14, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x53, 0x93, 0x33, 0x12, 0x33, 0xf3, 0x60,
-	0x1c, 0xfd, 0x0a, 0xf4, 0xb0, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0xb1, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x90, 0xd1, 0x66, 0xcc, 0xe7, 0x01, 0x00, 0x00,
+	0xe0, 0x28, 0x2e, 0x31, 0x98, 0xc1, 0x30, 0xd9, 0xe2, 0xd4, 0xa2, 0x92, 0x54, 0x6a, 0x98, 0x1d,
+	0xc1, 0x25, 0x82, 0x6a, 0x76, 0x40, 0x51, 0x69, 0x1e, 0x35, 0x4c, 0x76, 0x0a, 0x38, 0xf1, 0x48,
+	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
+	0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0xa9, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0x7a,
+	0x2c, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xcf, 0x18, 0x10, 0x00, 0x00, 0xff,
+	0xff, 0x71, 0x71, 0xc7, 0xf2, 0x41, 0x02, 0x00, 0x00,
 }
 
 func (m *EventBTCRollBack) Marshal() (dAtA []byte, err error) {
@@ -300,6 +350,41 @@ func (m *EventBTCHeaderInserted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBTCHeaderPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCHeaderPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCHeaderPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -350,6 +435,19 @@ func (m *EventBTCHeaderInserted) Size() (n int) {
 	return n
 }
 
+func (m *EventBTCHeaderPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -614,6 +712,92 @@ func (m *EventBTCHeaderInserted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBTCHeaderPruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCHeaderPruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCHeaderPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfo{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,5 +20,7 @@ type BankKeeper interface {
 type BTCLightClientHooks interface {
 	AfterBTCRollBack(ctx sdk.Context, headerInfo *BTCHeaderInfo)       // Must be called after the chain is rolled back
 	AfterBTCRollForward(ctx sdk.Context, headerInfo *BTCHeaderInfo)    // Must be called after the chain is rolled forward
+	AfterBTCTipUpdated(ctx sdk.Context, headerInfo *BTCHeaderInfo)     // Must be called once after the tip is updated by an insertion
 	AfterBTCHeaderInserted(ctx sdk.Context, headerInfo *BTCHeaderInfo) // Must be called after a header is inserted
+	BeforeBTCHeaderPruned(ctx sdk.Context, headerInfo *BTCHeaderInfo)  // Must be called before a header is pruned
 }
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	bbn "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/chaincfg"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -35,5 +38,101 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+
+	return gs.validateHeaders()
+}
+
+// validateHeaders checks that the headers form a tree rooted at the base BTC header:
+// - each header extends the base BTC header or a header that precedes it in the list
+// - the hash, height and cumulative work of each header are consistent with its parent
+// - the tip, if provided, is a maintained header with the most cumulative work
+func (gs GenesisState) validateHeaders() error {
+	if len(gs.Headers) == 0 && gs.TipHash == nil {
+		return nil
+	}
+
+	baseHeader := gs.BaseBtcHeader
+	if baseHeader.Header == nil || baseHeader.Hash == nil || baseHeader.Work == nil {
+		return fmt.Errorf("base BTC header, hash and work should be provided along with the headers")
+	}
+
+	headers := map[string]BTCHeaderInfo{baseHeader.Hash.String(): baseHeader}
+	maxWork := *baseHeader.Work
+	for i, header := range gs.Headers {
+		if header.Header == nil || header.Hash == nil || header.Work == nil {
+			return fmt.Errorf("header %d: header, hash and work should be provided", i)
+		}
+		hash := header.Header.Hash()
+		if !hash.Eq(header.Hash) {
+			return fmt.Errorf("header %d: hash %s does not match the header bytes hash %s", i, header.Hash, hash)
+		}
+		if _, ok := headers[hash.String()]; ok {
+			return fmt.Errorf("header %d: duplicate header %s", i, hash)
+		}
+		parent, ok := headers[header.Header.ParentHash().String()]
+		if !ok {
+			return fmt.Errorf("header %d: parent of header %s is neither the base BTC header nor a preceding header", i, hash)
+		}
+		if header.Height != parent.Height+1 {
+			return fmt.Errorf("header %d: height %d does not follow the height %d of its parent", i, header.Height, parent.Height)
+		}
+		work := CumulativeWork(CalcWork(header.Header), *parent.Work)
+		if !header.Work.Equal(work) {
+			return fmt.Errorf("header %d: cumulative work %s does not match the expected cumulative work %s", i, header.Work, work)
+		}
+		headers[hash.String()] = header
+		if work.GT(maxWork) {
+			maxWork = work
+		}
+	}
+
+	if gs.TipHash != nil {
+		tip, ok := headers[gs.TipHash.String()]
+		if !ok {
+			return fmt.Errorf("tip %s is not maintained", gs.TipHash)
+		}
+		if tip.Work.LT(maxWork) {
+			return fmt.Errorf("tip %s does not have the most cumulative work", gs.TipHash)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBaseBTCHeader checks that the base BTC header is a valid header of the provided bitcoin network:
+// - the hash and the cumulative work are consistent with the header bytes
+// - the header has enough proof of work for the network
+// - the header matches the genesis block or the checkpoint of the network at the same height, if any
+func (gs GenesisState) ValidateBaseBTCHeader(btcParams *chaincfg.Params) error {
+	baseHeader := gs.BaseBtcHeader
+	if baseHeader.Header == nil || baseHeader.Hash == nil || baseHeader.Work == nil {
+		return fmt.Errorf("base BTC header, hash and work should be provided")
+	}
+
+	hash := baseHeader.Header.Hash()
+	if !hash.Eq(baseHeader.Hash) {
+		return fmt.Errorf("base BTC header hash %s does not match the header bytes hash %s", baseHeader.Hash, hash)
+	}
+
+	// The cumulative work includes at least the work of the header itself
+	headerWork := CalcWork(baseHeader.Header)
+	if baseHeader.Work.LT(headerWork) {
+		return fmt.Errorf("base BTC header cumulative work %s is less than the header work %s", baseHeader.Work, headerWork)
+	}
+
+	err := bbn.ValidateBTCHeader(baseHeader.Header.ToBlockHeader(), btcParams.PowLimit)
+	if err != nil {
+		return fmt.Errorf("base BTC header is not valid for the %s network: %w", btcParams.Name, err)
+	}
+
+	if baseHeader.Height == 0 && !hash.ToChainhash().IsEqual(btcParams.GenesisHash) {
+		return fmt.Errorf("base BTC header at height 0 is not the genesis block %s of the %s network", btcParams.GenesisHash, btcParams.Name)
+	}
+	for _, checkpoint := range btcParams.Checkpoints {
+		if uint64(checkpoint.Height) == baseHeader.Height && !hash.ToChainhash().IsEqual(checkpoint.Hash) {
+			return fmt.Errorf("base BTC header at height %d does not match the checkpoint %s of the %s network", baseHeader.Height, checkpoint.Hash, btcParams.Name)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params        Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BaseBtcHeader BTCHeaderInfo `protobuf:"bytes,2,opt,name=base_btc_header,json=baseBtcHeader,proto3" json:"base_btc_header"`
+	// headers are all the maintained headers apart from the base BTC header,
+	// in ascending height order. Each header should extend either the
+	// base BTC header or a header that precedes it.
+	Headers []BTCHeaderInfo `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers"`
+	// tip_hash is the hash of the tip of the main chain.
+	// If it is not provided, the header with the most cumulative work
+	// that comes first in the list of headers becomes the tip.
+	TipHash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,4,opt,name=tip_hash,json=tipHash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"tip_hash,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +85,13 @@ func (m *GenesisState) GetBaseBtcHeader() BTCHeaderInfo {
 	return BTCHeaderInfo{}
 }
 
+func (m *GenesisState) GetHeaders() []BTCHeaderInfo {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btclightclient.v1.GenesisState")
 }
@@ -85,24 +101,27 @@ func init() {
 }
 
 var fileDescriptor_723ed9409b965050 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
+	// 319 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92,
 	0x50, 0x55, 0x7a, 0xa8, 0xaa, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa,
 	0xf4, 0x41, 0x2c, 0x88, 0x06, 0x29, 0x65, 0x1c, 0xc6, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x4d,
-	0x95, 0xd2, 0xc6, 0xa1, 0x08, 0xcd, 0x12, 0xb0, 0x62, 0xa5, 0xe5, 0x8c, 0x5c, 0x3c, 0xee, 0x10,
+	0x95, 0xd2, 0xc6, 0xa1, 0x08, 0xcd, 0x12, 0xb0, 0x62, 0xa5, 0x53, 0x4c, 0x5c, 0x3c, 0xee, 0x10,
 	0x47, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xd9, 0x73, 0xb1, 0x41, 0x4c, 0x93, 0x60, 0x54, 0x60,
 	0xd4, 0xe0, 0x36, 0x52, 0xd4, 0xc3, 0xe9, 0x48, 0xbd, 0x00, 0xb0, 0x42, 0x27, 0x96, 0x13, 0xf7,
 	0xe4, 0x19, 0x82, 0xa0, 0xda, 0x84, 0xc2, 0xb8, 0xf8, 0x93, 0x12, 0x8b, 0x53, 0xe3, 0x93, 0x4a,
 	0x92, 0xe3, 0x33, 0x52, 0x13, 0x53, 0x52, 0x8b, 0x24, 0x98, 0xc0, 0x26, 0x69, 0xe0, 0x31, 0xc9,
 	0x29, 0xc4, 0xd9, 0x03, 0xac, 0xd6, 0x33, 0x2f, 0x2d, 0x1f, 0x6a, 0x20, 0x2f, 0xc8, 0x18, 0xa7,
-	0x92, 0x64, 0x88, 0x84, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xad, 0x48, 0xce,
-	0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0xd0, 0x83, 0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0x04, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd6, 0xaf, 0x9a, 0xad, 0x01,
-	0x00, 0x00,
+	0x92, 0x64, 0x88, 0x84, 0x90, 0x07, 0x17, 0x3b, 0xc4, 0xb8, 0x62, 0x09, 0x66, 0x05, 0x66, 0x32,
+	0xcc, 0x83, 0x69, 0x17, 0x0a, 0xe7, 0xe2, 0x28, 0xc9, 0x2c, 0x88, 0xcf, 0x48, 0x2c, 0xce, 0x90,
+	0x60, 0x51, 0x60, 0xd4, 0xe0, 0x71, 0xb2, 0xb9, 0x75, 0x4f, 0xde, 0x22, 0x3d, 0xb3, 0x24, 0xa3,
+	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0x70, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3,
+	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x8c, 0x30, 0xd9, 0x23, 0xb1, 0x38, 0xc3, 0xa9, 0xb2, 0x24, 0xb5,
+	0x38, 0x88, 0xbd, 0x24, 0xb3, 0x00, 0xcc, 0x0b, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
+	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
+	0x39, 0x86, 0x28, 0x33, 0x42, 0x86, 0x57, 0xa0, 0xc7, 0x16, 0xd8, 0xb6, 0x24, 0x36, 0x70, 0x2c,
+	0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x27, 0x8a, 0xd2, 0x30, 0x50, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +144,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TipHash != nil {
+		{
+			size := m.TipHash.Size()
+			i -= size
+			if _, err := m.TipHash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.BaseBtcHeader.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -169,6 +214,16 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.BaseBtcHeader.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TipHash != nil {
+		l = m.TipHash.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -273,6 +328,75 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, BTCHeaderInfo{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.TipHash = &v
+			if err := m.TipHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,7 +3,11 @@ package types_test
 import (
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,10 +23,166 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					ForkRetentionDepth:      100,
+					MainChainRetentionDepth: 5000,
+				},
+			},
+			valid: true,
+		},
+		{
+			desc:     "invalid fork retention depth",
 			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "main chain retention depth smaller than fork retention depth",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					ForkRetentionDepth:      3000,
+					MainChainRetentionDepth: 2500,
+				},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisState_ValidateBaseBTCHeader(t *testing.T) {
+	defaultGenesis := types.DefaultGenesis()
+	wrongHash := *defaultGenesis
+	wrongHash.BaseBtcHeader.Hash = datagen.GenRandomBTCHeaderPrevBlock()
+	wrongHeight := *defaultGenesis
+	wrongHeight.BaseBtcHeader.Height = 0
+
+	for _, tc := range []struct {
+		desc      string
+		genState  *types.GenesisState
+		btcParams *chaincfg.Params
+		valid     bool
+	}{
+		{
+			desc:      "default is valid for mainnet",
+			genState:  defaultGenesis,
+			btcParams: &chaincfg.MainNetParams,
+			valid:     true,
+		},
+		{
+			desc:      "missing base header",
+			genState:  &types.GenesisState{},
+			btcParams: &chaincfg.MainNetParams,
+			valid:     false,
+		},
+		{
+			desc:      "hash does not match the header",
+			genState:  &wrongHash,
+			btcParams: &chaincfg.MainNetParams,
+			valid:     false,
+		},
+		{
+			desc:      "height 0 but not the genesis block",
+			genState:  &wrongHeight,
+			btcParams: &chaincfg.MainNetParams,
+			valid:     false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.ValidateBaseBTCHeader(tc.btcParams)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisState_ValidateHeaders(t *testing.T) {
+	// All headers have the same difficulty, so that the deepest header has the most work
+	bits := sdk.NewUint(datagen.RandomInt(1000000) + 1)
+	base := datagen.GenRandomBTCHeaderInfoWithBits(&bits)
+	child1 := datagen.GenRandomBTCHeaderInfoWithParentAndBits(base, &bits)
+	child2 := datagen.GenRandomBTCHeaderInfoWithParentAndBits(base, &bits)
+	grandchild := datagen.GenRandomBTCHeaderInfoWithParentAndBits(child1, &bits)
+	wrongHeight := *grandchild
+	wrongHeight.Height++
+	wrongWork := *grandchild
+	work := grandchild.Work.AddUint64(1)
+	wrongWork.Work = &work
+
+	newGenState := func(tipHash *bbn.BTCHeaderHashBytes, headers ...*types.BTCHeaderInfo) *types.GenesisState {
+		genState := &types.GenesisState{
+			Params:        types.DefaultParams(),
+			BaseBtcHeader: *base,
+			TipHash:       tipHash,
+		}
+		for _, header := range headers {
+			genState.Headers = append(genState.Headers, *header)
+		}
+		return genState
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "tree of headers with the tip",
+			genState: newGenState(grandchild.Hash, child1, child2, grandchild),
 			valid:    true,
 		},
+		{
+			desc:     "tree of headers without the tip",
+			genState: newGenState(nil, child2, child1, grandchild),
+			valid:    true,
+		},
+		{
+			desc:     "base BTC header as the tip",
+			genState: newGenState(base.Hash),
+			valid:    true,
+		},
+		{
+			desc:     "header before its parent",
+			genState: newGenState(nil, grandchild, child1),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate header",
+			genState: newGenState(nil, child1, child1),
+			valid:    false,
+		},
+		{
+			desc:     "height does not follow the parent",
+			genState: newGenState(nil, child1, &wrongHeight),
+			valid:    false,
+		},
+		{
+			desc:     "work does not follow the parent",
+			genState: newGenState(nil, child1, &wrongWork),
+			valid:    false,
+		},
+		{
+			desc:     "tip without the most work",
+			genState: newGenState(child2.Hash, child1, child2, grandchild),
+			valid:    false,
+		},
+		{
+			desc:     "tip is not maintained",
+			genState: newGenState(grandchild.Hash, child1, child2),
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -29,3 +29,15 @@ func (h MultiBTCLightClientHooks) AfterBTCRollForward(ctx sdk.Context, headerInf
 		h[i].AfterBTCRollForward(ctx, headerInfo)
 	}
 }
+
+func (h MultiBTCLightClientHooks) AfterBTCTipUpdated(ctx sdk.Context, headerInfo *BTCHeaderInfo) {
+	for i := range h {
+		h[i].AfterBTCTipUpdated(ctx, headerInfo)
+	}
+}
+
+func (h MultiBTCLightClientHooks) BeforeBTCHeaderPruned(ctx sdk.Context, headerInfo *BTCHeaderInfo) {
+	for i := range h {
+		h[i].BeforeBTCHeaderPruned(ctx, headerInfo)
+	}
+}
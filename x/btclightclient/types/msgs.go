@@ -42,3 +42,60 @@ func (msg *MsgInsertHeader) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{signer}
 }
+
+// Ensure that MsgInsertHeaders implements all functions of the Msg interface
+var _ sdk.Msg = (*MsgInsertHeaders)(nil)
+
+func NewMsgInsertHeaders(signer sdk.AccAddress, headersHex []string) (*MsgInsertHeaders, error) {
+	if len(headersHex) == 0 {
+		return nil, ErrEmptyMessage.Wrap("no headers provided")
+	}
+	headers := make([]bbn.BTCHeaderBytes, len(headersHex))
+	for i, headerHex := range headersHex {
+		headerBytes, err := bbn.NewBTCHeaderBytesFromHex(headerHex)
+		if err != nil {
+			return nil, err
+		}
+		headers[i] = headerBytes
+	}
+	return &MsgInsertHeaders{Signer: signer.String(), Headers: headers}, nil
+}
+
+func (msg *MsgInsertHeaders) ValidateBasic() error {
+	// This function validates stateless message elements
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return err
+	}
+	powLimit := bbn.GetGlobalPowLimit()
+	return msg.ValidateHeaders(&powLimit)
+}
+
+// ValidateHeaders checks that the headers are valid and that they form a chain,
+// i.e. each header extends the header that precedes it
+func (msg *MsgInsertHeaders) ValidateHeaders(powLimit *big.Int) error {
+	if len(msg.Headers) == 0 {
+		return ErrEmptyMessage.Wrap("no headers provided")
+	}
+	for i := range msg.Headers {
+		if i > 0 && !msg.Headers[i].HasParent(&msg.Headers[i-1]) {
+			return ErrHeaderParentDoesNotExist.Wrapf("header %d does not extend the preceding header", i)
+		}
+		err := bbn.ValidateBTCHeader(msg.Headers[i].ToBlockHeader(), powLimit)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg *MsgInsertHeaders) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		// Panic, since the GetSigners method is called after ValidateBasic
+		// which performs the same check.
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
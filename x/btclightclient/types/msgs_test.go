@@ -81,3 +81,60 @@ func FuzzMsgInsertHeader(f *testing.F) {
 	})
 
 }
+
+func FuzzMsgInsertHeaders(f *testing.F) {
+	maxDifficulty := bbn.GetMaxDifficulty()
+	bitsBig := sdk.NewUintFromBigInt(&maxDifficulty)
+
+	datagen.AddRandomSeedsToFuzzer(f, 100)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+
+		addressBytes := datagen.GenRandomByteArray(1 + uint64(rand.Intn(255)))
+		var signer sdk.AccAddress
+		signer.Unmarshal(addressBytes)
+
+		// Generate a chain of headers with the maximum allowed target
+		chainLen := rand.Intn(10) + 2
+		headersHex := make([]string, chainLen)
+		var parent *types.BTCHeaderInfo
+		for i := 0; i < chainLen; i++ {
+			header := datagen.GenRandomBTCHeaderInfoWithParentAndBits(parent, &bitsBig)
+			// The hash might be bigger than the target encoded by the bits, as the
+			// bits cannot represent the maximum difficulty exactly
+			if bbn.ValidateBTCHeader(header.Header.ToBlockHeader(), &maxDifficulty) != nil {
+				t.Skip()
+			}
+			headersHex[i] = header.Header.MarshalHex()
+			parent = header
+		}
+
+		// Empty messages should be rejected
+		_, err := types.NewMsgInsertHeaders(signer, []string{})
+		if err == nil {
+			t.Errorf("Message without headers did not fail")
+		}
+
+		// A chain of valid headers should be accepted
+		msg, err := types.NewMsgInsertHeaders(signer, headersHex)
+		if err != nil {
+			t.Fatalf("Valid parameters led to error %s", err)
+		}
+		if len(msg.Headers) != chainLen {
+			t.Fatalf("Expected %d headers, got %d", chainLen, len(msg.Headers))
+		}
+		err = msg.ValidateHeaders(&maxDifficulty)
+		if err != nil {
+			t.Errorf("Valid chain of headers failed with %s", err)
+		}
+
+		// Headers that do not form a chain should be rejected
+		idx := rand.Intn(chainLen - 1)
+		msg.Headers[idx], msg.Headers[idx+1] = msg.Headers[idx+1], msg.Headers[idx]
+		err = msg.ValidateHeaders(&maxDifficulty)
+		if err == nil {
+			t.Errorf("Headers that do not form a chain did not fail")
+		}
+	})
+}
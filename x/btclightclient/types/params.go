@@ -1,10 +1,30 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// DefaultForkRetentionDepth corresponds to a full difficulty adjustment period.
+	// It should not be lower than the checkpoint finalization timeout of the btccheckpoint module,
+	// as otherwise headers of submissions might be pruned before the submissions are finalized.
+	DefaultForkRetentionDepth uint64 = 2016
+	// DefaultMainChainRetentionDepth is zero, meaning that the main chain is never pruned by default
+	DefaultMainChainRetentionDepth uint64 = 0
+	// MinMainChainRetentionDepth is the minimum depth of the main chain that should be maintained
+	// when pruning of the main chain is enabled. The headers of a full difficulty adjustment period
+	// are required in order to validate the difficulty of new headers.
+	MinMainChainRetentionDepth uint64 = 2016
+)
+
+var (
+	KeyForkRetentionDepth      = []byte("ForkRetentionDepth")
+	KeyMainChainRetentionDepth = []byte("MainChainRetentionDepth")
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,22 +33,74 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(forkRetentionDepth uint64, mainChainRetentionDepth uint64) Params {
+	return Params{
+		ForkRetentionDepth:      forkRetentionDepth,
+		MainChainRetentionDepth: mainChainRetentionDepth,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultForkRetentionDepth,
+		DefaultMainChainRetentionDepth,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyForkRetentionDepth, &p.ForkRetentionDepth, validateForkRetentionDepth),
+		paramtypes.NewParamSetPair(KeyMainChainRetentionDepth, &p.MainChainRetentionDepth, validateMainChainRetentionDepth),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateForkRetentionDepth(p.ForkRetentionDepth); err != nil {
+		return err
+	}
+	if err := validateMainChainRetentionDepth(p.MainChainRetentionDepth); err != nil {
+		return err
+	}
+	// Forks are pruned before the main chain headers they extend,
+	// so that the base BTC header remains an ancestor of all the maintained headers
+	if p.PruneMainChain() && p.MainChainRetentionDepth < p.ForkRetentionDepth {
+		return fmt.Errorf("MainChainRetentionDepth should not be smaller than ForkRetentionDepth")
+	}
+
+	return nil
+}
+
+// PruneMainChain returns true if the headers of the main chain should be pruned
+func (p Params) PruneMainChain() bool {
+	return p.MainChainRetentionDepth > 0
+}
+
+func validateForkRetentionDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("ForkRetentionDepth must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMainChainRetentionDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != 0 && v < MinMainChainRetentionDepth {
+		return fmt.Errorf("MainChainRetentionDepth must be either zero or at least %d: %d", MinMainChainRetentionDepth, v)
+	}
+
 	return nil
 }
 
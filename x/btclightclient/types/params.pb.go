@@ -25,6 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// fork_retention_depth is the depth of the main chain after which headers
+	// that do not belong to the main chain are pruned.
+	// Headers extending a header that is at least this deep are rejected,
+	// as BTC re-orgs that are that deep are considered impossible.
+	ForkRetentionDepth uint64 `protobuf:"varint,1,opt,name=fork_retention_depth,json=forkRetentionDepth,proto3" json:"fork_retention_depth,omitempty" yaml:"fork_retention_depth"`
+	// main_chain_retention_depth is the depth of the main chain after which
+	// headers of the main chain are pruned, with the oldest remaining one becoming
+	// the base BTC header. A value of zero disables the pruning of the main chain.
+	MainChainRetentionDepth uint64 `protobuf:"varint,2,opt,name=main_chain_retention_depth,json=mainChainRetentionDepth,proto3" json:"main_chain_retention_depth,omitempty" yaml:"main_chain_retention_depth"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +68,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetForkRetentionDepth() uint64 {
+	if m != nil {
+		return m.ForkRetentionDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMainChainRetentionDepth() uint64 {
+	if m != nil {
+		return m.MainChainRetentionDepth
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
 }
@@ -68,17 +91,23 @@ func init() {
 }
 
 var fileDescriptor_a02d211bdb249bb3 = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
+	// 250 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50,
 	0x45, 0x7a, 0xa8, 0x8a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4,
-	0x41, 0x2c, 0x88, 0x06, 0x25, 0x3e, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x56, 0x2c, 0x33, 0x16, 0xc8,
-	0x33, 0x38, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x96, 0xe4, 0x8c, 0xc4, 0xcc,
-	0x3c, 0x18, 0x47, 0xbf, 0x02, 0xdd, 0x65, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x8b,
-	0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x89, 0x16, 0x8f, 0x17, 0xc0, 0x00, 0x00, 0x00,
+	0x41, 0x2c, 0x88, 0x06, 0xa5, 0xa3, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0x13, 0x84, 0x02, 0xb9, 0x44,
+	0xd2, 0xf2, 0x8b, 0xb2, 0xe3, 0x8b, 0x52, 0x4b, 0x52, 0xf3, 0x4a, 0x32, 0xf3, 0xf3, 0xe2, 0x53,
+	0x52, 0x0b, 0x4a, 0x32, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x9c, 0xe4, 0x3f, 0xdd, 0x93, 0x97,
+	0xae, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xa6, 0x4a, 0x29, 0x48, 0x08, 0x24, 0x1c, 0x04, 0x13,
+	0x75, 0x01, 0x09, 0x0a, 0x25, 0x71, 0x49, 0xe5, 0x26, 0x66, 0xe6, 0xc5, 0x27, 0x67, 0x80, 0x48,
+	0x74, 0x83, 0x99, 0xc0, 0x06, 0xab, 0x7e, 0xba, 0x27, 0xaf, 0x08, 0x31, 0x18, 0xb7, 0x5a, 0xa5,
+	0x20, 0x71, 0x90, 0xa4, 0x33, 0x48, 0x0e, 0xd5, 0x0e, 0x2b, 0x96, 0x19, 0x0b, 0xe4, 0x19, 0x9c,
+	0x02, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
+	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24,
+	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x3a, 0x60, 0x2b, 0x60, 0x1c, 0xfd, 0x0a,
+	0xf4, 0x10, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x90, 0x31, 0x20, 0x00, 0x00,
+	0xff, 0xff, 0x76, 0x7f, 0xe5, 0xba, 0x78, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -101,6 +130,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MainChainRetentionDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MainChainRetentionDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ForkRetentionDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForkRetentionDepth))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -121,6 +160,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ForkRetentionDepth != 0 {
+		n += 1 + sovParams(uint64(m.ForkRetentionDepth))
+	}
+	if m.MainChainRetentionDepth != 0 {
+		n += 1 + sovParams(uint64(m.MainChainRetentionDepth))
+	}
 	return n
 }
 
@@ -159,6 +204,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkRetentionDepth", wireType)
+			}
+			m.ForkRetentionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkRetentionDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainChainRetentionDepth", wireType)
+			}
+			m.MainChainRetentionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MainChainRetentionDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -111,15 +111,101 @@ func (m *MsgInsertHeaderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInsertHeaderResponse proto.InternalMessageInfo
 
+// MsgInsertHeaders defines the message for inserting a chain of headers.
+// The headers should be ordered by height, with each header extending
+// the one that precedes it.
+type MsgInsertHeaders struct {
+	Signer  string                                                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Headers []github_com_babylonchain_babylon_types.BTCHeaderBytes `protobuf:"bytes,2,rep,name=headers,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderBytes" json:"headers,omitempty"`
+}
+
+func (m *MsgInsertHeaders) Reset()         { *m = MsgInsertHeaders{} }
+func (m *MsgInsertHeaders) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertHeaders) ProtoMessage()    {}
+func (*MsgInsertHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84e67479ce863198, []int{2}
+}
+func (m *MsgInsertHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertHeaders.Merge(m, src)
+}
+func (m *MsgInsertHeaders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertHeaders proto.InternalMessageInfo
+
+func (m *MsgInsertHeaders) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type MsgInsertHeadersResponse struct {
+}
+
+func (m *MsgInsertHeadersResponse) Reset()         { *m = MsgInsertHeadersResponse{} }
+func (m *MsgInsertHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInsertHeadersResponse) ProtoMessage()    {}
+func (*MsgInsertHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84e67479ce863198, []int{3}
+}
+func (m *MsgInsertHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInsertHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInsertHeadersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInsertHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInsertHeadersResponse.Merge(m, src)
+}
+func (m *MsgInsertHeadersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInsertHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInsertHeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInsertHeadersResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgInsertHeader)(nil), "babylon.btclightclient.v1.MsgInsertHeader")
 	proto.RegisterType((*MsgInsertHeaderResponse)(nil), "babylon.btclightclient.v1.MsgInsertHeaderResponse")
+	proto.RegisterType((*MsgInsertHeaders)(nil), "babylon.btclightclient.v1.MsgInsertHeaders")
+	proto.RegisterType((*MsgInsertHeadersResponse)(nil), "babylon.btclightclient.v1.MsgInsertHeadersResponse")
 }
 
 func init() { proto.RegisterFile("babylon/btclightclient/tx.proto", fileDescriptor_84e67479ce863198) }
 
 var fileDescriptor_84e67479ce863198 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
+	// 322 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0x05, 0x7a, 0xa8, 0x0a,
@@ -131,12 +217,16 @@ var fileDescriptor_84e67479ce863198 = []byte{
 	0x37, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x5a, 0x9b, 0x9c,
 	0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x39, 0x85, 0x38, 0x43,
 	0x0c, 0x77, 0xaa, 0x2c, 0x49, 0x2d, 0x0e, 0x82, 0x9a, 0xa3, 0x24, 0xc9, 0x25, 0x8e, 0x66, 0x79,
-	0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x39, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50,
-	0x01, 0x17, 0x0f, 0x8a, 0xdb, 0xb4, 0xf4, 0x70, 0x86, 0x86, 0x1e, 0x9a, 0x51, 0x52, 0x46, 0xc4,
-	0xab, 0x85, 0x59, 0xab, 0xc4, 0xe0, 0x14, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x66, 0x84, 0xfc, 0x5a, 0x81, 0x11, 0x87, 0x20, 0xcf, 0x27, 0xb1, 0x81, 0x43, 0xda,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x26, 0xac, 0x10, 0xa6, 0xea, 0x01, 0x00, 0x00,
+	0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x52, 0x1d, 0x97, 0x00, 0x9a, 0x54, 0x31, 0x4e,
+	0x87, 0x05, 0x71, 0xb1, 0x43, 0x0c, 0x2c, 0x96, 0x60, 0x52, 0x60, 0xa6, 0xc8, 0x65, 0x30, 0x83,
+	0x94, 0xa4, 0xb8, 0x24, 0xd0, 0xed, 0x87, 0xb9, 0xcd, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71,
+	0xba, 0x50, 0x01, 0x17, 0x0f, 0x4a, 0xc0, 0x69, 0xe9, 0xe1, 0x8c, 0x2a, 0x3d, 0x34, 0xc3, 0xa4,
+	0x8c, 0x88, 0x57, 0x0b, 0x0f, 0x13, 0x06, 0xa1, 0x62, 0x2e, 0x5e, 0xd4, 0x20, 0xd1, 0x26, 0xde,
+	0x98, 0x62, 0x29, 0x63, 0x12, 0x14, 0x23, 0x2c, 0x75, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
+	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
+	0xc6, 0x63, 0x39, 0x86, 0x28, 0x33, 0x42, 0x61, 0x5c, 0x81, 0x91, 0xaa, 0x41, 0x81, 0x9e, 0xc4,
+	0x06, 0x4e, 0x7b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68, 0x4a, 0x1c, 0xce, 0xfc, 0x02,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +242,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	InsertHeader(ctx context.Context, in *MsgInsertHeader, opts ...grpc.CallOption) (*MsgInsertHeaderResponse, error)
+	InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opts ...grpc.CallOption) (*MsgInsertHeadersResponse, error)
 }
 
 type msgClient struct {
@@ -171,9 +262,19 @@ func (c *msgClient) InsertHeader(ctx context.Context, in *MsgInsertHeader, opts
 	return out, nil
 }
 
+func (c *msgClient) InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opts ...grpc.CallOption) (*MsgInsertHeadersResponse, error) {
+	out := new(MsgInsertHeadersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/InsertHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	InsertHeader(context.Context, *MsgInsertHeader) (*MsgInsertHeaderResponse, error)
+	InsertHeaders(context.Context, *MsgInsertHeaders) (*MsgInsertHeadersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +284,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) InsertHeader(ctx context.Context, req *MsgInsertHeader) (*MsgInsertHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertHeader not implemented")
 }
+func (*UnimplementedMsgServer) InsertHeaders(ctx context.Context, req *MsgInsertHeaders) (*MsgInsertHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertHeaders not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +310,24 @@ func _Msg_InsertHeader_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InsertHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertHeaders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InsertHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/InsertHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertHeaders(ctx, req.(*MsgInsertHeaders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +336,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InsertHeader",
 			Handler:    _Msg_InsertHeader_Handler,
 		},
+		{
+			MethodName: "InsertHeaders",
+			Handler:    _Msg_InsertHeaders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/tx.proto",
@@ -284,6 +410,73 @@ func (m *MsgInsertHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInsertHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Headers[iNdEx].Size()
+				i -= size
+				if _, err := m.Headers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInsertHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInsertHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -321,6 +514,34 @@ func (m *MsgInsertHeaderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInsertHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -494,6 +715,173 @@ func (m *MsgInsertHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInsertHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.Headers = append(m.Headers, v)
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
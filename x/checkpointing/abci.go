@@ -16,11 +16,18 @@ import (
 // Upon each BeginBlock, if reaching the second block after the epoch begins, then
 // - extract the LastCommitHash from the block
 // - create a raw checkpoint with the status of ACCUMULATING
-// - start a BLS signer which creates a BLS sig transaction and distributes it to the network
+// The BLS sigs of the validators are submitted by the off-chain signer service, which
+// subscribes to the emitted EventCheckpointAccumulating, see the blssigner package.
+// If a conflicting checkpoint has been found, the safety mode either halts the chain
+// or stops sealing further checkpoints until the operators intervene
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper, req abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if k.IsChainHalted(ctx) {
+		panic("a conflicting checkpoint is found, the chain is halted until the operators intervene")
+	}
+
 	// if this block is the second block of an epoch
 	epoch := k.GetEpoch(ctx)
 	if epoch.IsSecondBlock(ctx) {
@@ -41,12 +48,5 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, req abci.RequestBeginBlock)
 		if err != nil {
 			panic(err)
 		}
-
-		go func() {
-			err = k.SendBlsSig(ctx, epoch.EpochNumber-1, lch)
-			if err != nil {
-				panic(err)
-			}
-		}()
 	}
 }
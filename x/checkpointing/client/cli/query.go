@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdRawCheckpoint())
 	cmd.AddCommand(CmdRawCheckpointList())
+	cmd.AddCommand(CmdConflictingCheckpoints())
 
 	return cmd
 }
@@ -98,3 +99,35 @@ func CmdRawCheckpoint() *cobra.Command {
 
 	return cmd
 }
+
+// CmdConflictingCheckpoints defines the cobra command to query the evidences of conflicting checkpoints
+func CmdConflictingCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflicting-checkpoints",
+		Short: "retrieve the evidences of conflicting checkpoints",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryConflictingCheckpointsRequest(pageReq)
+			res, err := queryClient.ConflictingCheckpoints(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -11,7 +11,18 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	// the genesis validators form the validator set of the first epoch, so the
+	// btccheckpoint module needs to be initialised before
+	if err := k.ValidateValSetSize(ctx, len(genState.GenesisKeys)); err != nil {
+		panic(err)
+	}
+
 	k.SetGenBlsKeys(ctx, genState.GenesisKeys)
+	k.SetRegisteredBlsKeys(ctx, genState.RegisteredKeys)
+	k.SetGenCheckpoints(ctx, genState.Checkpoints)
+	for _, evidence := range genState.ConflictingCheckpoints {
+		k.SetConflictingCheckpointEvidence(ctx, evidence)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -19,5 +30,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	ckpts, err := k.CheckpointsState(ctx).GetAllRawCkptsWithMeta()
+	if err != nil {
+		panic(err)
+	}
+	genesis.Checkpoints = ckpts
+	// The keys registered at genesis are exported along with the ones registered afterwards,
+	// as the proofs-of-possession of the keys are not maintained
+	genesis.RegisteredKeys = k.RegistrationState(ctx).GetAllBlsKeys()
+	genesis.ConflictingCheckpoints = k.GetAllConflictingCheckpointEvidences(ctx)
+
 	return genesis
 }
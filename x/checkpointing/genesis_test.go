@@ -1,8 +1,10 @@
 package checkpointing_test
 
 import (
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/checkpointing"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cosmosed "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -39,7 +41,7 @@ func TestInitGenesis(t *testing.T) {
 		genKeys[i] = genKey
 	}
 	genesisState := types.GenesisState{
-		Params:      types.Params{},
+		Params:      types.DefaultParams(),
 		GenesisKeys: genKeys,
 	}
 
@@ -51,3 +53,202 @@ func TestInitGenesis(t *testing.T) {
 		require.True(t, genKeys[i].BlsKey.Pubkey.Equal(blsKey))
 	}
 }
+
+func TestInitGenesisValSetTooLarge(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the checkpoints of the genesis validators need to be encoded in the accepted format versions
+	params := app.BtcCheckpointKeeper.GetParams(ctx)
+	params.AcceptedFormatVersions = []uint32{uint32(txformat.CurrentVersion)}
+	app.BtcCheckpointKeeper.SetParams(ctx, params)
+
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		GenesisKeys: make([]*types.GenesisKey, 8*txformat.BitMapLength+1),
+	}
+	require.Panics(t, func() { checkpointing.InitGenesis(ctx, app.CheckpointingKeeper, genesisState) })
+}
+
+func TestExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ckptKeeper := app.CheckpointingKeeper
+
+	// register BLS keys both at genesis and afterwards
+	valKeys, err := privval.NewValidatorKeys(ed25519.GenPrivKey(), bls12381.GenPrivKey())
+	require.NoError(t, err)
+	valPubkey, err := cryptocodec.FromTmPubKeyInterface(valKeys.ValPubkey)
+	require.NoError(t, err)
+	genKey := &types.GenesisKey{
+		ValidatorAddress: sdk.ValAddress(valKeys.ValPubkey.Address()).String(),
+		BlsKey: &types.BlsKey{
+			Pubkey: &valKeys.BlsPubkey,
+			Pop:    valKeys.PoP,
+		},
+		ValPubkey: &cosmosed.PubKey{Key: valPubkey.Bytes()},
+	}
+	checkpointing.InitGenesis(ctx, ckptKeeper, types.GenesisState{
+		Params:      types.DefaultParams(),
+		GenesisKeys: []*types.GenesisKey{genKey},
+	})
+	registeredAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	registeredKey := bls12381.GenPrivKey().PubKey()
+	err = ckptKeeper.RegistrationState(ctx).CreateRegistration(registeredKey, registeredAddr)
+	require.NoError(t, err)
+
+	ckpts := datagen.GenRandomSequenceRawCheckpointsWithMeta()
+	for _, ckpt := range ckpts {
+		err = ckptKeeper.AddRawCheckpoint(ctx, ckpt)
+		require.NoError(t, err)
+	}
+
+	evidence := &types.ConflictingCheckpointEvidence{
+		ConflictingCkpt: datagen.GenRandomRawCheckpoint(),
+		LocalCkpt:       datagen.GenRandomRawCheckpoint(),
+		Signers:         []string{registeredAddr.String()},
+		DetectedHeight:  10,
+	}
+	evidence.ConflictingCkpt.EpochNum = 1
+	evidence.LocalCkpt.EpochNum = 1
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, evidence)
+
+	genesisState := checkpointing.ExportGenesis(ctx, ckptKeeper)
+	require.NoError(t, genesisState.Validate())
+	require.Len(t, genesisState.RegisteredKeys, 2)
+	require.Len(t, genesisState.Checkpoints, len(ckpts))
+	require.Len(t, genesisState.ConflictingCheckpoints, 1)
+
+	// re-import the exported state in a new chain
+	app = simapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	ckptKeeper = app.CheckpointingKeeper
+	checkpointing.InitGenesis(ctx, ckptKeeper, *genesisState)
+
+	genAddr, err := sdk.ValAddressFromBech32(genKey.ValidatorAddress)
+	require.NoError(t, err)
+	blsKey, err := ckptKeeper.GetBlsPubKey(ctx, genAddr)
+	require.NoError(t, err)
+	require.True(t, valKeys.BlsPubkey.Equal(blsKey))
+	blsKey, err = ckptKeeper.GetBlsPubKey(ctx, registeredAddr)
+	require.NoError(t, err)
+	require.True(t, registeredKey.Equal(blsKey))
+	for _, ckpt := range ckpts {
+		got, err := ckptKeeper.GetRawCheckpoint(ctx, ckpt.Ckpt.EpochNum)
+		require.NoError(t, err)
+		require.True(t, ckpt.Equal(got))
+	}
+	require.Equal(t, []*types.ConflictingCheckpointEvidence{evidence}, ckptKeeper.GetAllConflictingCheckpointEvidences(ctx))
+	require.True(t, ckptKeeper.IsCheckpointingStopped(ctx))
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	addr1 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	key1 := bls12381.GenPrivKey().PubKey()
+	key2 := bls12381.GenPrivKey().PubKey()
+	ckpt := datagen.GenRandomRawCheckpointWithMeta()
+	conflictingCkpt, localCkpt := datagen.GenRandomRawCheckpoint(), datagen.GenRandomRawCheckpoint()
+	conflictingCkpt.EpochNum, localCkpt.EpochNum = 1, 1
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "unique BLS keys and checkpoints",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				Checkpoints:    []*types.RawCheckpointWithMeta{ckpt},
+				RegisteredKeys: []*types.ValidatorWithBlsKey{{ValidatorAddress: addr1, BlsPubKey: key1}, {ValidatorAddress: addr2, BlsPubKey: key2}},
+			},
+			valid: true,
+		},
+		{
+			desc: "BLS key registered by two validators",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RegisteredKeys: []*types.ValidatorWithBlsKey{{ValidatorAddress: addr1, BlsPubKey: key1}, {ValidatorAddress: addr2, BlsPubKey: key1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "validator with two BLS keys",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RegisteredKeys: []*types.ValidatorWithBlsKey{{ValidatorAddress: addr1, BlsPubKey: key1}, {ValidatorAddress: addr1, BlsPubKey: key2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "BLS key registered at and after genesis",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				GenesisKeys:    []*types.GenesisKey{{ValidatorAddress: addr1, BlsKey: &types.BlsKey{Pubkey: &key1}}},
+				RegisteredKeys: []*types.ValidatorWithBlsKey{{ValidatorAddress: addr2, BlsPubKey: key1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid BLS key",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				RegisteredKeys: []*types.ValidatorWithBlsKey{{ValidatorAddress: addr1, BlsPubKey: key1[1:]}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid quorum threshold",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.Fraction{Numerator: 2, Denominator: 1}, types.DefaultSafetyMode),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid safety mode",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultQuorumThreshold, types.SafetyMode(len(types.SafetyMode_name))),
+			},
+			valid: false,
+		},
+		{
+			desc: "conflicting checkpoint evidence",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				ConflictingCheckpoints: []*types.ConflictingCheckpointEvidence{{ConflictingCkpt: conflictingCkpt, LocalCkpt: localCkpt}},
+			},
+			valid: true,
+		},
+		{
+			desc: "conflicting checkpoint evidence of another epoch",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				ConflictingCheckpoints: []*types.ConflictingCheckpointEvidence{{ConflictingCkpt: conflictingCkpt, LocalCkpt: &types.RawCheckpoint{EpochNum: 2}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate checkpoint",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Checkpoints: []*types.RawCheckpointWithMeta{ckpt, ckpt},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -106,3 +106,19 @@ func (cs CheckpointsState) UpdateCheckpoint(ckpt *types.RawCheckpointWithMeta) e
 
 	return nil
 }
+
+// GetAllRawCkptsWithMeta retrieves all the raw checkpoints with meta by the ascending order of epoch
+func (cs CheckpointsState) GetAllRawCkptsWithMeta() ([]*types.RawCheckpointWithMeta, error) {
+	iter := cs.checkpoints.Iterator(nil, nil)
+	defer iter.Close()
+
+	var ckpts []*types.RawCheckpointWithMeta
+	for ; iter.Valid(); iter.Next() {
+		ckptWithMeta, err := types.BytesToCkptWithMeta(cs.cdc, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		ckpts = append(ckpts, ckptWithMeta)
+	}
+	return ckpts, nil
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) conflictsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ConflictsPrefix)
+}
+
+// recordConflictingCheckpoint stores the evidence of a conflicting checkpoint signed
+// by the given validators and emits an event, unless the same conflicting checkpoint
+// was already recorded
+func (k Keeper) recordConflictingCheckpoint(
+	ctx sdk.Context,
+	conflicting *types.RawCheckpoint,
+	local *types.RawCheckpoint,
+	signers epochingtypes.ValidatorSet,
+) {
+	key := types.ConflictingCkptKey(conflicting.EpochNum, conflicting.Hash())
+	if k.conflictsStore(ctx).Has(key) {
+		return
+	}
+
+	signerAddrs := make([]string, len(signers))
+	for i, v := range signers {
+		signerAddrs[i] = v.Addr.String()
+	}
+
+	evidence := &types.ConflictingCheckpointEvidence{
+		ConflictingCkpt: conflicting,
+		LocalCkpt:       local,
+		Signers:         signerAddrs,
+		DetectedHeight:  uint64(ctx.BlockHeight()),
+	}
+	k.SetConflictingCheckpointEvidence(ctx, evidence)
+
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventConflictingCheckpoint{Evidence: evidence},
+	)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to emit conflicting checkpoint event for epoch %v: %v", conflicting.EpochNum, err))
+	}
+	ctx.Logger().Error(fmt.Sprintf("Checkpointing: a conflicting checkpoint is found for epoch %v, safety mode %v is triggered",
+		conflicting.EpochNum, k.GetParams(ctx).SafetyMode))
+}
+
+// SetConflictingCheckpointEvidence stores the evidence of a conflicting checkpoint
+// and keeps the number of unresolved conflicting checkpoints up to date
+func (k Keeper) SetConflictingCheckpointEvidence(ctx sdk.Context, evidence *types.ConflictingCheckpointEvidence) {
+	store := k.conflictsStore(ctx)
+	key := types.ConflictingCkptKey(evidence.ConflictingCkpt.EpochNum, evidence.ConflictingCkpt.Hash())
+
+	wasUnresolved := false
+	if bz := store.Get(key); bz != nil {
+		var old types.ConflictingCheckpointEvidence
+		k.cdc.MustUnmarshal(bz, &old)
+		wasUnresolved = !old.Resolved
+	}
+	switch numUnresolved := k.getNumUnresolvedConflicts(ctx); {
+	case !wasUnresolved && !evidence.Resolved:
+		k.setNumUnresolvedConflicts(ctx, numUnresolved+1)
+	case wasUnresolved && evidence.Resolved:
+		k.setNumUnresolvedConflicts(ctx, numUnresolved-1)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(evidence))
+}
+
+func (k Keeper) getNumUnresolvedConflicts(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.UnresolvedConflictsKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNumUnresolvedConflicts(ctx sdk.Context, num uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if num == 0 {
+		store.Delete(types.UnresolvedConflictsKey)
+		return
+	}
+	store.Set(types.UnresolvedConflictsKey, sdk.Uint64ToBigEndian(num))
+}
+
+// GetAllConflictingCheckpointEvidences returns the evidences of all conflicting
+// checkpoints by the ascending order of epoch
+func (k Keeper) GetAllConflictingCheckpointEvidences(ctx sdk.Context) []*types.ConflictingCheckpointEvidence {
+	var evidences []*types.ConflictingCheckpointEvidence
+
+	iter := k.conflictsStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.ConflictingCheckpointEvidence
+		k.cdc.MustUnmarshal(iter.Value(), &evidence)
+		evidences = append(evidences, &evidence)
+	}
+
+	return evidences
+}
+
+// HasUnresolvedConflict returns true if a conflicting checkpoint was found and
+// the operators have not intervened yet. It only reads the number of unresolved
+// conflicting checkpoints, as it is called upon every block
+func (k Keeper) HasUnresolvedConflict(ctx sdk.Context) bool {
+	return k.getNumUnresolvedConflicts(ctx) > 0
+}
+
+// ResolveConflictingCheckpoints marks the evidences of all conflicting checkpoints
+// as resolved, which lifts the safety mode, and seals the checkpoints which reached
+// the quorum while checkpointing was stopped. It is meant to be called once the
+// operators have intervened, e.g. from an upgrade handler.
+func (k Keeper) ResolveConflictingCheckpoints(ctx sdk.Context) error {
+	for _, evidence := range k.GetAllConflictingCheckpointEvidences(ctx) {
+		if !evidence.Resolved {
+			evidence.Resolved = true
+			k.SetConflictingCheckpointEvidence(ctx, evidence)
+		}
+	}
+
+	ckpts, err := k.CheckpointsState(ctx).GetAllRawCkptsWithMeta()
+	if err != nil {
+		return err
+	}
+	quorumThreshold := k.GetParams(ctx).QuorumThreshold
+	for _, ckptWithMeta := range ckpts {
+		if ckptWithMeta.Status != types.Accumulating {
+			continue
+		}
+		totalPower := k.GetTotalVotingPower(ctx, ckptWithMeta.Ckpt.EpochNum)
+		if !quorumThreshold.IsReachedBy(ckptWithMeta.PowerSum, uint64(totalPower)) {
+			continue
+		}
+		ckptWithMeta.Status = types.Sealed
+		if err := k.UpdateCheckpoint(ctx, ckptWithMeta); err != nil {
+			return err
+		}
+		k.emitCheckpointSealed(ctx, ckptWithMeta)
+	}
+
+	return nil
+}
+
+// IsChainHalted returns true if block production must stop because of an
+// unresolved conflicting checkpoint
+func (k Keeper) IsChainHalted(ctx sdk.Context) bool {
+	return k.GetParams(ctx).SafetyMode == types.HaltChain && k.HasUnresolvedConflict(ctx)
+}
+
+// IsCheckpointingStopped returns true if further checkpoints must not be sealed
+// because of an unresolved conflicting checkpoint
+func (k Keeper) IsCheckpointingStopped(ctx sdk.Context) bool {
+	return k.GetParams(ctx).SafetyMode == types.StopEpochs && k.HasUnresolvedConflict(ctx)
+}
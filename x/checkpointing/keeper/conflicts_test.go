@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing"
+	"github.com/babylonchain/babylon/x/checkpointing/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+/*
+FuzzKeeperConflictingCheckpoint checks
+1. a checkpoint of the same LastCommitHash signed by another quorum is accepted, and adopted if the local one is accumulating
+2. a validly signed checkpoint of another LastCommitHash is recorded as evidence with its signers
+3. the evidence is emitted as an event and returned by the query
+4. the safety mode stops sealing checkpoints or halts the chain until the conflict is resolved
+5. the checkpoints accumulated while stopped are sealed and submitted once the conflict is resolved
+*/
+func FuzzKeeperConflictingCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 1)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		epoch := datagen.RandomInt(100) + 1
+		numVals, numSigners := 4, 3
+
+		conflicting, blsValSet, sks := datagen.GenSignedRawCheckpointWithKeys(epoch, numVals, numSigners)
+		valSet := make(epochingtypes.ValidatorSet, numVals)
+		for i, v := range blsValSet.ValSet {
+			addr, err := sdk.ValAddressFromBech32(v.ValidatorAddress)
+			require.NoError(t, err)
+			valSet[i] = epochingtypes.Validator{Addr: addr, Power: int64(v.VotingPower)}
+		}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epoch).Return(valSet).AnyTimes()
+		nextValSet := epochingtypes.NewSortedValidatorSet(append(epochingtypes.ValidatorSet{}, valSet...))
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epoch+1).Return(nextValSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(int64(10 * numVals)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+
+		for i, v := range blsValSet.ValSet {
+			require.NoError(t, ckptKeeper.CreateRegistration(ctx, bls12381.PublicKey(v.BlsPubKey), valSet[i].Addr))
+		}
+
+		// the local checkpoint is still accumulating BLS sigs
+		localLch := datagen.GenRandomLastCommitHash()
+		accumulating := datagen.SignRawCheckpoint(epoch, localLch, sks, []int{0})
+		require.NoError(t, ckptKeeper.AddRawCheckpoint(ctx, types.NewCheckpointWithMeta(accumulating, types.Accumulating)))
+
+		// a checkpoint of the same LastCommitHash not reaching the quorum is invalid
+		weak := datagen.SignRawCheckpoint(epoch, localLch, sks, []int{3})
+		_, err := ckptKeeper.CheckpointEpoch(ctx, types.FromRawCkptToBTCCkptBytes(weak, datagen.GenRandomByteArray(txformat.AddressLength)))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, types.ErrConflictingCheckpoint)
+
+		// a checkpoint of the same LastCommitHash reaching the quorum is adopted and sealed
+		local := datagen.SignRawCheckpoint(epoch, localLch, sks, []int{1, 2})
+		ckptEpoch, err := ckptKeeper.CheckpointEpoch(ctx, types.FromRawCkptToBTCCkptBytes(local, datagen.GenRandomByteArray(txformat.AddressLength)))
+		require.NoError(t, err)
+		require.Equal(t, epoch, ckptEpoch)
+		adopted, err := ckptKeeper.GetRawCheckpoint(ctx, epoch)
+		require.NoError(t, err)
+		require.Equal(t, types.Sealed, adopted.Status)
+		require.True(t, local.Equal(adopted.Ckpt))
+		require.Equal(t, uint64(2*10), adopted.PowerSum)
+
+		// another subset reaching the quorum is accepted, while the sealed checkpoint is kept
+		other := datagen.SignRawCheckpoint(epoch, localLch, sks, []int{0, 1, 3})
+		ckptEpoch, err = ckptKeeper.CheckpointEpoch(ctx, types.FromRawCkptToBTCCkptBytes(other, datagen.GenRandomByteArray(txformat.AddressLength)))
+		require.NoError(t, err)
+		require.Equal(t, epoch, ckptEpoch)
+		adopted, err = ckptKeeper.GetRawCheckpoint(ctx, epoch)
+		require.NoError(t, err)
+		require.True(t, local.Equal(adopted.Ckpt))
+		require.Empty(t, ckptKeeper.GetAllConflictingCheckpointEvidences(ctx))
+		require.False(t, ckptKeeper.HasUnresolvedConflict(ctx))
+
+		// a validly signed checkpoint of another LastCommitHash is conflicting
+		ckptBytes := types.FromRawCkptToBTCCkptBytes(conflicting, datagen.GenRandomByteArray(txformat.AddressLength))
+		_, err = ckptKeeper.CheckpointEpoch(ctx, ckptBytes)
+		require.ErrorIs(t, err, types.ErrConflictingCheckpoint)
+
+		// the same conflicting checkpoint is recorded once
+		_, err = ckptKeeper.CheckpointEpoch(ctx, ckptBytes)
+		require.ErrorIs(t, err, types.ErrConflictingCheckpoint)
+
+		evidences := ckptKeeper.GetAllConflictingCheckpointEvidences(ctx)
+		require.Len(t, evidences, 1)
+		require.True(t, conflicting.Equal(evidences[0].ConflictingCkpt))
+		require.True(t, local.Equal(evidences[0].LocalCkpt))
+		require.Equal(t, uint64(ctx.BlockHeight()), evidences[0].DetectedHeight)
+		require.False(t, evidences[0].Resolved)
+		require.Len(t, evidences[0].Signers, numSigners)
+		for i, signer := range evidences[0].Signers {
+			require.Equal(t, valSet[i].Addr.String(), signer)
+		}
+
+		var emitted int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventConflictingCheckpoint{}) {
+				emitted++
+			}
+		}
+		require.Equal(t, 1, emitted)
+
+		res, err := ckptKeeper.ConflictingCheckpoints(sdk.WrapSDKContext(ctx), types.NewQueryConflictingCheckpointsRequest(nil))
+		require.NoError(t, err)
+		require.Equal(t, evidences, res.Evidences)
+
+		// the chain is halted in the halting safety mode
+		ckptKeeper.SetParams(ctx, types.NewParams(types.DefaultQuorumThreshold, types.HaltChain))
+		require.True(t, ckptKeeper.IsChainHalted(ctx))
+		require.False(t, ckptKeeper.IsCheckpointingStopped(ctx))
+
+		// by default the further checkpoints keep accumulating BLS sigs but are not sealed
+		ckptKeeper.SetParams(ctx, types.DefaultParams())
+		require.False(t, ckptKeeper.IsChainHalted(ctx))
+		require.True(t, ckptKeeper.IsCheckpointingStopped(ctx))
+		nextEpoch := epochingtypes.NewEpoch(epoch+2, 5)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(nextEpoch).AnyTimes()
+		lch := datagen.GenRandomLastCommitHash()
+		blockCtx := ctx.WithBlockHeader(tmproto.Header{Height: int64(nextEpoch.GetSecondBlockHeight()), LastCommitHash: lch})
+		checkpointing.BeginBlocker(blockCtx, *ckptKeeper, abci.RequestBeginBlock{})
+		msgServer := keeper.NewMsgServerImpl(*ckptKeeper)
+		for i := 0; i < numSigners; i++ {
+			blsSig := bls12381.Sign(bls12381.GenPrivKey(), lch.MustMarshal())
+			msg := types.NewMsgAddBlsSig(epoch+1, lch, blsSig, nextValSet[i].Addr)
+			_, err = msgServer.AddBlsSig(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+		}
+		status, err := ckptKeeper.GetStatus(ctx, epoch+1)
+		require.NoError(t, err)
+		require.Equal(t, types.Accumulating, status)
+
+		// the operators intervene, which seals the checkpoints reaching the quorum
+		require.NoError(t, ckptKeeper.ResolveConflictingCheckpoints(ctx))
+		require.False(t, ckptKeeper.IsChainHalted(ctx))
+		require.False(t, ckptKeeper.IsCheckpointingStopped(ctx))
+		require.True(t, ckptKeeper.GetAllConflictingCheckpointEvidences(ctx)[0].Resolved)
+		sealed, err := ckptKeeper.GetRawCheckpoint(ctx, epoch+1)
+		require.NoError(t, err)
+		require.Equal(t, types.Sealed, sealed.Status)
+
+		// and the checkpoint can be submitted to BTC
+		ckptBytes = types.FromRawCkptToBTCCkptBytes(sealed.Ckpt, datagen.GenRandomByteArray(txformat.AddressLength))
+		submittedEpoch, err := ckptKeeper.CheckpointEpoch(ctx, ckptBytes)
+		require.NoError(t, err)
+		require.Equal(t, epoch+1, submittedEpoch)
+		ckptKeeper.SetCheckpointSubmitted(ctx, submittedEpoch)
+		status, err = ckptKeeper.GetStatus(ctx, epoch+1)
+		require.NoError(t, err)
+		require.Equal(t, types.Submitted, status)
+	})
+}
+
+func TestKeeperUnresolvedConflicts(t *testing.T) {
+	ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+	genEvidence := func(epoch uint64) *types.ConflictingCheckpointEvidence {
+		ckpt := datagen.GenRandomRawCheckpoint()
+		ckpt.EpochNum = epoch
+		return &types.ConflictingCheckpointEvidence{ConflictingCkpt: ckpt}
+	}
+	first, second := genEvidence(1), genEvidence(2)
+
+	require.False(t, ckptKeeper.HasUnresolvedConflict(ctx))
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, first)
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, second)
+	// overwriting an evidence does not count it twice
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, second)
+	require.True(t, ckptKeeper.HasUnresolvedConflict(ctx))
+
+	first.Resolved = true
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, first)
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, first)
+	require.True(t, ckptKeeper.HasUnresolvedConflict(ctx))
+
+	second.Resolved = true
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, second)
+	require.False(t, ckptKeeper.HasUnresolvedConflict(ctx))
+
+	first.Resolved = false
+	ckptKeeper.SetConflictingCheckpointEvidence(ctx, first)
+	require.True(t, ckptKeeper.HasUnresolvedConflict(ctx))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// NewBlsDoubleSignHandler returns the handler of BlsDoubleSignEvidence to be
+// routed by the evidence module
+func NewBlsDoubleSignHandler(k Keeper, stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper) evidencetypes.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.BlsDoubleSignEvidence)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected evidence type %T", e)
+		}
+		return k.HandleBlsDoubleSign(ctx, evidence, stakingKeeper, slashingKeeper)
+	}
+}
+
+// HandleBlsDoubleSign verifies that a validator has BLS-signed two different
+// LastCommitHash values of the same epoch, and then slashes, jails and tombstones
+// the validator in the same way as the evidence module handles equivocations.
+//
+// The evidence is considered invalid if:
+// - either BLS sig does not verify against the validator's registered BLS key
+// - the validator is not in the validator set of the epoch
+// - the infraction height is not the height of the last block of the epoch
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - the validator has no signing info or is already tombstoned
+func (k Keeper) HandleBlsDoubleSign(
+	ctx sdk.Context,
+	evidence *types.BlsDoubleSignEvidence,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	valAddr, err := evidence.GetSignerAddress()
+	if err != nil {
+		return err
+	}
+	blsPubKey, err := k.GetBlsPubKey(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	for _, sig := range []*types.BlsSig{evidence.FirstSig, evidence.SecondSig} {
+		ok, err := bls12381.Verify(*sig.BlsSig, blsPubKey, sig.LastCommitHash.MustMarshal())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("invalid BLS sig on LastCommitHash %v", sig.LastCommitHash)
+		}
+	}
+
+	// the validator is only accountable for the epochs it is a member of
+	epochNum := evidence.GetEpochNum()
+	val, _, err := k.GetValidatorSet(ctx, epochNum).FindValidatorWithIndex(valAddr)
+	if err != nil {
+		return err
+	}
+
+	// the infraction is signing the LastCommitHash of the last block of the epoch,
+	// whose height is recorded upon the epoch beginning as the epoch interval may change
+	epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, epochNum)
+	if err != nil {
+		return err
+	}
+	infractionHeight := int64(epoch.GetLastBlockHeight())
+	if evidence.GetHeight() != infractionHeight {
+		return fmt.Errorf("infraction height %d does not match the last block %d of epoch %v",
+			evidence.GetHeight(), infractionHeight, epochNum)
+	}
+	ageBlocks := ctx.BlockHeight() - infractionHeight
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf("evidence of epoch %v is too old", epochNum)
+	}
+
+	validator := stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %v does not exist or is unbonded", valAddr)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	if !slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return fmt.Errorf("signing info of validator %v does not exist", valAddr)
+	}
+	if slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %v is already tombstoned", valAddr)
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("Checkpointing: confirmed BLS double sign of validator %v at epoch %v", valAddr, epochNum))
+
+	// the stake distribution which signed the checkpoint is the one at the infraction height
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+	slashingKeeper.Slash(ctx, consAddr, slashingKeeper.SlashFractionDoubleSign(ctx), val.Power, distributionHeight)
+	if !validator.IsJailed() {
+		slashingKeeper.Jail(ctx, consAddr)
+	}
+	slashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	slashingKeeper.Tombstone(ctx, consAddr)
+
+	return nil
+}
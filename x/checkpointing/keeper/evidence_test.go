@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing/keeper"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+FuzzKeeperHandleBlsDoubleSign checks
+1. the evidence is rejected if a BLS sig is not signed by the registered BLS key
+2. the evidence is rejected if the validator is not in the validator set of the epoch
+3. the evidence is rejected if the infraction height is not the last block of the epoch
+4. the validator is slashed, jailed and tombstoned upon a valid evidence
+5. the evidence is rejected if the validator is already tombstoned
+*/
+func FuzzKeeperHandleBlsDoubleSign(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 1)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		epochNum := datagen.RandomInt(100) + 1
+		power := int64(datagen.RandomInt(100) + 1)
+
+		consPk := ed25519.GenPrivKey().PubKey()
+		valAddr := sdk.ValAddress(consPk.Address())
+		validator, err := stakingtypes.NewValidator(valAddr, consPk, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		consAddr := sdk.ConsAddress(consPk.Address())
+		valSet := epochingtypes.ValidatorSet{{Addr: valAddr, Power: power}}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		// the epoch interval changed from 5 to 7 blocks upon the epoch
+		epoch := epochingtypes.Epoch{EpochNumber: epochNum, CurrentEpochInterval: 7, FirstBlockHeight: (epochNum-1)*5 + 1}
+		ek.EXPECT().GetHistoricalEpoch(gomock.Any(), epochNum).Return(&epoch, nil).AnyTimes()
+		height := int64(epoch.FirstBlockHeight + 6)
+		stk := mocks.NewMockStakingKeeper(ctrl)
+		stk.EXPECT().Validator(gomock.Any(), valAddr).Return(validator).AnyTimes()
+		slk := mocks.NewMockSlashingKeeper(ctrl)
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		handler := keeper.NewBlsDoubleSignHandler(*ckptKeeper, stk, slk)
+
+		sk, pk := bls12381.GenKeyPair()
+		require.NoError(t, ckptKeeper.CreateRegistration(ctx, pk, valAddr))
+
+		// the BLS sigs are not signed by the registered BLS key
+		otherSk, _ := bls12381.GenKeyPair()
+		err = handler(ctx, datagen.GenBlsDoubleSignEvidence(epochNum, height, valAddr, otherSk))
+		require.Error(t, err)
+
+		// the validator is not in the validator set of the epoch
+		evidence := datagen.GenBlsDoubleSignEvidence(epochNum, height, valAddr, sk)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(epochingtypes.ValidatorSet{}).Times(1)
+		err = handler(ctx, evidence)
+		require.Error(t, err)
+
+		// the infraction height is not the last block of the epoch recorded on chain
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(valSet).AnyTimes()
+		err = handler(ctx, datagen.GenBlsDoubleSignEvidence(epochNum, int64(epochingtypes.NewEpoch(epochNum, 5).GetLastBlockHeight()), valAddr, sk))
+		require.Error(t, err)
+
+		// the validator is slashed at the last block of the epoch, jailed and tombstoned
+		fraction := sdk.NewDecWithPrec(5, 2)
+		distributionHeight := height - sdk.ValidatorUpdateDelay
+		slk.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true).AnyTimes()
+		slk.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false).Times(1)
+		slk.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(fraction).Times(1)
+		slk.EXPECT().Slash(gomock.Any(), consAddr, fraction, power, distributionHeight).Times(1)
+		slk.EXPECT().Jail(gomock.Any(), consAddr).Times(1)
+		slk.EXPECT().JailUntil(gomock.Any(), consAddr, evidencetypes.DoubleSignJailEndTime).Times(1)
+		slk.EXPECT().Tombstone(gomock.Any(), consAddr).Times(1)
+		err = handler(ctx, evidence)
+		require.NoError(t, err)
+
+		// the validator is already tombstoned
+		slk.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true).Times(1)
+		err = handler(ctx, datagen.GenBlsDoubleSignEvidence(epochNum, height, valAddr, sk))
+		require.Error(t, err)
+	})
+}
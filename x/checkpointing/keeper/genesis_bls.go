@@ -26,3 +26,33 @@ func (k Keeper) SetGenBlsKeys(ctx sdk.Context, genKeys []*types.GenesisKey) {
 		}
 	}
 }
+
+// SetRegisteredBlsKeys registers BLS keys with validators without a proof-of-possession.
+// It is used to import the keys that have been registered on the chain from which the genesis
+// state was exported, which have been verified at the time of their registration.
+func (k Keeper) SetRegisteredBlsKeys(ctx sdk.Context, keys []*types.ValidatorWithBlsKey) {
+	for _, key := range keys {
+		addr, err := sdk.ValAddressFromBech32(key.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		exists := k.RegistrationState(ctx).Exists(addr)
+		if exists {
+			panic("a validator's BLS key has already been registered")
+		}
+		err = k.RegistrationState(ctx).CreateRegistration(key.BlsPubKey, addr)
+		if err != nil {
+			panic("failed to register a BLS key")
+		}
+	}
+}
+
+// SetGenCheckpoints stores the raw checkpoints with meta at genesis
+func (k Keeper) SetGenCheckpoints(ctx sdk.Context, ckpts []*types.RawCheckpointWithMeta) {
+	for _, ckpt := range ckpts {
+		err := k.CheckpointsState(ctx).CreateRawCkptWithMeta(ckpt)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
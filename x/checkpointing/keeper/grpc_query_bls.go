@@ -2,9 +2,27 @@ package keeper
 
 import (
 	"context"
+
 	"github.com/babylonchain/babylon/x/checkpointing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// BlsPublicKeyList returns the validator set of an epoch along with their BLS public keys,
+// in the order of the bitmaps of the checkpoints. The validator set is bounded by the size
+// of the bitmaps, so it is returned as a whole and the pagination is ignored
 func (k Keeper) BlsPublicKeyList(c context.Context, req *types.QueryBlsPublicKeyListRequest) (*types.QueryBlsPublicKeyListResponse, error) {
-	panic("TODO: implement this")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valSet, err := k.GetBLSPubKeySet(ctx, req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsPublicKeyListResponse{ValidatorWithBlsKeys: valSet.ValSet}, nil
 }
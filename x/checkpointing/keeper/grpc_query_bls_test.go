@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzQueryBlsPublicKeyList(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 1)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		epoch := datagen.RandomInt(100) + 1
+		valSet := datagen.GenRandomValSet(int(datagen.RandomInt(10)) + 1)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epoch).Return(valSet).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		sdkCtx := sdk.WrapSDKContext(ctx)
+
+		// the BLS public key of a validator is not registered
+		_, err := ckptKeeper.BlsPublicKeyList(sdkCtx, &types.QueryBlsPublicKeyListRequest{EpochNum: epoch})
+		require.Error(t, err)
+
+		pubKeys := make([]bls12381.PublicKey, len(valSet))
+		for i, val := range valSet {
+			_, pubKeys[i] = bls12381.GenKeyPair()
+			require.NoError(t, ckptKeeper.CreateRegistration(ctx, pubKeys[i], val.Addr))
+		}
+
+		// the validators are returned in the order of the bitmaps along with their BLS public keys
+		res, err := ckptKeeper.BlsPublicKeyList(sdkCtx, &types.QueryBlsPublicKeyListRequest{EpochNum: epoch})
+		require.NoError(t, err)
+		require.Len(t, res.ValidatorWithBlsKeys, len(valSet))
+		for i, val := range res.ValidatorWithBlsKeys {
+			require.Equal(t, valSet[i].Addr.String(), val.ValidatorAddress)
+			require.Equal(t, pubKeys[i].Bytes(), val.BlsPubKey)
+			require.Equal(t, uint64(valSet[i].Power), val.VotingPower)
+		}
+	})
+}
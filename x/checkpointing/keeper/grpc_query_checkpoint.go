@@ -108,6 +108,32 @@ func (k Keeper) RecentEpochStatusCount(ctx context.Context, req *types.QueryRece
 	}, nil
 }
 
+// ConflictingCheckpoints returns the evidences of the conflicting checkpoints in the ascending order of epoch
+func (k Keeper) ConflictingCheckpoints(ctx context.Context, req *types.QueryConflictingCheckpointsRequest) (*types.QueryConflictingCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var evidences []*types.ConflictingCheckpointEvidence
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := k.conflictsStore(sdkCtx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var evidence types.ConflictingCheckpointEvidence
+		if err := k.cdc.Unmarshal(value, &evidence); err != nil {
+			return err
+		}
+		evidences = append(evidences, &evidence)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConflictingCheckpointsResponse{Evidences: evidences, Pagination: pageRes}, nil
+}
+
 func (k Keeper) RecentRawCheckpointList(c context.Context, req *types.QueryRecentRawCheckpointListRequest) (*types.QueryRecentRawCheckpointListResponse, error) {
 	panic("TODO: implement this")
 }
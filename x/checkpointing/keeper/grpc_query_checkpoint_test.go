@@ -11,7 +11,6 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +18,7 @@ func FuzzQueryEpoch(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 1)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		rand.Seed(seed)
-		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
 		sdkCtx := sdk.WrapSDKContext(ctx)
 
 		// test querying a raw checkpoint with epoch number
@@ -55,7 +54,7 @@ func FuzzQueryStatusCount(f *testing.F) {
 		defer ctrl.Finish()
 		ek := mocks.NewMockEpochingKeeper(ctrl)
 		ek.EXPECT().GetEpoch(gomock.Any()).Return(epochingtypes.Epoch{EpochNumber: tipEpoch + 1})
-		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil, client.Context{})
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
 		sdkCtx := sdk.WrapSDKContext(ctx)
 		expectedCounts := make(map[string]uint64)
 		epochCount := uint64(rand.Int63n(int64(tipEpoch)))
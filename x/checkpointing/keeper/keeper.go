@@ -6,7 +6,6 @@ import (
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -20,9 +19,9 @@ type (
 		memKey         sdk.StoreKey
 		blsSigner      BlsSigner
 		epochingKeeper types.EpochingKeeper
+		btcckptKeeper  types.BtcCheckpointKeeper
 		hooks          types.CheckpointingHooks
 		paramstore     paramtypes.Subspace
-		clientCtx      client.Context
 	}
 )
 
@@ -32,8 +31,8 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	signer BlsSigner,
 	ek types.EpochingKeeper,
+	bk types.BtcCheckpointKeeper,
 	ps paramtypes.Subspace,
-	clientCtx client.Context,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -46,9 +45,9 @@ func NewKeeper(
 		memKey:         memKey,
 		blsSigner:      signer,
 		epochingKeeper: ek,
+		btcckptKeeper:  bk,
 		paramstore:     ps,
 		hooks:          nil,
-		clientCtx:      clientCtx,
 	}
 }
 
@@ -98,11 +97,18 @@ func (k Keeper) addBlsSig(ctx sdk.Context, sig *types.BlsSig) error {
 
 	// accumulate BLS signatures
 	updated, err := ckptWithMeta.Accumulate(
-		vals, signerAddr, signerBlsKey, *sig.BlsSig, k.GetTotalVotingPower(ctx, sig.GetEpochNum()))
+		vals, signerAddr, signerBlsKey, *sig.BlsSig, k.GetTotalVotingPower(ctx, sig.GetEpochNum()),
+		k.GetParams(ctx).QuorumThreshold)
 	if err != nil {
 		return err
 	}
 
+	// after a conflicting checkpoint, the checkpoints keep accumulating BLS sigs but are
+	// only sealed once the conflict is resolved
+	if updated && ckptWithMeta.Status == types.Sealed && k.IsCheckpointingStopped(ctx) {
+		ckptWithMeta.Status = types.Accumulating
+	}
+
 	if updated {
 		err = k.UpdateCheckpoint(ctx, ckptWithMeta)
 	}
@@ -111,18 +117,23 @@ func (k Keeper) addBlsSig(ctx sdk.Context, sig *types.BlsSig) error {
 	}
 
 	if updated && ckptWithMeta.Status == types.Sealed {
-		err = ctx.EventManager().EmitTypedEvent(
-			&types.EventCheckpointSealed{Checkpoint: ckptWithMeta},
-		)
-		if err != nil {
-			ctx.Logger().Error("failed to emit checkpoint sealed event for epoch %v", ckptWithMeta.Ckpt.EpochNum)
-		}
-		ctx.Logger().Info(fmt.Sprintf("Checkpointing: checkpoint for epoch %v is Sealed", ckptWithMeta.Ckpt.EpochNum))
+		k.emitCheckpointSealed(ctx, ckptWithMeta)
 	}
 
 	return nil
 }
 
+// emitCheckpointSealed emits the event of a checkpoint reaching the SEALED status
+func (k Keeper) emitCheckpointSealed(ctx sdk.Context, ckptWithMeta *types.RawCheckpointWithMeta) {
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventCheckpointSealed{Checkpoint: ckptWithMeta},
+	)
+	if err != nil {
+		ctx.Logger().Error("failed to emit checkpoint sealed event for epoch %v", ckptWithMeta.Ckpt.EpochNum)
+	}
+	ctx.Logger().Info(fmt.Sprintf("Checkpointing: checkpoint for epoch %v is Sealed", ckptWithMeta.Ckpt.EpochNum))
+}
+
 func (k Keeper) GetRawCheckpoint(ctx sdk.Context, epochNum uint64) (*types.RawCheckpointWithMeta, error) {
 	return k.CheckpointsState(ctx).GetRawCkptWithMeta(epochNum)
 }
@@ -140,8 +151,20 @@ func (k Keeper) AddRawCheckpoint(ctx sdk.Context, ckptWithMeta *types.RawCheckpo
 	return k.CheckpointsState(ctx).CreateRawCkptWithMeta(ckptWithMeta)
 }
 
+// ValidateValSetSize returns an error if the checkpoints of a validator set with
+// numVals validators cannot be encoded in the format versions accepted on BTC
+func (k Keeper) ValidateValSetSize(ctx sdk.Context, numVals int) error {
+	return types.ValidateValSetSize(numVals, k.btcckptKeeper.MaxBitMapLength(ctx))
+}
+
 func (k Keeper) BuildRawCheckpoint(ctx sdk.Context, epochNum uint64, lch types.LastCommitHash) (*types.RawCheckpointWithMeta, error) {
-	ckptWithMeta := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch), types.Accumulating)
+	// the bitmap of the checkpoint holds one bit per validator of the epoch
+	numVals := len(k.GetValidatorSet(ctx, epochNum))
+	if err := k.ValidateValSetSize(ctx, numVals); err != nil {
+		return nil, err
+	}
+
+	ckptWithMeta := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, numVals), types.Accumulating)
 	err := k.AddRawCheckpoint(ctx, ckptWithMeta)
 	if err != nil {
 		return nil, err
@@ -155,7 +178,9 @@ func (k Keeper) BuildRawCheckpoint(ctx sdk.Context, epochNum uint64, lch types.L
 // it equals to the existing raw checkpoint. Otherwise, it further verifies
 // the raw checkpoint and decides whether it is an invalid checkpoint or a
 // conflicting checkpoint. A conflicting checkpoint indicates the existence
-// of a fork
+// of a fork.
+// rawCkptBytes is the checkpoint data connected from its BTC transactions,
+// as decoded by btctxformatter.DecodeCheckpoint
 func (k Keeper) CheckpointEpoch(ctx sdk.Context, rawCkptBytes []byte) (uint64, error) {
 	ckptWithMeta, err := k.verifyCkptBytes(ctx, rawCkptBytes)
 	if err != nil {
@@ -165,12 +190,15 @@ func (k Keeper) CheckpointEpoch(ctx sdk.Context, rawCkptBytes []byte) (uint64, e
 }
 
 // verifyCkptBytes verifies checkpoint from BTC. A checkpoint is valid if
-// it equals to the existing raw checkpoint. Otherwise, it further verifies
-// the raw checkpoint and decides whether it is an invalid checkpoint or a
-// conflicting checkpoint. A conflicting checkpoint indicates the existence
-// of a fork
+// it equals to the existing raw checkpoint. Otherwise, its multi signature is
+// verified against the validator set of the epoch, and it is
+//   - accepted as the checkpoint of the epoch if it commits to the same
+//     LastCommitHash as the existing one, as it is only signed by another subset
+//     of the validators reaching the quorum
+//   - a conflicting checkpoint if it commits to a different LastCommitHash,
+//     which indicates the existence of a fork
 func (k Keeper) verifyCkptBytes(ctx sdk.Context, rawCkptBytes []byte) (*types.RawCheckpointWithMeta, error) {
-	ckpt, err := types.BytesToRawCkpt(k.cdc, rawCkptBytes)
+	ckpt, err := types.FromBTCCkptBytesToRawCkpt(rawCkptBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -189,36 +217,93 @@ func (k Keeper) verifyCkptBytes(ctx sdk.Context, rawCkptBytes []byte) (*types.Ra
 		return ckptWithMeta, nil
 	}
 
-	// next verify if the multi signature is valid
+	signerSet, signersPubKeys, err := k.verifyCkptMultiSig(ctx, ckpt)
+	if err != nil {
+		return nil, err
+	}
+
+	if ckptWithMeta.Ckpt.LastCommitHash.Equal(*ckpt.LastCommitHash) {
+		if err := k.adoptCkpt(ctx, ckptWithMeta, ckpt, signerSet, signersPubKeys); err != nil {
+			return nil, err
+		}
+		return ckptWithMeta, nil
+	}
+
+	// a conflicting checkpoint is found, which triggers the safety mode
+	k.recordConflictingCheckpoint(ctx, ckpt, ckptWithMeta.Ckpt, signerSet)
+	return nil, types.ErrConflictingCheckpoint.Wrapf("epoch %v", ckpt.EpochNum)
+}
+
+// verifyCkptMultiSig checks that the checkpoint is signed by validators of its epoch
+// reaching the quorum and returns them along with their BLS public keys
+func (k Keeper) verifyCkptMultiSig(ctx sdk.Context, ckpt *types.RawCheckpoint) (epochingtypes.ValidatorSet, []bls12381.PublicKey, error) {
 	// check whether sufficient voting power is accumulated
 	totalPower := k.GetTotalVotingPower(ctx, ckpt.EpochNum)
-	signerSet, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	valSet := k.GetValidatorSet(ctx, ckpt.EpochNum)
+	if err := types.ValidateBitmap(ckpt.Bitmap, len(valSet)); err != nil {
+		return nil, nil, err
+	}
+	signerSet, err := valSet.FindSubset(ckpt.Bitmap)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var sum int64
 	signersPubKeys := make([]bls12381.PublicKey, len(signerSet))
 	for i, v := range signerSet {
 		signersPubKeys[i], err = k.GetBlsPubKey(ctx, v.Addr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		sum += v.Power
 	}
-	if sum <= totalPower*1/3 {
-		return nil, errors.New("insufficient voting power")
+	if !k.GetParams(ctx).QuorumThreshold.IsReachedBy(uint64(sum), uint64(totalPower)) {
+		return nil, nil, errors.New("insufficient voting power")
 	}
+	// next verify if the multi signature is valid
 	msgBytes := ckpt.LastCommitHash.MustMarshal()
 	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signersPubKeys, msgBytes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !ok {
-		return nil, errors.New("invalid BLS multi-sig")
+		return nil, nil, errors.New("invalid BLS multi-sig")
+	}
+	return signerSet, signersPubKeys, nil
+}
+
+// adoptCkpt makes the local checkpoint, which is still accumulating BLS sigs, take
+// the bitmap and multi signature of a checkpoint of the same LastCommitHash found on
+// BTC, and seals it. As the checkpoint is already on BTC, it is sealed even if
+// checkpointing is stopped. A local checkpoint which is already sealed is kept as is.
+func (k Keeper) adoptCkpt(
+	ctx sdk.Context,
+	ckptWithMeta *types.RawCheckpointWithMeta,
+	ckpt *types.RawCheckpoint,
+	signerSet epochingtypes.ValidatorSet,
+	signersPubKeys []bls12381.PublicKey,
+) error {
+	if ckptWithMeta.Status != types.Accumulating {
+		return nil
+	}
+
+	var sum uint64
+	for _, v := range signerSet {
+		sum += uint64(v.Power)
+	}
+	aggrPk, err := bls12381.AggrPKList(signersPubKeys)
+	if err != nil {
+		return err
 	}
 
-	// TODO: needs to stall the node since a conflicting checkpoint is found
-	return nil, types.ErrInvalidRawCheckpoint.Wrapf("a conflicting checkpoint is found")
+	ckptWithMeta.Ckpt = ckpt
+	ckptWithMeta.BlsAggrPk = &aggrPk
+	ckptWithMeta.PowerSum = sum
+	ckptWithMeta.Status = types.Sealed
+	if err := k.UpdateCheckpoint(ctx, ckptWithMeta); err != nil {
+		return err
+	}
+	k.emitCheckpointSealed(ctx, ckptWithMeta)
+	return nil
 }
 
 // SetCheckpointSubmitted sets the status of a checkpoint to SUBMITTED
@@ -254,6 +339,8 @@ func (k Keeper) SetCheckpointFinalized(ctx sdk.Context, epoch uint64) {
 	}
 }
 
+// SetCheckpointForgotten rolls back the status of a checkpoint from SUBMITTED to SEALED
+// when all its submissions are no longer on the BTC main chain
 func (k Keeper) SetCheckpointForgotten(ctx sdk.Context, epoch uint64) {
 	ckpt := k.setCheckpointStatus(ctx, epoch, types.Submitted, types.Sealed)
 	err := ctx.EventManager().EmitTypedEvent(
@@ -307,6 +394,27 @@ func (k Keeper) GetValidatorSet(ctx sdk.Context, epochNumber uint64) epochingtyp
 	return k.epochingKeeper.GetValidatorSet(ctx, epochNumber)
 }
 
+// GetBLSPubKeySet returns the validator set of the epoch along with the BLS public
+// keys of the validators, in the order of the bitmaps of the checkpoints
+func (k Keeper) GetBLSPubKeySet(ctx sdk.Context, epochNumber uint64) (*types.ValidatorWithBlsKeySet, error) {
+	valSet := k.GetValidatorSet(ctx, epochNumber)
+	valWithBlsKeys := make([]*types.ValidatorWithBlsKey, len(valSet))
+
+	for i, val := range valSet {
+		pubKey, err := k.GetBlsPubKey(ctx, val.Addr)
+		if err != nil {
+			return nil, err
+		}
+		valWithBlsKeys[i] = &types.ValidatorWithBlsKey{
+			ValidatorAddress: val.Addr.String(),
+			BlsPubKey:        pubKey.Bytes(),
+			VotingPower:      uint64(val.Power),
+		}
+	}
+
+	return &types.ValidatorWithBlsKeySet{ValSet: valWithBlsKeys}, nil
+}
+
 func (k Keeper) GetTotalVotingPower(ctx sdk.Context, epochNumber uint64) int64 {
 	return k.epochingKeeper.GetTotalVotingPower(ctx, epochNumber)
 }
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"math/rand"
 	"testing"
@@ -20,7 +22,11 @@ func FuzzKeeperAddRawCheckpoint(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 1)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		rand.Seed(seed)
-		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(datagen.GenRandomValSet(4)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
 
 		// test nil raw checkpoint
 		err := ckptKeeper.AddRawCheckpoint(ctx, nil)
@@ -60,10 +66,10 @@ func FuzzKeeperCheckpointEpoch(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 1)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		rand.Seed(seed)
-		ckptKeeper, ctx, cdc := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
 
 		mockCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta()
-		ckptBytes := types.RawCkptToBytes(cdc, mockCkptWithMeta.Ckpt)
+		ckptBytes := types.FromRawCkptToBTCCkptBytes(mockCkptWithMeta.Ckpt, datagen.GenRandomByteArray(txformat.AddressLength))
 		epoch, err := ckptKeeper.CheckpointEpoch(ctx, ckptBytes)
 		require.Equal(t, uint64(0), epoch)
 		require.Errorf(t, err, "invalid checkpoint bytes")
@@ -85,7 +91,7 @@ func FuzzKeeperSetCheckpointStatus(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 1)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		rand.Seed(seed)
-		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
 
 		mockCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta()
 		mockCkptWithMeta.Status = types.Accumulating
@@ -120,3 +126,36 @@ func FuzzKeeperSetCheckpointStatus(f *testing.F) {
 		require.Equal(t, types.Finalized, status)
 	})
 }
+
+/*
+	FuzzKeeperCheckpointEpochDecoding checks
+	1. the checkpoint data connected from the BTC transactions, as provided by btccheckpoint, is decoded
+	2. the protobuf encoding of the checkpoint is not accepted
+*/
+func FuzzKeeperCheckpointEpochDecoding(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 1)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rand.Seed(seed)
+		ckptKeeper, ctx, cdc := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+		mockCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta()
+		mockCkptWithMeta.Ckpt.EpochNum = datagen.RandomInt(100) + 1
+		require.NoError(t, ckptKeeper.AddRawCheckpoint(ctx, mockCkptWithMeta))
+		ckpt := mockCkptWithMeta.Ckpt
+
+		for _, version := range []txformat.FormatVersion{txformat.CurrentVersion, txformat.NPartsVersion} {
+			parts, err := txformat.EncodeCheckpoint(txformat.MainTag(), version, ckpt.EpochNum, *ckpt.LastCommitHash,
+				ckpt.Bitmap, *ckpt.BlsMultiSig, datagen.GenRandomByteArray(txformat.AddressLength))
+			require.NoError(t, err)
+			_, ckptBytes, err := txformat.DecodeCheckpoint(txformat.MainTag(), parts)
+			require.NoError(t, err)
+
+			epoch, err := ckptKeeper.CheckpointEpoch(ctx, ckptBytes)
+			require.NoError(t, err)
+			require.Equal(t, ckpt.EpochNum, epoch)
+		}
+
+		_, err := ckptKeeper.CheckpointEpoch(ctx, types.RawCkptToBytes(cdc, ckpt))
+		require.Error(t, err)
+	})
+}
@@ -73,3 +73,18 @@ func (rs RegistrationState) Exists(addr sdk.ValAddress) bool {
 	pkKey := types.AddrToBlsKeyKey(addr)
 	return rs.addrToBlsKeys.Has(pkKey)
 }
+
+// GetAllBlsKeys retrieves the BLS public keys of all the validators by the ascending order of their addresses
+func (rs RegistrationState) GetAllBlsKeys() []*types.ValidatorWithBlsKey {
+	iter := rs.addrToBlsKeys.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys []*types.ValidatorWithBlsKey
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, &types.ValidatorWithBlsKey{
+			ValidatorAddress: sdk.ValAddress(iter.Key()).String(),
+			BlsPubKey:        iter.Value(),
+		})
+	}
+	return keys
+}
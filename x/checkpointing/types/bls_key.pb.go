@@ -120,9 +120,120 @@ func (m *ProofOfPossession) GetEd25519Sig() []byte {
 	return nil
 }
 
+// ValidatorWithBlsKey couples validator address and its bls public key
+type ValidatorWithBlsKey struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BlsPubKey        []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+	// voting power of the validator in the epoch of the validator set it belongs to
+	VotingPower uint64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ValidatorWithBlsKey) Reset()         { *m = ValidatorWithBlsKey{} }
+func (m *ValidatorWithBlsKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWithBlsKey) ProtoMessage()    {}
+func (*ValidatorWithBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7e926461cc70111, []int{2}
+}
+func (m *ValidatorWithBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWithBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWithBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWithBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWithBlsKey.Merge(m, src)
+}
+func (m *ValidatorWithBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWithBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWithBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWithBlsKey proto.InternalMessageInfo
+
+func (m *ValidatorWithBlsKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorWithBlsKey) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
+func (m *ValidatorWithBlsKey) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// ValidatorWithBlsKeySet is the validator set of an epoch along with the bls
+// public keys of the validators, sorted in the same order as the bitmaps of the
+// checkpoints of the epoch
+type ValidatorWithBlsKeySet struct {
+	ValSet []*ValidatorWithBlsKey `protobuf:"bytes,1,rep,name=val_set,json=valSet,proto3" json:"val_set,omitempty"`
+}
+
+func (m *ValidatorWithBlsKeySet) Reset()         { *m = ValidatorWithBlsKeySet{} }
+func (m *ValidatorWithBlsKeySet) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWithBlsKeySet) ProtoMessage()    {}
+func (*ValidatorWithBlsKeySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7e926461cc70111, []int{3}
+}
+func (m *ValidatorWithBlsKeySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWithBlsKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWithBlsKeySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWithBlsKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWithBlsKeySet.Merge(m, src)
+}
+func (m *ValidatorWithBlsKeySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWithBlsKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWithBlsKeySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWithBlsKeySet proto.InternalMessageInfo
+
+func (m *ValidatorWithBlsKeySet) GetValSet() []*ValidatorWithBlsKey {
+	if m != nil {
+		return m.ValSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlsKey)(nil), "babylon.checkpointing.v1.BlsKey")
 	proto.RegisterType((*ProofOfPossession)(nil), "babylon.checkpointing.v1.ProofOfPossession")
+	proto.RegisterType((*ValidatorWithBlsKey)(nil), "babylon.checkpointing.v1.ValidatorWithBlsKey")
+	proto.RegisterType((*ValidatorWithBlsKeySet)(nil), "babylon.checkpointing.v1.ValidatorWithBlsKeySet")
 }
 
 func init() {
@@ -130,27 +241,34 @@ func init() {
 }
 
 var fileDescriptor_a7e926461cc70111 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
-	0x4b, 0xd7, 0x4f, 0xca, 0x29, 0x8e, 0xcf, 0x4e, 0xad, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x80, 0x2a, 0xd2, 0x43, 0x51, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xcd, 0x63, 0xe4, 0x62, 0x73, 0xca, 0x29, 0xf6, 0x4e,
-	0xad, 0x14, 0x0a, 0xe5, 0x62, 0x2b, 0x28, 0x4d, 0xca, 0x4e, 0xad, 0x94, 0x60, 0x52, 0x60, 0xd4,
-	0xe0, 0x71, 0xb2, 0xbd, 0x75, 0x4f, 0xde, 0x32, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0x1f, 0x6a, 0x72, 0x72, 0x46, 0x62, 0x66, 0x9e, 0x3e, 0xdc, 0x2d, 0x45, 0x95, 0x05,
-	0x25, 0xf9, 0x20, 0x47, 0x18, 0x1a, 0x19, 0x5b, 0x18, 0xea, 0x05, 0x94, 0x26, 0xe5, 0x64, 0x26,
-	0x7b, 0xa7, 0x56, 0x06, 0x41, 0x0d, 0x13, 0xb2, 0xe5, 0x62, 0x2e, 0xc8, 0x2f, 0x90, 0x60, 0x56,
-	0x60, 0xd4, 0xe0, 0x36, 0xd2, 0xd6, 0xc3, 0xe5, 0x3e, 0xbd, 0x80, 0xa2, 0xfc, 0xfc, 0x34, 0xff,
-	0xb4, 0x80, 0xfc, 0xe2, 0xe2, 0xd4, 0xe2, 0xe2, 0xcc, 0xfc, 0xbc, 0x20, 0x90, 0x3e, 0xa5, 0x1e,
-	0x46, 0x2e, 0x41, 0x0c, 0x29, 0x21, 0x79, 0x2e, 0xee, 0xd4, 0x14, 0x23, 0x53, 0x53, 0x43, 0xcb,
-	0xf8, 0xe2, 0xcc, 0x74, 0x88, 0x83, 0x83, 0xb8, 0xa0, 0x42, 0xc1, 0x99, 0xe9, 0x42, 0x61, 0x5c,
-	0xec, 0xa0, 0x80, 0x01, 0x49, 0x32, 0x93, 0xef, 0x9b, 0xe0, 0xcc, 0xf4, 0xbc, 0xc4, 0x92, 0xd2,
-	0xa2, 0xd4, 0x20, 0xb6, 0xa4, 0x9c, 0xe2, 0xe0, 0xcc, 0x74, 0x27, 0xff, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x25, 0x64, 0x78, 0x05, 0x5a, 0xc4, 0x95, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xe3, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xf9, 0x65,
-	0x17, 0xde, 0x01, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x86, 0x73, 0x04, 0xb9, 0xea, 0xb9, 0x03, 0x35, 0x08, 0x59, 0x0c, 0x6e, 0x08, 0x4b, 0xa4,
+	0x0a, 0x5b, 0x49, 0x15, 0x89, 0x0e, 0x1d, 0xc8, 0xc0, 0xd2, 0xa1, 0xd6, 0x59, 0x14, 0x89, 0xc5,
+	0xdc, 0x39, 0xd7, 0xcb, 0x29, 0x87, 0xbf, 0x93, 0xef, 0x6c, 0xf0, 0x0f, 0x60, 0x63, 0xe0, 0x17,
+	0xf0, 0x7b, 0x18, 0x3b, 0x22, 0x06, 0x84, 0x92, 0x3f, 0x82, 0x2e, 0x31, 0x91, 0x80, 0x56, 0x48,
+	0x6c, 0xa7, 0xf7, 0x7b, 0xef, 0xd5, 0xfb, 0x3d, 0xfa, 0xf0, 0x13, 0x46, 0x59, 0xab, 0xa0, 0x4c,
+	0x8a, 0x05, 0x2f, 0x96, 0x1a, 0x64, 0x69, 0x65, 0x29, 0x12, 0xa6, 0x4c, 0xbe, 0xe4, 0x6d, 0xac,
+	0x2b, 0xb0, 0x10, 0x84, 0x9d, 0x29, 0xfe, 0xcd, 0x14, 0x37, 0xe3, 0x47, 0x0f, 0x04, 0x08, 0xd8,
+	0x98, 0x12, 0xf7, 0xda, 0xfa, 0x87, 0x9f, 0x11, 0xf6, 0x66, 0xca, 0x9c, 0xf3, 0x36, 0x78, 0x89,
+	0x3d, 0x5d, 0xb3, 0x25, 0x6f, 0xc3, 0x3b, 0x03, 0x34, 0x3a, 0x98, 0x9d, 0x7d, 0xfb, 0x7e, 0x74,
+	0x2a, 0xa4, 0x5d, 0xd4, 0x2c, 0x2e, 0xe0, 0x6d, 0xd2, 0x25, 0x17, 0x0b, 0x2a, 0xcb, 0x64, 0xd7,
+	0xa5, 0x6a, 0xb5, 0x05, 0x57, 0x62, 0x3c, 0x39, 0x79, 0x36, 0x8e, 0xd3, 0x9a, 0x29, 0x59, 0x9c,
+	0xf3, 0x96, 0x74, 0x61, 0xc1, 0x19, 0xee, 0x6b, 0xd0, 0x61, 0x7f, 0x80, 0x46, 0xfe, 0xe4, 0x38,
+	0xbe, 0xad, 0x5f, 0x9c, 0x56, 0x00, 0x57, 0x17, 0x57, 0x29, 0x18, 0xc3, 0x8d, 0x91, 0x50, 0x12,
+	0xf7, 0x6f, 0xf8, 0x11, 0xe1, 0xc3, 0xbf, 0x46, 0xc1, 0x11, 0xf6, 0xf9, 0x7c, 0x32, 0x9d, 0x8e,
+	0x4f, 0x73, 0x23, 0xc5, 0xb6, 0x30, 0xc1, 0x9d, 0x94, 0x49, 0x11, 0x5c, 0xe2, 0x3d, 0x07, 0xc6,
+	0x0d, 0xfb, 0xff, 0xbf, 0x4d, 0x26, 0x45, 0x49, 0x6d, 0x5d, 0x71, 0xe2, 0x31, 0x65, 0x32, 0x29,
+	0x86, 0x1f, 0x10, 0xbe, 0x7f, 0x49, 0x95, 0x9c, 0x53, 0x0b, 0xd5, 0x2b, 0x69, 0x17, 0x1d, 0xbc,
+	0x63, 0x7c, 0xd8, 0xfc, 0x92, 0x73, 0x3a, 0x9f, 0x57, 0xdc, 0x98, 0x10, 0x0d, 0xd0, 0x68, 0x9f,
+	0xdc, 0xdb, 0x0d, 0x9e, 0x6f, 0xf5, 0x20, 0xc2, 0xbe, 0x2b, 0xa7, 0x6b, 0x96, 0xef, 0x70, 0x93,
+	0x7d, 0xa6, 0x4c, 0x5a, 0x33, 0x17, 0xf6, 0x18, 0x1f, 0x34, 0xe0, 0xb8, 0xe4, 0x1a, 0xde, 0xf1,
+	0x6a, 0xb3, 0xc1, 0x5d, 0xe2, 0x6f, 0xb5, 0xd4, 0x49, 0xc3, 0x37, 0xf8, 0xe1, 0x0d, 0x35, 0x32,
+	0x6e, 0x83, 0x17, 0x78, 0xaf, 0xa1, 0x2a, 0x37, 0xdc, 0x86, 0x68, 0xd0, 0x1f, 0xf9, 0x93, 0xa7,
+	0xb7, 0x33, 0xbf, 0x21, 0x82, 0x78, 0x0d, 0x55, 0x19, 0xb7, 0xb3, 0x8b, 0x2f, 0xab, 0x08, 0x5d,
+	0xaf, 0x22, 0xf4, 0x63, 0x15, 0xa1, 0x4f, 0xeb, 0xa8, 0x77, 0xbd, 0x8e, 0x7a, 0x5f, 0xd7, 0x51,
+	0xef, 0xf5, 0xf4, 0x5f, 0x18, 0xdf, 0xff, 0x71, 0xa2, 0xb6, 0xd5, 0xdc, 0x30, 0x6f, 0x73, 0x71,
+	0x27, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xc5, 0x77, 0xd7, 0xc8, 0x02, 0x00, 0x00,
 }
 
 func (m *BlsKey) Marshal() (dAtA []byte, err error) {
@@ -242,6 +360,85 @@ func (m *ProofOfPossession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorWithBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWithBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWithBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintBlsKey(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintBlsKey(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintBlsKey(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWithBlsKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWithBlsKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWithBlsKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValSet) > 0 {
+		for iNdEx := len(m.ValSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlsKey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlsKey(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlsKey(v)
 	base := offset
@@ -287,6 +484,41 @@ func (m *ProofOfPossession) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorWithBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBlsKey(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovBlsKey(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovBlsKey(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *ValidatorWithBlsKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValSet) > 0 {
+		for _, e := range m.ValSet {
+			l = e.Size()
+			n += 1 + l + sovBlsKey(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBlsKey(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -533,6 +765,225 @@ func (m *ProofOfPossession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorWithBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWithBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWithBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWithBlsKeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWithBlsKeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWithBlsKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValSet = append(m.ValSet, &ValidatorWithBlsKey{})
+			if err := m.ValSet[len(m.ValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlsKey(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -250,11 +250,98 @@ func (m *BlsSig) GetSignerAddress() string {
 	return ""
 }
 
+// ConflictingCheckpointEvidence is the evidence of a checkpoint found on BTC
+// which is validly signed by the validator set of its epoch but differs from
+// the checkpoint of the epoch on Babylon. Such a checkpoint indicates a fork.
+type ConflictingCheckpointEvidence struct {
+	// conflicting_ckpt is the checkpoint found on BTC
+	ConflictingCkpt *RawCheckpoint `protobuf:"bytes,1,opt,name=conflicting_ckpt,json=conflictingCkpt,proto3" json:"conflicting_ckpt,omitempty"`
+	// local_ckpt is the checkpoint of the same epoch on Babylon
+	LocalCkpt *RawCheckpoint `protobuf:"bytes,2,opt,name=local_ckpt,json=localCkpt,proto3" json:"local_ckpt,omitempty"`
+	// signers are the addresses of the validators who signed the conflicting
+	// checkpoint, which can be slashed
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// detected_height is the Babylon height at which the conflict is detected
+	DetectedHeight uint64 `protobuf:"varint,4,opt,name=detected_height,json=detectedHeight,proto3" json:"detected_height,omitempty"`
+	// resolved is set by the operators once they have intervened, lifting the
+	// safety mode triggered by the conflict
+	Resolved bool `protobuf:"varint,5,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (m *ConflictingCheckpointEvidence) Reset()         { *m = ConflictingCheckpointEvidence{} }
+func (m *ConflictingCheckpointEvidence) String() string { return proto.CompactTextString(m) }
+func (*ConflictingCheckpointEvidence) ProtoMessage()    {}
+func (*ConflictingCheckpointEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63ff05f0a47b36f7, []int{3}
+}
+func (m *ConflictingCheckpointEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingCheckpointEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingCheckpointEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingCheckpointEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingCheckpointEvidence.Merge(m, src)
+}
+func (m *ConflictingCheckpointEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingCheckpointEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingCheckpointEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingCheckpointEvidence proto.InternalMessageInfo
+
+func (m *ConflictingCheckpointEvidence) GetConflictingCkpt() *RawCheckpoint {
+	if m != nil {
+		return m.ConflictingCkpt
+	}
+	return nil
+}
+
+func (m *ConflictingCheckpointEvidence) GetLocalCkpt() *RawCheckpoint {
+	if m != nil {
+		return m.LocalCkpt
+	}
+	return nil
+}
+
+func (m *ConflictingCheckpointEvidence) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ConflictingCheckpointEvidence) GetDetectedHeight() uint64 {
+	if m != nil {
+		return m.DetectedHeight
+	}
+	return 0
+}
+
+func (m *ConflictingCheckpointEvidence) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("babylon.checkpointing.v1.CheckpointStatus", CheckpointStatus_name, CheckpointStatus_value)
 	proto.RegisterType((*RawCheckpoint)(nil), "babylon.checkpointing.v1.RawCheckpoint")
 	proto.RegisterType((*RawCheckpointWithMeta)(nil), "babylon.checkpointing.v1.RawCheckpointWithMeta")
 	proto.RegisterType((*BlsSig)(nil), "babylon.checkpointing.v1.BlsSig")
+	proto.RegisterType((*ConflictingCheckpointEvidence)(nil), "babylon.checkpointing.v1.ConflictingCheckpointEvidence")
 }
 
 func init() {
@@ -262,47 +349,54 @@ func init() {
 }
 
 var fileDescriptor_63ff05f0a47b36f7 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x5f, 0x6b, 0xd3, 0x50,
-	0x1c, 0x6d, 0xb6, 0x52, 0xd7, 0xbb, 0xb5, 0x84, 0xe0, 0xa4, 0x56, 0xc8, 0xca, 0x40, 0x1d, 0x7b,
-	0x48, 0xd9, 0x86, 0xe0, 0xdf, 0x87, 0x34, 0xed, 0xb4, 0xac, 0xed, 0x46, 0xd2, 0x2a, 0x0c, 0x24,
-	0xdc, 0xa4, 0x31, 0xb9, 0xf4, 0xde, 0xdc, 0x90, 0x7b, 0xe3, 0xac, 0x9f, 0x40, 0xf6, 0xe4, 0xb3,
-	0x30, 0x10, 0xfc, 0x32, 0x3e, 0xee, 0x51, 0xf6, 0x30, 0x64, 0x7b, 0x19, 0xfa, 0x25, 0x24, 0x37,
-	0x65, 0xae, 0x1b, 0x43, 0x10, 0x7d, 0xcb, 0xef, 0xe4, 0x9c, 0x1f, 0xf7, 0x9c, 0x73, 0xb9, 0xe0,
-	0x9e, 0x03, 0x9d, 0x31, 0xa6, 0x61, 0xdd, 0x0d, 0x3c, 0x77, 0x14, 0x51, 0x14, 0x72, 0x14, 0xfa,
-	0x17, 0x26, 0x2d, 0x8a, 0x29, 0xa7, 0x4a, 0x65, 0xc2, 0xd3, 0xa6, 0x78, 0xda, 0xdb, 0xb5, 0xea,
-	0x6d, 0x97, 0x32, 0x42, 0x99, 0x2d, 0x78, 0xf5, 0x6c, 0xc8, 0x44, 0xd5, 0x9b, 0x3e, 0xf5, 0x69,
-	0x86, 0xa7, 0x5f, 0x19, 0xba, 0xfc, 0x53, 0x02, 0x25, 0x13, 0xee, 0x19, 0xe7, 0x8b, 0x94, 0x3b,
-	0xa0, 0xe8, 0x45, 0xd4, 0x0d, 0xec, 0x30, 0x21, 0x15, 0xa9, 0x26, 0xad, 0xe4, 0xcd, 0x39, 0x01,
-	0xf4, 0x12, 0xa2, 0x3c, 0x05, 0x32, 0x86, 0x8c, 0xdb, 0x2e, 0x25, 0x04, 0x71, 0x3b, 0x80, 0x2c,
-	0xa8, 0xcc, 0xd4, 0xa4, 0x95, 0x85, 0x86, 0x72, 0x74, 0xbc, 0x54, 0xee, 0x40, 0xc6, 0x0d, 0xf1,
-	0xeb, 0x05, 0x64, 0x81, 0x59, 0xc6, 0x53, 0xb3, 0x72, 0x0b, 0x14, 0x1c, 0xc4, 0x09, 0x8c, 0x2a,
-	0xb3, 0xa9, 0xc6, 0x9c, 0x4c, 0x0a, 0x04, 0x25, 0x07, 0x33, 0x9b, 0x24, 0x98, 0x23, 0x9b, 0x21,
-	0xbf, 0x92, 0x17, 0x2b, 0x9f, 0x1d, 0x1d, 0x2f, 0x3d, 0xf2, 0x11, 0x0f, 0x12, 0x47, 0x73, 0x29,
-	0xa9, 0x4f, 0x5c, 0xbb, 0x01, 0x44, 0x61, 0xfd, 0x3c, 0xaa, 0x78, 0x1c, 0x71, 0x5a, 0x77, 0x30,
-	0x5b, 0x5b, 0xdf, 0x78, 0xb8, 0xa6, 0x59, 0xc8, 0x0f, 0x21, 0x4f, 0x62, 0xcf, 0x9c, 0x77, 0x30,
-	0xeb, 0xa6, 0x2b, 0x2d, 0xe4, 0x3f, 0xce, 0x9f, 0x7d, 0x5e, 0x92, 0x96, 0x3f, 0xcd, 0x80, 0xc5,
-	0x29, 0xb7, 0xaf, 0x10, 0x0f, 0xba, 0x1e, 0x87, 0xca, 0x13, 0x90, 0x77, 0x47, 0x11, 0x17, 0x86,
-	0xe7, 0xd7, 0xef, 0x6b, 0xd7, 0x25, 0xac, 0x4d, 0xc9, 0x4d, 0x21, 0x52, 0x1a, 0xa0, 0xc0, 0x38,
-	0xe4, 0x09, 0x13, 0x59, 0x94, 0xd7, 0x57, 0xaf, 0x97, 0xff, 0xd6, 0x5a, 0x42, 0x61, 0x4e, 0x94,
-	0xca, 0x6b, 0x90, 0x9e, 0xd7, 0x86, 0xbe, 0x1f, 0xdb, 0xd1, 0x28, 0x0b, 0xe8, 0xef, 0x12, 0xd8,
-	0x49, 0x1c, 0x8c, 0xdc, 0x2d, 0x6f, 0x6c, 0x16, 0x1d, 0xcc, 0x74, 0xdf, 0x8f, 0x77, 0x46, 0x69,
-	0xab, 0x11, 0xdd, 0xf3, 0x62, 0x9b, 0x25, 0x44, 0xc4, 0x9b, 0x37, 0xe7, 0x04, 0x60, 0x25, 0x64,
-	0x12, 0xce, 0x99, 0x04, 0x0a, 0x0d, 0xcc, 0x2c, 0xe4, 0xff, 0xcf, 0x3b, 0xf0, 0x12, 0xdc, 0x48,
-	0x7d, 0xa6, 0x2d, 0xcf, 0xfe, 0x8b, 0x96, 0x0b, 0x4e, 0x76, 0xe4, 0xbb, 0xa0, 0xcc, 0x90, 0x1f,
-	0x7a, 0xb1, 0x0d, 0x87, 0xc3, 0xd8, 0x63, 0x4c, 0xb8, 0x2c, 0x9a, 0xa5, 0x0c, 0xd5, 0x33, 0x50,
-	0x58, 0xcd, 0xad, 0xfe, 0x90, 0x80, 0x7c, 0xb9, 0x09, 0x45, 0x03, 0x15, 0x63, 0x6b, 0xa7, 0x6f,
-	0x5b, 0x7d, 0xbd, 0x3f, 0xb0, 0x6c, 0xdd, 0x30, 0x06, 0xdd, 0x41, 0x47, 0xef, 0xb7, 0x7b, 0xcf,
-	0xe5, 0x5c, 0x55, 0xde, 0x3f, 0xa8, 0x2d, 0xe8, 0xae, 0x9b, 0x90, 0x04, 0xc3, 0xb4, 0x4d, 0x65,
-	0x19, 0x28, 0x17, 0xf9, 0x56, 0x4b, 0xef, 0xb4, 0x9a, 0xb2, 0x54, 0x05, 0xfb, 0x07, 0xb5, 0x82,
-	0xe5, 0x41, 0xec, 0x0d, 0x95, 0x15, 0xb0, 0x38, 0xc5, 0x19, 0x34, 0xba, 0xed, 0x7e, 0xbf, 0xd5,
-	0x94, 0x67, 0xaa, 0xa5, 0xfd, 0x83, 0x5a, 0xd1, 0x4a, 0x1c, 0x82, 0x38, 0xbf, 0xca, 0x34, 0xb6,
-	0x7b, 0x9b, 0x6d, 0xb3, 0xdb, 0x6a, 0xca, 0xb3, 0x19, 0xd3, 0xa0, 0xe1, 0x1b, 0x14, 0x93, 0xab,
-	0xcc, 0xcd, 0x76, 0x4f, 0xef, 0xb4, 0x77, 0x5b, 0x4d, 0x39, 0x9f, 0x31, 0x37, 0x51, 0x08, 0x31,
-	0x7a, 0xef, 0x0d, 0xab, 0xf9, 0x0f, 0x5f, 0xd4, 0x5c, 0x63, 0xfb, 0xeb, 0x89, 0x2a, 0x1d, 0x9e,
-	0xa8, 0xd2, 0xf7, 0x13, 0x55, 0xfa, 0x78, 0xaa, 0xe6, 0x0e, 0x4f, 0xd5, 0xdc, 0xb7, 0x53, 0x35,
-	0xb7, 0xfb, 0xe0, 0x4f, 0xb1, 0xbf, 0xbb, 0xf4, 0x12, 0xf1, 0x71, 0xe4, 0x31, 0xa7, 0x20, 0x9e,
-	0x8e, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x0d, 0xb7, 0x12, 0xaf, 0x04, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x8b, 0xdb, 0x46,
+	0x1c, 0xb5, 0xd6, 0xae, 0xb3, 0x9e, 0xc4, 0x8e, 0x18, 0x9a, 0xa2, 0xba, 0xd4, 0x6b, 0x16, 0xda,
+	0x98, 0x1c, 0x64, 0x76, 0x43, 0xa1, 0x7f, 0x0f, 0xb2, 0x6c, 0x37, 0x26, 0xb6, 0xb3, 0x48, 0x76,
+	0x0b, 0x81, 0x22, 0x46, 0xa3, 0xa9, 0x34, 0x78, 0xa4, 0x11, 0x9a, 0xd1, 0xa6, 0xee, 0x27, 0x28,
+	0x7b, 0xea, 0xb9, 0xb0, 0x50, 0xe8, 0x97, 0xe9, 0x31, 0xc7, 0x92, 0x43, 0x28, 0xbb, 0x97, 0xd0,
+	0x7e, 0x89, 0xa2, 0x91, 0xb3, 0xbb, 0x4e, 0x08, 0xa5, 0x4b, 0x73, 0xf3, 0xef, 0xf9, 0xbd, 0x1f,
+	0xf3, 0xde, 0xfb, 0x21, 0xf0, 0xb1, 0x8f, 0xfc, 0x35, 0xe3, 0x49, 0x1f, 0x47, 0x04, 0xaf, 0x52,
+	0x4e, 0x13, 0x49, 0x93, 0xf0, 0xca, 0x64, 0xa6, 0x19, 0x97, 0x1c, 0x1a, 0x1b, 0x9e, 0xb9, 0xc5,
+	0x33, 0x8f, 0x0f, 0xda, 0xef, 0x63, 0x2e, 0x62, 0x2e, 0x3c, 0xc5, 0xeb, 0x97, 0x43, 0x29, 0x6a,
+	0xbf, 0x1b, 0xf2, 0x90, 0x97, 0x78, 0xf1, 0xab, 0x44, 0xf7, 0xff, 0xd6, 0x40, 0xd3, 0x41, 0x4f,
+	0xec, 0x8b, 0x45, 0xf0, 0x03, 0xd0, 0x20, 0x29, 0xc7, 0x91, 0x97, 0xe4, 0xb1, 0xa1, 0x75, 0xb5,
+	0x5e, 0xcd, 0xd9, 0x55, 0xc0, 0x3c, 0x8f, 0xe1, 0x97, 0x40, 0x67, 0x48, 0x48, 0x0f, 0xf3, 0x38,
+	0xa6, 0xd2, 0x8b, 0x90, 0x88, 0x8c, 0x9d, 0xae, 0xd6, 0xbb, 0x35, 0x80, 0xcf, 0x9e, 0xef, 0xb5,
+	0xa6, 0x48, 0x48, 0x5b, 0xfd, 0xf5, 0x00, 0x89, 0xc8, 0x69, 0xb1, 0xad, 0x19, 0xbe, 0x07, 0xea,
+	0x3e, 0x95, 0x31, 0x4a, 0x8d, 0x6a, 0xa1, 0x71, 0x36, 0x13, 0x44, 0xa0, 0xe9, 0x33, 0xe1, 0xc5,
+	0x39, 0x93, 0xd4, 0x13, 0x34, 0x34, 0x6a, 0x6a, 0xe5, 0x57, 0xcf, 0x9e, 0xef, 0x7d, 0x16, 0x52,
+	0x19, 0xe5, 0xbe, 0x89, 0x79, 0xdc, 0xdf, 0xb8, 0xc6, 0x11, 0xa2, 0x49, 0xff, 0x22, 0xaa, 0x6c,
+	0x9d, 0x4a, 0xde, 0xf7, 0x99, 0x38, 0x38, 0xbc, 0xff, 0xe9, 0x81, 0xe9, 0xd2, 0x30, 0x41, 0x32,
+	0xcf, 0x88, 0x73, 0xd3, 0x67, 0x62, 0x56, 0xac, 0x74, 0x69, 0xf8, 0x79, 0xed, 0xc5, 0xaf, 0x7b,
+	0xda, 0xfe, 0x2f, 0x3b, 0xe0, 0xce, 0x96, 0xdb, 0x6f, 0xa9, 0x8c, 0x66, 0x44, 0x22, 0xf8, 0x05,
+	0xa8, 0xe1, 0x55, 0x2a, 0x95, 0xe1, 0x9b, 0x87, 0x77, 0xcd, 0x37, 0x25, 0x6c, 0x6e, 0xc9, 0x1d,
+	0x25, 0x82, 0x03, 0x50, 0x17, 0x12, 0xc9, 0x5c, 0xa8, 0x2c, 0x5a, 0x87, 0xf7, 0xde, 0x2c, 0xbf,
+	0xd4, 0xba, 0x4a, 0xe1, 0x6c, 0x94, 0xf0, 0x3b, 0x50, 0xbc, 0xd7, 0x43, 0x61, 0x98, 0x79, 0xe9,
+	0xaa, 0x0c, 0xe8, 0x7a, 0x09, 0x1c, 0xe5, 0x3e, 0xa3, 0xf8, 0x21, 0x59, 0x3b, 0x0d, 0x9f, 0x09,
+	0x2b, 0x0c, 0xb3, 0xa3, 0x55, 0xd1, 0x6a, 0xca, 0x9f, 0x90, 0xcc, 0x13, 0x79, 0xac, 0xe2, 0xad,
+	0x39, 0xbb, 0x0a, 0x70, 0xf3, 0x78, 0x13, 0xce, 0x0b, 0x0d, 0xd4, 0x07, 0x4c, 0xb8, 0x34, 0x7c,
+	0x9b, 0x37, 0xf0, 0x0d, 0xb8, 0x51, 0xf8, 0x2c, 0x5a, 0xae, 0xfe, 0x1f, 0x2d, 0xd7, 0xfd, 0xf2,
+	0xc9, 0x1f, 0x81, 0x96, 0xa0, 0x61, 0x42, 0x32, 0x0f, 0x05, 0x41, 0x46, 0x84, 0x50, 0x2e, 0x1b,
+	0x4e, 0xb3, 0x44, 0xad, 0x12, 0x54, 0x56, 0x2b, 0xc5, 0x1d, 0x7c, 0x68, 0xf3, 0xe4, 0x7b, 0x46,
+	0x71, 0x51, 0xcc, 0x65, 0x29, 0xa3, 0x63, 0x1a, 0x90, 0x04, 0x13, 0xe8, 0x00, 0x1d, 0x5f, 0x12,
+	0xbc, 0xeb, 0xdc, 0xc6, 0xed, 0x2b, 0x0b, 0xec, 0xe2, 0x4c, 0xc6, 0x00, 0x30, 0x8e, 0x11, 0x2b,
+	0xb7, 0xed, 0xfc, 0xb7, 0x6d, 0x0d, 0x25, 0x55, 0x7b, 0x0c, 0x70, 0xa3, 0x34, 0x25, 0x8c, 0x6a,
+	0xb7, 0xda, 0x6b, 0x38, 0x2f, 0x47, 0x78, 0x17, 0xdc, 0x0e, 0x88, 0x24, 0x58, 0x92, 0xc0, 0x8b,
+	0x08, 0x0d, 0x23, 0xb9, 0xe9, 0xba, 0xf5, 0x12, 0x7e, 0xa0, 0x50, 0xd8, 0x06, 0xbb, 0x19, 0x11,
+	0x9c, 0x1d, 0x93, 0xc0, 0x78, 0xa7, 0xab, 0xf5, 0x76, 0x9d, 0x8b, 0xf9, 0xde, 0x5f, 0x1a, 0xd0,
+	0x5f, 0x3d, 0x53, 0x68, 0x02, 0xc3, 0x7e, 0x78, 0xb4, 0xf0, 0xdc, 0x85, 0xb5, 0x58, 0xba, 0x9e,
+	0x65, 0xdb, 0xcb, 0xd9, 0x72, 0x6a, 0x2d, 0x26, 0xf3, 0xaf, 0xf5, 0x4a, 0x5b, 0x3f, 0x39, 0xed,
+	0xde, 0xb2, 0x30, 0xce, 0xe3, 0x9c, 0xa1, 0xe2, 0xfd, 0x70, 0x1f, 0xc0, 0xab, 0x7c, 0x77, 0x64,
+	0x4d, 0x47, 0x43, 0x5d, 0x6b, 0x83, 0x93, 0xd3, 0x6e, 0xdd, 0x25, 0x88, 0x91, 0x00, 0xf6, 0xc0,
+	0x9d, 0x2d, 0xce, 0x72, 0x30, 0x9b, 0x2c, 0x16, 0xa3, 0xa1, 0xbe, 0xd3, 0x6e, 0x9e, 0x9c, 0x76,
+	0x1b, 0x6e, 0xee, 0xc7, 0x54, 0xca, 0xd7, 0x99, 0xf6, 0xa3, 0xf9, 0x78, 0xe2, 0xcc, 0x46, 0x43,
+	0xbd, 0x5a, 0x32, 0x8b, 0x2e, 0x69, 0x16, 0xbf, 0xce, 0x1c, 0x4f, 0xe6, 0xd6, 0x74, 0xf2, 0x78,
+	0x34, 0xd4, 0x6b, 0x25, 0x73, 0x4c, 0x13, 0xc4, 0xe8, 0x8f, 0x24, 0x68, 0xd7, 0x7e, 0xfa, 0xad,
+	0x53, 0x19, 0x3c, 0xfa, 0xfd, 0xac, 0xa3, 0x3d, 0x3d, 0xeb, 0x68, 0x7f, 0x9e, 0x75, 0xb4, 0x9f,
+	0xcf, 0x3b, 0x95, 0xa7, 0xe7, 0x9d, 0xca, 0x1f, 0xe7, 0x9d, 0xca, 0xe3, 0x4f, 0xfe, 0xed, 0x26,
+	0x7f, 0x78, 0xe5, 0x33, 0x2d, 0xd7, 0x29, 0x11, 0x7e, 0x5d, 0x7d, 0x57, 0xef, 0xff, 0x13, 0x00,
+	0x00, 0xff, 0xff, 0xe3, 0xee, 0x16, 0x29, 0xcc, 0x05, 0x00, 0x00,
 }
 
 func (this *RawCheckpoint) Equal(that interface{}) bool {
@@ -558,6 +652,77 @@ func (m *BlsSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConflictingCheckpointEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingCheckpointEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingCheckpointEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolved {
+		i--
+		if m.Resolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DetectedHeight != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.DetectedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LocalCkpt != nil {
+		{
+			size, err := m.LocalCkpt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConflictingCkpt != nil {
+		{
+			size, err := m.ConflictingCkpt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCheckpoint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCheckpoint(v)
 	base := offset
@@ -640,6 +805,35 @@ func (m *BlsSig) Size() (n int) {
 	return n
 }
 
+func (m *ConflictingCheckpointEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingCkpt != nil {
+		l = m.ConflictingCkpt.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.LocalCkpt != nil {
+		l = m.LocalCkpt.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovCheckpoint(uint64(l))
+		}
+	}
+	if m.DetectedHeight != 0 {
+		n += 1 + sovCheckpoint(uint64(m.DetectedHeight))
+	}
+	if m.Resolved {
+		n += 2
+	}
+	return n
+}
+
 func sovCheckpoint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1149,6 +1343,199 @@ func (m *BlsSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConflictingCheckpointEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingCheckpointEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingCheckpointEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingCkpt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingCkpt == nil {
+				m.ConflictingCkpt = &RawCheckpoint{}
+			}
+			if err := m.ConflictingCkpt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalCkpt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocalCkpt == nil {
+				m.LocalCkpt = &RawCheckpoint{}
+			}
+			if err := m.LocalCkpt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedHeight", wireType)
+			}
+			m.DetectedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCheckpoint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -18,6 +19,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgWrappedCreateValidator{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	// Register evidences submitted through the evidence module
+	registry.RegisterImplementations((*exported.Evidence)(nil),
+		&BlsDoubleSignEvidence{},
+	)
 }
 
 var (
@@ -14,4 +14,7 @@ var (
 	ErrBlsKeyDoesNotExist     = sdkerrors.Register(ModuleName, 1208, "BLS public key does not exist")
 	ErrBlsKeyAlreadyExist     = sdkerrors.Register(ModuleName, 1209, "BLS public key already exists")
 	ErrBlsPrivKeyDoesNotExist = sdkerrors.Register(ModuleName, 1210, "BLS private key does not exist")
+	ErrInvalidBlsKey          = sdkerrors.Register(ModuleName, 1211, "BLS public key is invalid")
+	ErrValSetTooLarge         = sdkerrors.Register(ModuleName, 1212, "validator set is too large to be checkpointed")
+	ErrConflictingCheckpoint  = sdkerrors.Register(ModuleName, 1213, "a conflicting checkpoint is found")
 )
@@ -286,6 +286,50 @@ func (m *EventCheckpointForgotten) GetCheckpoint() *RawCheckpointWithMeta {
 	return nil
 }
 
+type EventConflictingCheckpoint struct {
+	Evidence *ConflictingCheckpointEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *EventConflictingCheckpoint) Reset()         { *m = EventConflictingCheckpoint{} }
+func (m *EventConflictingCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EventConflictingCheckpoint) ProtoMessage()    {}
+func (*EventConflictingCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d41a0fa2283f67f, []int{6}
+}
+func (m *EventConflictingCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictingCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictingCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictingCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictingCheckpoint.Merge(m, src)
+}
+func (m *EventConflictingCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictingCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictingCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictingCheckpoint proto.InternalMessageInfo
+
+func (m *EventConflictingCheckpoint) GetEvidence() *ConflictingCheckpointEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCheckpointAccumulating)(nil), "babylon.checkpointing.v1.EventCheckpointAccumulating")
 	proto.RegisterType((*EventCheckpointSealed)(nil), "babylon.checkpointing.v1.EventCheckpointSealed")
@@ -293,6 +337,7 @@ func init() {
 	proto.RegisterType((*EventCheckpointConfirmed)(nil), "babylon.checkpointing.v1.EventCheckpointConfirmed")
 	proto.RegisterType((*EventCheckpointFinalized)(nil), "babylon.checkpointing.v1.EventCheckpointFinalized")
 	proto.RegisterType((*EventCheckpointForgotten)(nil), "babylon.checkpointing.v1.EventCheckpointForgotten")
+	proto.RegisterType((*EventConflictingCheckpoint)(nil), "babylon.checkpointing.v1.EventConflictingCheckpoint")
 }
 
 func init() {
@@ -300,23 +345,26 @@ func init() {
 }
 
 var fileDescriptor_9d41a0fa2283f67f = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
-	0x4b, 0xd7, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
-	0x80, 0xaa, 0xd1, 0x43, 0x51, 0xa3, 0x57, 0x66, 0x28, 0xa5, 0x86, 0x5d, 0x37, 0x82, 0x07, 0x31,
-	0x41, 0x29, 0x8f, 0x4b, 0xda, 0x15, 0x64, 0xa2, 0x33, 0x5c, 0xc2, 0x31, 0x39, 0xb9, 0x34, 0xb7,
-	0x34, 0x27, 0x11, 0xa4, 0x5e, 0xc8, 0x9f, 0x8b, 0x0b, 0xa1, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5f, 0x0f, 0x97, 0xad, 0x7a, 0x41, 0x89, 0xe5, 0x08, 0x83, 0xc2, 0x33, 0x4b, 0x32,
-	0x7c, 0x53, 0x4b, 0x12, 0x83, 0x90, 0x8c, 0x50, 0xca, 0xe0, 0x12, 0x45, 0xb3, 0x2f, 0x38, 0x35,
-	0x31, 0x27, 0x35, 0x85, 0xfa, 0x36, 0x65, 0x73, 0x49, 0xa0, 0xdb, 0x54, 0x9a, 0x94, 0x9b, 0x59,
-	0x52, 0x42, 0x1f, 0xcb, 0x9c, 0xf3, 0xf3, 0xd2, 0x32, 0x8b, 0x72, 0xe9, 0x63, 0x99, 0x5b, 0x66,
-	0x5e, 0x62, 0x4e, 0x66, 0x15, 0x9d, 0x2c, 0xcb, 0x2f, 0x4a, 0xcf, 0x2f, 0x29, 0x49, 0xcd, 0xa3,
-	0xba, 0x65, 0x4e, 0xfe, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9a,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5, 0x20, 0x39, 0x23, 0x31,
-	0x33, 0x0f, 0xc6, 0xd1, 0xaf, 0x40, 0x4b, 0xe9, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x54, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x76, 0x0b, 0x2a, 0x4d, 0x03, 0x00, 0x00,
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xd3, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0x07, 0xf0, 0x66, 0x11, 0x39, 0xb7, 0x82, 0x10, 0x2a, 0x1c, 0x92, 0x41, 0x9c, 0x2e, 0xa8,
+	0x88, 0xb3, 0x96, 0xba, 0x49, 0x21, 0x1d, 0x04, 0xb7, 0xcb, 0xf5, 0x9a, 0x3c, 0x9a, 0xbc, 0x8b,
+	0xe9, 0x4b, 0xb4, 0x7e, 0x0a, 0x3f, 0x96, 0x63, 0x47, 0x47, 0x49, 0xbe, 0x88, 0xc4, 0xc6, 0x46,
+	0x43, 0xbb, 0x95, 0x8c, 0xc7, 0xfd, 0xff, 0xff, 0xdf, 0xf4, 0x98, 0xe3, 0x4b, 0x7f, 0x19, 0x19,
+	0x74, 0x55, 0xa8, 0xd5, 0x3c, 0x31, 0x80, 0x04, 0x18, 0xb8, 0x3a, 0xd7, 0x48, 0x0b, 0x91, 0xa4,
+	0x86, 0x4c, 0xdf, 0xae, 0x33, 0xe2, 0x5f, 0x46, 0xe4, 0x17, 0x83, 0xb3, 0xed, 0xed, 0xe6, 0xb5,
+	0x5e, 0x70, 0x90, 0x9d, 0x8c, 0xaa, 0xc5, 0xe1, 0xe6, 0xe3, 0x56, 0xa9, 0x2c, 0xce, 0x22, 0x59,
+	0xe5, 0xfb, 0x63, 0xc6, 0x9a, 0x8a, 0x6d, 0x9d, 0x5a, 0xe7, 0x47, 0x97, 0xae, 0xd8, 0xa5, 0x0a,
+	0x4f, 0xbe, 0x34, 0x43, 0x8f, 0x40, 0xe1, 0x83, 0x26, 0xe9, 0xfd, 0x99, 0x70, 0x42, 0x76, 0xdc,
+	0xf2, 0x26, 0x5a, 0x46, 0x7a, 0xba, 0x7f, 0x69, 0xce, 0xec, 0xb6, 0x94, 0xf9, 0x31, 0x10, 0x75,
+	0x83, 0x0d, 0x0d, 0xce, 0x20, 0x8d, 0xbb, 0xc1, 0xee, 0x01, 0x65, 0x04, 0x6f, 0x1d, 0x61, 0x26,
+	0x0d, 0x0c, 0x91, 0xc6, 0xfd, 0x63, 0xcf, 0x6c, 0xb0, 0xc6, 0x0c, 0xce, 0x22, 0x50, 0x55, 0xb1,
+	0x69, 0xf4, 0x27, 0xec, 0x50, 0xe7, 0x30, 0xd5, 0xa8, 0x74, 0x8d, 0xdd, 0xec, 0xc6, 0xb6, 0x4e,
+	0x8c, 0xea, 0xba, 0xb7, 0x19, 0xba, 0x1b, 0x7f, 0x14, 0xdc, 0x5a, 0x15, 0xdc, 0xfa, 0x2a, 0xb8,
+	0xf5, 0x5e, 0xf2, 0xde, 0xaa, 0xe4, 0xbd, 0xcf, 0x92, 0xf7, 0x9e, 0xae, 0x03, 0xa0, 0x30, 0xf3,
+	0x85, 0x32, 0xb1, 0x5b, 0x33, 0x2a, 0x94, 0x80, 0xbf, 0x0f, 0xf7, 0xb5, 0x75, 0x5c, 0xb4, 0x4c,
+	0xf4, 0xc2, 0x3f, 0xf8, 0x39, 0xac, 0xab, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x5e, 0xa5,
+	0x9f, 0xc0, 0x03, 0x00, 0x00,
 }
 
 func (m *EventCheckpointAccumulating) Marshal() (dAtA []byte, err error) {
@@ -529,6 +577,41 @@ func (m *EventCheckpointForgotten) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventConflictingCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictingCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -618,6 +701,19 @@ func (m *EventCheckpointForgotten) Size() (n int) {
 	return n
 }
 
+func (m *EventConflictingCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1140,6 +1236,92 @@ func (m *EventCheckpointForgotten) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictingCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &ConflictingCheckpointEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// evidence routes and types
+const (
+	RouteBlsDoubleSign = "blsdoublesign"
+	TypeBlsDoubleSign  = "blsdoublesign"
+)
+
+var _ exported.Evidence = (*BlsDoubleSignEvidence)(nil)
+
+// NewBlsDoubleSignEvidence creates the evidence of a validator signing both
+// the given BLS sigs, where height is the height of the last block of the epoch
+func NewBlsDoubleSignEvidence(firstSig *BlsSig, secondSig *BlsSig, height int64) *BlsDoubleSignEvidence {
+	return &BlsDoubleSignEvidence{
+		FirstSig:  firstSig,
+		SecondSig: secondSig,
+		Height:    height,
+	}
+}
+
+// Route returns the evidence route
+func (e *BlsDoubleSignEvidence) Route() string { return RouteBlsDoubleSign }
+
+// Type returns the evidence type
+func (e *BlsDoubleSignEvidence) Type() string { return TypeBlsDoubleSign }
+
+// Hash returns the hash of the evidence
+func (e *BlsDoubleSignEvidence) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// GetHeight returns the infraction height, i.e., the height of the last block
+// of the epoch whose LastCommitHash is signed twice
+func (e *BlsDoubleSignEvidence) GetHeight() int64 {
+	return e.Height
+}
+
+// GetEpochNum returns the epoch whose LastCommitHash is signed twice
+func (e *BlsDoubleSignEvidence) GetEpochNum() uint64 {
+	return e.FirstSig.EpochNum
+}
+
+// GetSignerAddress returns the address of the validator signing twice
+func (e *BlsDoubleSignEvidence) GetSignerAddress() (sdk.ValAddress, error) {
+	return sdk.ValAddressFromBech32(e.FirstSig.SignerAddress)
+}
+
+// ValidateBasic checks that both BLS sigs are well-formed and signed by the
+// same validator on different LastCommitHash values of the same epoch
+func (e *BlsDoubleSignEvidence) ValidateBasic() error {
+	if e.Height <= 0 {
+		return errors.New("infraction height should be positive")
+	}
+	if e.FirstSig == nil || e.SecondSig == nil {
+		return errors.New("empty BLS sig")
+	}
+	for _, sig := range []*BlsSig{e.FirstSig, e.SecondSig} {
+		if sig.LastCommitHash == nil || sig.BlsSig == nil {
+			return errors.New("incomplete BLS sig")
+		}
+		if err := sig.LastCommitHash.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := sig.BlsSig.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if _, err := e.GetSignerAddress(); err != nil {
+		return err
+	}
+	if e.FirstSig.SignerAddress != e.SecondSig.SignerAddress {
+		return errors.New("BLS sigs are signed by different validators")
+	}
+	if e.FirstSig.EpochNum != e.SecondSig.EpochNum {
+		return errors.New("BLS sigs are signed on different epochs")
+	}
+	if e.FirstSig.LastCommitHash.Equal(*e.SecondSig.LastCommitHash) {
+		return errors.New("BLS sigs are signed on the same LastCommitHash")
+	}
+
+	return nil
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/checkpointing/evidence.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlsDoubleSignEvidence is the evidence that a validator has BLS-signed two
+// different LastCommitHash values of the same epoch. It is submitted through
+// the evidence module and leads to the validator being slashed and tombstoned.
+type BlsDoubleSignEvidence struct {
+	FirstSig  *BlsSig `protobuf:"bytes,1,opt,name=first_sig,json=firstSig,proto3" json:"first_sig,omitempty"`
+	SecondSig *BlsSig `protobuf:"bytes,2,opt,name=second_sig,json=secondSig,proto3" json:"second_sig,omitempty"`
+	// height is the infraction height, i.e., the height of the last block of the
+	// epoch, which is checked against the epoch recorded on chain
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlsDoubleSignEvidence) Reset()         { *m = BlsDoubleSignEvidence{} }
+func (m *BlsDoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*BlsDoubleSignEvidence) ProtoMessage()    {}
+func (*BlsDoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a902eddabf8c422, []int{0}
+}
+func (m *BlsDoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlsDoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlsDoubleSignEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlsDoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsDoubleSignEvidence.Merge(m, src)
+}
+func (m *BlsDoubleSignEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlsDoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsDoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlsDoubleSignEvidence proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BlsDoubleSignEvidence)(nil), "babylon.checkpointing.v1.BlsDoubleSignEvidence")
+}
+
+func init() {
+	proto.RegisterFile("babylon/checkpointing/evidence.proto", fileDescriptor_5a902eddabf8c422)
+}
+
+var fileDescriptor_5a902eddabf8c422 = []byte{
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4a, 0x4c, 0xaa,
+	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
+	0x4b, 0xd7, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x92, 0x80, 0xaa, 0xd2, 0x43, 0x51, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
+	0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0xa9, 0x61, 0x37, 0x15, 0xc1, 0x83, 0xa8, 0x53,
+	0xda, 0xc9, 0xc8, 0x25, 0xea, 0x94, 0x53, 0xec, 0x92, 0x5f, 0x9a, 0x94, 0x93, 0x1a, 0x9c, 0x99,
+	0x9e, 0xe7, 0x0a, 0xb5, 0x57, 0xc8, 0x96, 0x8b, 0x33, 0x2d, 0xb3, 0xa8, 0xb8, 0x24, 0xbe, 0x38,
+	0x33, 0x5d, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0x97, 0x2b, 0xf4, 0x9c, 0x72,
+	0x8a, 0x83, 0x33, 0xd3, 0x83, 0x38, 0xc0, 0x5a, 0x82, 0x33, 0xd3, 0x85, 0xec, 0xb9, 0xb8, 0x8a,
+	0x53, 0x93, 0xf3, 0xf3, 0x52, 0xc0, 0xfa, 0x99, 0x88, 0xd4, 0xcf, 0x09, 0xd1, 0x03, 0x32, 0x40,
+	0x8c, 0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x39, 0x08,
+	0xca, 0xb3, 0xe2, 0xe8, 0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81, 0x3c, 0x83, 0x93, 0xff, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0x43, 0xad, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0xd0,
+	0xc2, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x26, 0xc6, 0x80, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x30, 0xd8, 0xbe, 0xb9, 0x93, 0x01, 0x00, 0x00,
+}
+
+func (m *BlsDoubleSignEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlsDoubleSignEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlsDoubleSignEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SecondSig != nil {
+		{
+			size, err := m.SecondSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FirstSig != nil {
+		{
+			size, err := m.FirstSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlsDoubleSignEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstSig != nil {
+		l = m.FirstSig.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.SecondSig != nil {
+		l = m.SecondSig.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlsDoubleSignEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlsDoubleSignEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlsDoubleSignEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstSig == nil {
+				m.FirstSig = &BlsSig{}
+			}
+			if err := m.FirstSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondSig == nil {
+				m.SecondSig = &BlsSig{}
+			}
+			if err := m.SecondSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestBlsDoubleSignEvidence_ValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	sk, _ := bls12381.GenKeyPair()
+
+	evidence := datagen.GenBlsDoubleSignEvidence(1, 10, valAddr, sk)
+	require.NoError(t, evidence.ValidateBasic())
+	require.Equal(t, uint64(1), evidence.GetEpochNum())
+	require.Equal(t, int64(10), evidence.GetHeight())
+	signer, err := evidence.GetSignerAddress()
+	require.NoError(t, err)
+	require.Equal(t, valAddr, signer)
+
+	testCases := []struct {
+		name   string
+		tamper func(e *types.BlsDoubleSignEvidence)
+	}{
+		{"zero height", func(e *types.BlsDoubleSignEvidence) {
+			e.Height = 0
+		}},
+		{"missing BLS sig", func(e *types.BlsDoubleSignEvidence) {
+			e.SecondSig = nil
+		}},
+		{"missing LastCommitHash", func(e *types.BlsDoubleSignEvidence) {
+			e.FirstSig.LastCommitHash = nil
+		}},
+		{"invalid BLS sig", func(e *types.BlsDoubleSignEvidence) {
+			sig := (*e.FirstSig.BlsSig)[1:]
+			e.FirstSig.BlsSig = &sig
+		}},
+		{"different signers", func(e *types.BlsDoubleSignEvidence) {
+			e.SecondSig.SignerAddress = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		}},
+		{"invalid signer", func(e *types.BlsDoubleSignEvidence) {
+			e.FirstSig.SignerAddress = "invalid"
+			e.SecondSig.SignerAddress = "invalid"
+		}},
+		{"different epochs", func(e *types.BlsDoubleSignEvidence) {
+			e.SecondSig.EpochNum++
+		}},
+		{"same LastCommitHash", func(e *types.BlsDoubleSignEvidence) {
+			e.SecondSig.LastCommitHash = e.FirstSig.LastCommitHash
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := datagen.GenBlsDoubleSignEvidence(1, 10, valAddr, sk)
+			tc.tamper(e)
+			require.Error(t, e.ValidateBasic())
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"time"
+
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -21,11 +24,36 @@ type BankKeeper interface {
 // EpochingKeeper defines the expected interface needed to retrieve epoch info
 type EpochingKeeper interface {
 	GetEpoch(ctx sdk.Context) epochingtypes.Epoch
+	GetHistoricalEpoch(ctx sdk.Context, epochNumber uint64) (*epochingtypes.Epoch, error)
 	EnqueueMsg(ctx sdk.Context, msg epochingtypes.QueuedMessage)
 	GetValidatorSet(ctx sdk.Context, epochNumer uint64) epochingtypes.ValidatorSet
 	GetTotalVotingPower(ctx sdk.Context, epochNumber uint64) int64
 }
 
+// BtcCheckpointKeeper defines the expected interface needed to know which checkpoints
+// can be submitted to BTC
+type BtcCheckpointKeeper interface {
+	// MaxBitMapLength returns the length of the longest bitmap of the checkpoints
+	// which can be encoded in the accepted format versions
+	MaxBitMapLength(ctx sdk.Context) int
+}
+
+// StakingKeeper defines the expected interface needed to find the validators to be slashed
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
+}
+
+// SlashingKeeper defines the expected interface needed to slash and tombstone validators
+type SlashingKeeper interface {
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress)
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power int64, distributionHeight int64)
+	SlashFractionDoubleSign(ctx sdk.Context) sdk.Dec
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+}
+
 // Event Hooks
 // These can be utilized to communicate between a checkpointing keeper and another
 // keeper which must take particular actions when raw checkpoints change
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -13,6 +20,81 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.validateBlsKeys(); err != nil {
+		return err
+	}
+
+	epochs := make(map[uint64]bool, len(gs.Checkpoints))
+	for _, ckpt := range gs.Checkpoints {
+		if ckpt == nil || ckpt.Ckpt == nil {
+			return ErrInvalidRawCheckpoint.Wrap("empty raw checkpoint")
+		}
+		if epochs[ckpt.Ckpt.EpochNum] {
+			return ErrCkptAlreadyExist.Wrapf("duplicate raw checkpoint at epoch %d", ckpt.Ckpt.EpochNum)
+		}
+		epochs[ckpt.Ckpt.EpochNum] = true
+	}
 
-	return gs.Params.Validate()
+	for _, evidence := range gs.ConflictingCheckpoints {
+		if evidence == nil || evidence.ConflictingCkpt == nil || evidence.LocalCkpt == nil {
+			return ErrInvalidRawCheckpoint.Wrap("empty conflicting checkpoint evidence")
+		}
+		if err := evidence.ConflictingCkpt.ValidateBasic(); err != nil {
+			return err
+		}
+		if evidence.ConflictingCkpt.EpochNum != evidence.LocalCkpt.EpochNum {
+			return ErrInvalidRawCheckpoint.Wrapf("conflicting checkpoint of epoch %d does not conflict with local checkpoint of epoch %d",
+				evidence.ConflictingCkpt.EpochNum, evidence.LocalCkpt.EpochNum)
+		}
+	}
+
+	return nil
+}
+
+// validateBlsKeys checks that each validator has at most one BLS key
+// and that each BLS key belongs to a single validator,
+// across both the genesis keys and the registered keys
+func (gs GenesisState) validateBlsKeys() error {
+	validators := make(map[string]bool, len(gs.GenesisKeys)+len(gs.RegisteredKeys))
+	blsKeys := make(map[string]bool, len(gs.GenesisKeys)+len(gs.RegisteredKeys))
+	addKey := func(valAddrStr string, blsKey bls12381.PublicKey) error {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", valAddrStr, err)
+		}
+		if len(blsKey) != bls12381.PubKeySize {
+			return ErrInvalidBlsKey.Wrapf("invalid BLS public key length of validator %s", valAddrStr)
+		}
+		if validators[valAddr.String()] {
+			return ErrBlsKeyAlreadyExist.Wrapf("validator %s has more than one BLS public key", valAddrStr)
+		}
+		if blsKeys[string(blsKey)] {
+			return ErrBlsKeyAlreadyExist.Wrapf("BLS public key of validator %s is registered by another validator", valAddrStr)
+		}
+		validators[valAddr.String()] = true
+		blsKeys[string(blsKey)] = true
+		return nil
+	}
+
+	for _, key := range gs.GenesisKeys {
+		if key == nil || key.BlsKey == nil || key.BlsKey.Pubkey == nil {
+			return ErrInvalidBlsKey.Wrap("empty genesis key")
+		}
+		if err := addKey(key.ValidatorAddress, *key.BlsKey.Pubkey); err != nil {
+			return err
+		}
+	}
+	for _, key := range gs.RegisteredKeys {
+		if key == nil {
+			return ErrInvalidBlsKey.Wrap("empty registered key")
+		}
+		if err := addKey(key.ValidatorAddress, key.BlsPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -30,6 +30,14 @@ type GenesisState struct {
 	// params defines all the paramaters of related to checkpointing
 	Params      Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	GenesisKeys []*GenesisKey `protobuf:"bytes,2,rep,name=genesis_keys,json=genesisKeys,proto3" json:"genesis_keys,omitempty"`
+	// checkpoints are the raw checkpoints with meta of all the epochs
+	Checkpoints []*RawCheckpointWithMeta `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	// registered_keys are the BLS keys registered by validators that do not
+	// belong to genesis_keys, including the ones registered after genesis
+	RegisteredKeys []*ValidatorWithBlsKey `protobuf:"bytes,4,rep,name=registered_keys,json=registeredKeys,proto3" json:"registered_keys,omitempty"`
+	// conflicting_checkpoints are the evidences of the conflicting checkpoints
+	// found on BTC
+	ConflictingCheckpoints []*ConflictingCheckpointEvidence `protobuf:"bytes,5,rep,name=conflicting_checkpoints,json=conflictingCheckpoints,proto3" json:"conflicting_checkpoints,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +87,27 @@ func (m *GenesisState) GetGenesisKeys() []*GenesisKey {
 	return nil
 }
 
+func (m *GenesisState) GetCheckpoints() []*RawCheckpointWithMeta {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegisteredKeys() []*ValidatorWithBlsKey {
+	if m != nil {
+		return m.RegisteredKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConflictingCheckpoints() []*ConflictingCheckpointEvidence {
+	if m != nil {
+		return m.ConflictingCheckpoints
+	}
+	return nil
+}
+
 type GenesisKey struct {
 	// validator_address is the address corresponding to a validator
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -152,31 +181,37 @@ func init() {
 }
 
 var fileDescriptor_cdd0fb065da1de51 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0xdb, 0x4d, 0x26, 0xcb, 0x76, 0xd0, 0xe2, 0xa1, 0x0e, 0xac, 0x65, 0x7a, 0x18, 0x08,
-	0x09, 0x9b, 0xec, 0x30, 0x10, 0xc1, 0x5d, 0x76, 0xf0, 0xe0, 0x98, 0x37, 0x2f, 0x25, 0x69, 0x43,
-	0x57, 0xd6, 0x35, 0xa5, 0xc9, 0x8a, 0x7d, 0x0b, 0x6f, 0xbe, 0x87, 0x4f, 0xb1, 0xe3, 0x8e, 0x9e,
-	0x44, 0xb6, 0x17, 0x91, 0x26, 0xd9, 0x44, 0x71, 0x78, 0x6a, 0xf2, 0xe5, 0xf7, 0xff, 0xbe, 0xef,
-	0xdf, 0x3f, 0xb8, 0x20, 0x98, 0x14, 0x31, 0x4b, 0x90, 0x3f, 0xa5, 0xfe, 0x2c, 0x65, 0x51, 0x22,
-	0xa2, 0x24, 0x44, 0x21, 0x4d, 0x28, 0x8f, 0x38, 0x4c, 0x33, 0x26, 0x98, 0x65, 0x6b, 0x08, 0xfe,
-	0x80, 0x60, 0xde, 0x6d, 0x9d, 0xfa, 0x8c, 0xcf, 0x19, 0xf7, 0x24, 0x87, 0xd4, 0x45, 0x89, 0x5a,
-	0x27, 0x21, 0x0b, 0x99, 0xaa, 0x97, 0x27, 0x5d, 0x75, 0x15, 0x83, 0xfc, 0xac, 0x48, 0x05, 0x43,
-	0x34, 0xe8, 0xf5, 0xfb, 0xdd, 0x01, 0x9a, 0xd1, 0x62, 0xab, 0x6b, 0xff, 0xbd, 0x51, 0x8a, 0x33,
-	0x3c, 0xdf, 0x32, 0x7b, 0xb6, 0x26, 0x31, 0xf7, 0x66, 0xb4, 0x50, 0x50, 0xfb, 0xd5, 0x04, 0xcd,
-	0x91, 0xf2, 0xf1, 0x28, 0xb0, 0xa0, 0xd6, 0x2d, 0xa8, 0xa9, 0x2e, 0xb6, 0xe9, 0x9a, 0x9d, 0x46,
-	0xcf, 0x85, 0xfb, 0x7c, 0xc1, 0xb1, 0xe4, 0x86, 0x07, 0xcb, 0x8f, 0x73, 0x63, 0xa2, 0x55, 0xd6,
-	0x08, 0x34, 0xf5, 0x7f, 0x29, 0xa7, 0x70, 0xbb, 0xe2, 0x56, 0x3b, 0x8d, 0xde, 0xe5, 0xfe, 0x2e,
-	0x7a, 0xfa, 0x3d, 0x2d, 0x26, 0x8d, 0x70, 0x77, 0xe6, 0xed, 0x37, 0x13, 0x80, 0xef, 0x37, 0xeb,
-	0x0a, 0x1c, 0xe7, 0x38, 0x8e, 0x02, 0x2c, 0x58, 0xe6, 0xe1, 0x20, 0xc8, 0x28, 0x57, 0x2b, 0xd6,
-	0x27, 0x47, 0xbb, 0x87, 0x3b, 0x55, 0xb7, 0x06, 0xe0, 0x50, 0xdb, 0xb4, 0x2b, 0xff, 0xb9, 0x18,
-	0xc6, 0x72, 0x76, 0x8d, 0xc8, 0xaf, 0x75, 0x03, 0x40, 0x8e, 0x63, 0x2f, 0x5d, 0x90, 0x52, 0x5d,
-	0x95, 0xea, 0x33, 0xa8, 0x43, 0x53, 0x81, 0x40, 0x1d, 0x08, 0x1c, 0x2f, 0x48, 0x29, 0xad, 0xe7,
-	0x38, 0x1e, 0x4b, 0x7e, 0xf8, 0xb0, 0x5c, 0x3b, 0xe6, 0x6a, 0xed, 0x98, 0x9f, 0x6b, 0xc7, 0x7c,
-	0xd9, 0x38, 0xc6, 0x6a, 0xe3, 0x18, 0xef, 0x1b, 0xc7, 0x78, 0xea, 0x87, 0x91, 0x98, 0x2e, 0x08,
-	0xf4, 0xd9, 0x1c, 0xe9, 0x5d, 0xfc, 0x29, 0x8e, 0x92, 0xed, 0x05, 0x3d, 0xff, 0xca, 0x49, 0x14,
-	0x29, 0xe5, 0xa4, 0x26, 0x63, 0xba, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xd1, 0xac, 0x82,
-	0x83, 0x02, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xdd, 0x8a, 0x13, 0x31,
+	0x18, 0xed, 0x6c, 0xd7, 0xca, 0xa6, 0x8b, 0x3f, 0x83, 0xe8, 0xb8, 0xe0, 0x58, 0xaa, 0xc8, 0x82,
+	0x98, 0xb0, 0x95, 0x22, 0x0b, 0x22, 0xd8, 0x45, 0xf6, 0x42, 0xc4, 0x3a, 0xc2, 0x0a, 0xde, 0x0c,
+	0x49, 0x26, 0x4e, 0x43, 0xa7, 0x93, 0x61, 0x92, 0x8e, 0xce, 0x5b, 0xf8, 0x2c, 0xe2, 0x43, 0xec,
+	0xe5, 0x5e, 0x7a, 0x25, 0xd2, 0xbe, 0x88, 0x4c, 0x92, 0xe9, 0xac, 0xd2, 0x61, 0xaf, 0x92, 0xef,
+	0xcb, 0x39, 0x27, 0xe7, 0x4b, 0x0e, 0x78, 0x44, 0x30, 0x29, 0x13, 0x91, 0x22, 0x3a, 0x63, 0x74,
+	0x9e, 0x09, 0x9e, 0x2a, 0x9e, 0xc6, 0x28, 0x66, 0x29, 0x93, 0x5c, 0xc2, 0x2c, 0x17, 0x4a, 0xb8,
+	0x9e, 0x05, 0xc1, 0x7f, 0x40, 0xb0, 0x38, 0x3a, 0xb8, 0x4f, 0x85, 0x5c, 0x08, 0x19, 0x6a, 0x1c,
+	0x32, 0x85, 0x21, 0x1d, 0xdc, 0x89, 0x45, 0x2c, 0x4c, 0xbf, 0xda, 0xd9, 0xee, 0xc0, 0x60, 0x10,
+	0xcd, 0xcb, 0x4c, 0x09, 0xc4, 0xa2, 0xd1, 0x78, 0x7c, 0x74, 0x8c, 0xe6, 0xac, 0xac, 0x79, 0xc3,
+	0xed, 0x8e, 0x32, 0x9c, 0xe3, 0x45, 0x8d, 0x69, 0x71, 0x4d, 0x12, 0x19, 0xce, 0x59, 0x69, 0x41,
+	0x4f, 0xb6, 0x83, 0x9a, 0xca, 0xe0, 0x86, 0x3f, 0xbb, 0x60, 0xff, 0xd4, 0xcc, 0xfb, 0x51, 0x61,
+	0xc5, 0xdc, 0x57, 0xa0, 0x67, 0x6e, 0xf3, 0x9c, 0x81, 0x73, 0xd8, 0x1f, 0x0d, 0x60, 0xdb, 0xfc,
+	0x70, 0xaa, 0x71, 0x93, 0xdd, 0xf3, 0xdf, 0x0f, 0x3b, 0x81, 0x65, 0xb9, 0xa7, 0x60, 0xdf, 0xbe,
+	0x5f, 0xe5, 0x46, 0x7a, 0x3b, 0x83, 0xee, 0x61, 0x7f, 0xf4, 0xb8, 0x5d, 0xc5, 0xde, 0xfe, 0x96,
+	0x95, 0x41, 0x3f, 0xde, 0xec, 0xa5, 0xfb, 0x01, 0xf4, 0x1b, 0xac, 0xf4, 0xba, 0x5a, 0x07, 0xb5,
+	0xeb, 0x04, 0xf8, 0xeb, 0xc9, 0xa6, 0xf7, 0x89, 0xab, 0xd9, 0x3b, 0xa6, 0x70, 0x70, 0x59, 0xc3,
+	0x3d, 0x03, 0x37, 0x73, 0x16, 0x73, 0xa9, 0x58, 0xce, 0x22, 0x63, 0x6f, 0x57, 0xcb, 0x3e, 0x6b,
+	0x97, 0x3d, 0xc3, 0x09, 0x8f, 0xb0, 0x12, 0x79, 0x25, 0x39, 0x49, 0xb4, 0xcf, 0x1b, 0x8d, 0x8a,
+	0xb6, 0x9a, 0x81, 0x7b, 0x54, 0xa4, 0x5f, 0x12, 0x4e, 0x2b, 0x56, 0x78, 0xd9, 0xf6, 0x35, 0xad,
+	0xff, 0xa2, 0x5d, 0xff, 0xa4, 0x21, 0x36, 0xf6, 0xdf, 0x14, 0x3c, 0x62, 0x29, 0x65, 0xc1, 0x5d,
+	0xba, 0xed, 0x58, 0x0e, 0x7f, 0x38, 0x00, 0x34, 0x0f, 0xe7, 0x3e, 0x05, 0xb7, 0x8b, 0xda, 0x67,
+	0x88, 0xa3, 0x28, 0x67, 0xd2, 0xfc, 0xdf, 0x5e, 0x70, 0x6b, 0x73, 0xf0, 0xda, 0xf4, 0xdd, 0x63,
+	0x70, 0xdd, 0x66, 0xc5, 0xdb, 0xb9, 0xea, 0x8b, 0xed, 0xc0, 0x3d, 0xa2, 0x57, 0xf7, 0x25, 0x00,
+	0x05, 0x4e, 0xc2, 0x6c, 0x49, 0x2a, 0x76, 0x57, 0xb3, 0x1f, 0x40, 0x9b, 0x7c, 0x93, 0x6a, 0x68,
+	0x53, 0x0d, 0xa7, 0x4b, 0x52, 0x51, 0xf7, 0x0a, 0x9c, 0x4c, 0x35, 0x7e, 0xf2, 0xfe, 0x7c, 0xe5,
+	0x3b, 0x17, 0x2b, 0xdf, 0xf9, 0xb3, 0xf2, 0x9d, 0xef, 0x6b, 0xbf, 0x73, 0xb1, 0xf6, 0x3b, 0xbf,
+	0xd6, 0x7e, 0xe7, 0xf3, 0x38, 0xe6, 0x6a, 0xb6, 0x24, 0x90, 0x8a, 0x05, 0xb2, 0x5e, 0xe8, 0x0c,
+	0xf3, 0xb4, 0x2e, 0xd0, 0xb7, 0xff, 0x72, 0xac, 0xca, 0x8c, 0x49, 0xd2, 0xd3, 0x19, 0x7e, 0xfe,
+	0x37, 0x00, 0x00, 0xff, 0xff, 0x79, 0xbd, 0x67, 0x6a, 0xc8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +234,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictingCheckpoints) > 0 {
+		for iNdEx := len(m.ConflictingCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictingCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RegisteredKeys) > 0 {
+		for iNdEx := len(m.RegisteredKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.GenesisKeys) > 0 {
 		for iNdEx := len(m.GenesisKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -305,6 +382,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredKeys) > 0 {
+		for _, e := range m.RegisteredKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConflictingCheckpoints) > 0 {
+		for _, e := range m.ConflictingCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -431,6 +526,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &RawCheckpointWithMeta{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredKeys = append(m.RegisteredKeys, &ValidatorWithBlsKey{})
+			if err := m.RegisteredKeys[len(m.RegisteredKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingCheckpoints = append(m.ConflictingCheckpoints, &ConflictingCheckpointEvidence{})
+			if err := m.ConflictingCheckpoints[len(m.ConflictingCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
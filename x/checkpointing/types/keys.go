@@ -25,6 +25,9 @@ const (
 var (
 	CheckpointsPrefix  = []byte{0x1} // reserve this namespace for checkpoints
 	RegistrationPrefix = []byte{0x2} // reserve this namespace for BLS keys
+	ConflictsPrefix    = []byte{0x3} // reserve this namespace for conflicting checkpoints
+
+	UnresolvedConflictsKey = []byte{0x4} // where we save the number of unresolved conflicting checkpoints
 
 	CkptsObjectPrefix = append(CheckpointsPrefix, 0x0) // where we save the concrete BLS sig bytes
 
@@ -37,6 +40,11 @@ func CkptsObjectKey(epoch uint64) []byte {
 	return sdk.Uint64ToBigEndian(epoch)
 }
 
+// ConflictingCkptKey defines epoch and the hash of the conflicting checkpoint
+func ConflictingCkptKey(epoch uint64, ckptHash RawCkptHash) []byte {
+	return append(sdk.Uint64ToBigEndian(epoch), ckptHash...)
+}
+
 // AddrToBlsKeyKey defines validator address
 func AddrToBlsKeyKey(valAddr sdk.ValAddress) []byte {
 	return valAddr
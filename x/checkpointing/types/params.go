@@ -1,10 +1,25 @@
 package types
 
 import (
+	"fmt"
+	"math/big"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+var (
+	// checkpoints are sealed once signed by more than a third of the voting power
+	DefaultQuorumThreshold = Fraction{Numerator: 1, Denominator: 3}
+	// a conflicting checkpoint stops sealing checkpoints but keeps producing blocks
+	DefaultSafetyMode = StopEpochs
+)
+
+var (
+	KeyQuorumThreshold = []byte("QuorumThreshold")
+	KeySafetyMode      = []byte("SafetyMode")
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,22 +28,35 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(quorumThreshold Fraction, safetyMode SafetyMode) Params {
+	return Params{
+		QuorumThreshold: quorumThreshold,
+		SafetyMode:      safetyMode,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultQuorumThreshold, DefaultSafetyMode)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyQuorumThreshold, &p.QuorumThreshold, validateQuorumThreshold),
+		paramtypes.NewParamSetPair(KeySafetyMode, &p.SafetyMode, validateSafetyMode),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateQuorumThreshold(p.QuorumThreshold); err != nil {
+		return err
+	}
+	if err := validateSafetyMode(p.SafetyMode); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +65,51 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateQuorumThreshold(i interface{}) error {
+	v, ok := i.(Fraction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Denominator == 0 {
+		return fmt.Errorf("QuorumThreshold must have a positive denominator")
+	}
+
+	if v.Numerator == 0 || v.Numerator > v.Denominator {
+		return fmt.Errorf("QuorumThreshold must be in (0,1]: %d/%d", v.Numerator, v.Denominator)
+	}
+
+	return nil
+}
+
+func validateSafetyMode(i interface{}) error {
+	v, ok := i.(SafetyMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := SafetyMode_name[int32(v)]; !ok {
+		return fmt.Errorf("SafetyMode is unknown: %d", v)
+	}
+
+	return nil
+}
+
+// IsReachedBy returns true if the power exceeds the fraction of the total power,
+// or if it is the total power itself. A zero total power never reaches the quorum.
+func (f Fraction) IsReachedBy(power uint64, totalPower uint64) bool {
+	if totalPower == 0 {
+		return false
+	}
+
+	if power >= totalPower {
+		return true
+	}
+
+	// power * denominator > totalPower * numerator, without overflows
+	lhs := new(big.Int).Mul(new(big.Int).SetUint64(power), new(big.Int).SetUint64(f.Denominator))
+	rhs := new(big.Int).Mul(new(big.Int).SetUint64(totalPower), new(big.Int).SetUint64(f.Numerator))
+
+	return lhs.Cmp(rhs) > 0
+}
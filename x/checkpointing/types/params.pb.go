@@ -23,14 +23,104 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SafetyMode is the reaction of Babylon to a conflicting checkpoint, which lasts
+// until the operators intervene
+type SafetyMode int32
+
+const (
+	// HALT_CHAIN stops block production
+	HaltChain SafetyMode = 0
+	// STOP_EPOCHS keeps producing blocks and building checkpoints, but stops sealing
+	// them until the conflict is resolved
+	StopEpochs SafetyMode = 1
+)
+
+var SafetyMode_name = map[int32]string{
+	0: "SAFETY_MODE_HALT_CHAIN",
+	1: "SAFETY_MODE_STOP_EPOCHS",
+}
+
+var SafetyMode_value = map[string]int32{
+	"SAFETY_MODE_HALT_CHAIN":  0,
+	"SAFETY_MODE_STOP_EPOCHS": 1,
+}
+
+func (x SafetyMode) String() string {
+	return proto.EnumName(SafetyMode_name, int32(x))
+}
+
+func (SafetyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3587fe7b22c0f5bb, []int{0}
+}
+
+// Fraction is the rational number numerator/denominator
+type Fraction struct {
+	Numerator   uint64 `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty" yaml:"numerator"`
+	Denominator uint64 `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty" yaml:"denominator"`
+}
+
+func (m *Fraction) Reset()         { *m = Fraction{} }
+func (m *Fraction) String() string { return proto.CompactTextString(m) }
+func (*Fraction) ProtoMessage()    {}
+func (*Fraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3587fe7b22c0f5bb, []int{0}
+}
+func (m *Fraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fraction.Merge(m, src)
+}
+func (m *Fraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fraction proto.InternalMessageInfo
+
+func (m *Fraction) GetNumerator() uint64 {
+	if m != nil {
+		return m.Numerator
+	}
+	return 0
+}
+
+func (m *Fraction) GetDenominator() uint64 {
+	if m != nil {
+		return m.Denominator
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// quorum_threshold is the fraction of the total voting power of the validator
+	// set of an epoch which the signers of its checkpoint must exceed for the
+	// checkpoint to be sealed. It lies in (0,1], a checkpoint signed by the whole
+	// validator set being always sealed.
+	QuorumThreshold Fraction `protobuf:"bytes,1,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold" yaml:"quorum_threshold"`
+	// safety_mode is the reaction of Babylon to a conflicting checkpoint
+	SafetyMode SafetyMode `protobuf:"varint,2,opt,name=safety_mode,json=safetyMode,proto3,enum=babylon.checkpointing.v1.SafetyMode" json:"safety_mode,omitempty" yaml:"safety_mode"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3587fe7b22c0f5bb, []int{0}
+	return fileDescriptor_3587fe7b22c0f5bb, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -59,7 +149,23 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetQuorumThreshold() Fraction {
+	if m != nil {
+		return m.QuorumThreshold
+	}
+	return Fraction{}
+}
+
+func (m *Params) GetSafetyMode() SafetyMode {
+	if m != nil {
+		return m.SafetyMode
+	}
+	return HaltChain
+}
+
 func init() {
+	proto.RegisterEnum("babylon.checkpointing.v1.SafetyMode", SafetyMode_name, SafetyMode_value)
+	proto.RegisterType((*Fraction)(nil), "babylon.checkpointing.v1.Fraction")
 	proto.RegisterType((*Params)(nil), "babylon.checkpointing.v1.Params")
 }
 
@@ -68,17 +174,67 @@ func init() {
 }
 
 var fileDescriptor_3587fe7b22c0f5bb = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
+	// 424 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
 	0x4b, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
 	0x80, 0xaa, 0xd1, 0x43, 0x51, 0xa3, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56,
-	0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xf1, 0x71, 0xb1, 0x05, 0x80, 0xf5, 0x5b, 0xb1, 0xcc, 0x58,
-	0x20, 0xcf, 0xe0, 0xe4, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa6,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x4b, 0x92, 0x33, 0x12,
-	0x33, 0xf3, 0x60, 0x1c, 0xfd, 0x0a, 0x34, 0x77, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0xed, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x15, 0x10, 0xfe, 0xa5, 0xbd, 0x00, 0x00, 0x00,
+	0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x55, 0x70, 0x71, 0xb8, 0x15, 0x25, 0x26, 0x97, 0x64, 0xe6,
+	0xe7, 0x09, 0x19, 0x71, 0x71, 0xe6, 0x95, 0xe6, 0xa6, 0x16, 0x25, 0x96, 0xe4, 0x17, 0x49, 0x30,
+	0x2a, 0x30, 0x6a, 0xb0, 0x38, 0x89, 0x7c, 0xba, 0x27, 0x2f, 0x50, 0x99, 0x98, 0x9b, 0x63, 0xa5,
+	0x04, 0x97, 0x52, 0x0a, 0x42, 0x28, 0x13, 0xb2, 0xe0, 0xe2, 0x4e, 0x49, 0xcd, 0xcb, 0xcf, 0xcd,
+	0xcc, 0x03, 0xeb, 0x62, 0x02, 0xeb, 0x12, 0xfb, 0x74, 0x4f, 0x5e, 0x08, 0xa2, 0x0b, 0x49, 0x52,
+	0x29, 0x08, 0x59, 0xa9, 0xd2, 0x5d, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0xd3, 0x85, 0xf2, 0xb8, 0x04,
+	0x0a, 0x4b, 0xf3, 0x8b, 0x4a, 0x73, 0xe3, 0x4b, 0x32, 0x8a, 0x52, 0x8b, 0x33, 0xf2, 0x73, 0x52,
+	0xc0, 0xf6, 0x73, 0x1b, 0x29, 0xe9, 0xe1, 0xf2, 0x8f, 0x1e, 0xcc, 0xd9, 0x4e, 0xf2, 0x27, 0xee,
+	0xc9, 0x33, 0x7c, 0xba, 0x27, 0x2f, 0x0e, 0xb1, 0x11, 0xdd, 0x24, 0xa5, 0x20, 0x7e, 0x88, 0x50,
+	0x08, 0x4c, 0x44, 0x28, 0x96, 0x8b, 0xbb, 0x38, 0x31, 0x2d, 0xb5, 0xa4, 0x32, 0x3e, 0x37, 0x3f,
+	0x25, 0x15, 0xec, 0x68, 0x3e, 0x23, 0x15, 0xdc, 0x56, 0x05, 0x83, 0x15, 0xfb, 0xe6, 0xa7, 0xa4,
+	0x22, 0x7b, 0x0d, 0xc9, 0x08, 0xa5, 0x20, 0xae, 0x62, 0xb8, 0x1a, 0x2b, 0x96, 0x19, 0x0b, 0xe4,
+	0x19, 0xb4, 0xb2, 0xb8, 0xb8, 0x10, 0xfa, 0x84, 0x34, 0xb9, 0xc4, 0x82, 0x1d, 0xdd, 0x5c, 0x43,
+	0x22, 0xe3, 0x7d, 0xfd, 0x5d, 0x5c, 0xe3, 0x3d, 0x1c, 0x7d, 0x42, 0xe2, 0x9d, 0x3d, 0x1c, 0x3d,
+	0xfd, 0x04, 0x18, 0xa4, 0x78, 0xbb, 0xe6, 0x2a, 0x70, 0x7a, 0x24, 0xe6, 0x94, 0x38, 0x67, 0x24,
+	0x66, 0xe6, 0x09, 0x69, 0x73, 0x89, 0x23, 0x2b, 0x0d, 0x0e, 0xf1, 0x0f, 0x88, 0x77, 0x0d, 0xf0,
+	0x77, 0xf6, 0x08, 0x16, 0x60, 0x94, 0xe2, 0xeb, 0x9a, 0xab, 0xc0, 0x15, 0x5c, 0x92, 0x5f, 0xe0,
+	0x5a, 0x90, 0x9f, 0x9c, 0x51, 0x2c, 0xc5, 0xd2, 0xb1, 0x58, 0x8e, 0xc1, 0xc9, 0xff, 0xc4, 0x23,
+	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
+	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
+	0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xfe, 0x4b, 0x06, 0xd9, 0x02, 0xe3, 0xe8, 0x57, 0xa0, 0xa5, 0xa6,
+	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xea, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
+	0x4b, 0x47, 0x65, 0xc6, 0x73, 0x02, 0x00, 0x00,
+}
+
+func (m *Fraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -101,6 +257,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SafetyMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SafetyMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.QuorumThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -115,12 +286,32 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovParams(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovParams(uint64(m.Denominator))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.QuorumThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SafetyMode != 0 {
+		n += 1 + sovParams(uint64(m.SafetyMode))
+	}
 	return n
 }
 
@@ -130,6 +321,94 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -159,6 +438,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuorumThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyMode", wireType)
+			}
+			m.SafetyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafetyMode |= SafetyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
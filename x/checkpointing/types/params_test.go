@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsQuorumThreshold(t *testing.T) {
+	p := types.DefaultParams()
+	require.NoError(t, p.Validate())
+
+	// more than a third of the voting power is required by default
+	require.False(t, p.QuorumThreshold.IsReachedBy(10, 30))
+	require.True(t, p.QuorumThreshold.IsReachedBy(11, 30))
+	require.False(t, p.QuorumThreshold.IsReachedBy(10, 31))
+
+	// a threshold of one requires the whole voting power
+	p.QuorumThreshold = types.Fraction{Numerator: 1, Denominator: 1}
+	require.NoError(t, p.Validate())
+	require.False(t, p.QuorumThreshold.IsReachedBy(29, 30))
+	require.True(t, p.QuorumThreshold.IsReachedBy(30, 30))
+
+	// a validator set without voting power never reaches the quorum
+	require.False(t, p.QuorumThreshold.IsReachedBy(0, 0))
+	require.False(t, p.QuorumThreshold.IsReachedBy(10, 0))
+
+	for _, invalid := range []types.Fraction{{0, 3}, {1, 0}, {4, 3}} {
+		p.QuorumThreshold = invalid
+		require.Error(t, p.Validate())
+	}
+}
+
+func TestParamsSafetyMode(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, types.StopEpochs, p.SafetyMode)
+
+	p.SafetyMode = types.HaltChain
+	require.NoError(t, p.Validate())
+
+	p.SafetyMode = types.SafetyMode(len(types.SafetyMode_name))
+	require.Error(t, p.Validate())
+}
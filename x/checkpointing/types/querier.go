@@ -22,3 +22,8 @@ func NewQueryEpochStatusRequest(epochNum uint64) *QueryEpochStatusRequest {
 func NewQueryRecentEpochStatusCountRequest(epochNum uint64) *QueryRecentEpochStatusCountRequest {
 	return &QueryRecentEpochStatusCountRequest{EpochCount: epochNum}
 }
+
+// NewQueryConflictingCheckpointsRequest creates a new instance of QueryConflictingCheckpointsRequest.
+func NewQueryConflictingCheckpointsRequest(req *query.PageRequest) *QueryConflictingCheckpointsRequest {
+	return &QueryConflictingCheckpointsRequest{Pagination: req}
+}
@@ -743,6 +743,109 @@ func (m *QueryRecentEpochStatusCountResponse) GetStatusCount() map[string]uint64
 	return nil
 }
 
+// QueryConflictingCheckpointsRequest is the request type for the
+// Query/ConflictingCheckpoints RPC method.
+type QueryConflictingCheckpointsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConflictingCheckpointsRequest) Reset()         { *m = QueryConflictingCheckpointsRequest{} }
+func (m *QueryConflictingCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConflictingCheckpointsRequest) ProtoMessage()    {}
+func (*QueryConflictingCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fdb8f0f85bb51e, []int{14}
+}
+func (m *QueryConflictingCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConflictingCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConflictingCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConflictingCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConflictingCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryConflictingCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConflictingCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConflictingCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConflictingCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryConflictingCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConflictingCheckpointsResponse is the response type for the
+// Query/ConflictingCheckpoints RPC method.
+type QueryConflictingCheckpointsResponse struct {
+	// the order is going from the oldest to newest based on the epoch number
+	Evidences []*ConflictingCheckpointEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConflictingCheckpointsResponse) Reset()         { *m = QueryConflictingCheckpointsResponse{} }
+func (m *QueryConflictingCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConflictingCheckpointsResponse) ProtoMessage()    {}
+func (*QueryConflictingCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fdb8f0f85bb51e, []int{15}
+}
+func (m *QueryConflictingCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConflictingCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConflictingCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConflictingCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConflictingCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryConflictingCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConflictingCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConflictingCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConflictingCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryConflictingCheckpointsResponse) GetEvidences() []*ConflictingCheckpointEvidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+func (m *QueryConflictingCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -751,7 +854,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0fdb8f0f85bb51e, []int{14}
+	return fileDescriptor_a0fdb8f0f85bb51e, []int{16}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +893,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0fdb8f0f85bb51e, []int{15}
+	return fileDescriptor_a0fdb8f0f85bb51e, []int{17}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,59 +929,6 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-// ValidatorWithBlsKey couples validator address and its bls public key
-type ValidatorWithBlsKey struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	BlsPubKey        []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
-}
-
-func (m *ValidatorWithBlsKey) Reset()         { *m = ValidatorWithBlsKey{} }
-func (m *ValidatorWithBlsKey) String() string { return proto.CompactTextString(m) }
-func (*ValidatorWithBlsKey) ProtoMessage()    {}
-func (*ValidatorWithBlsKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0fdb8f0f85bb51e, []int{16}
-}
-func (m *ValidatorWithBlsKey) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ValidatorWithBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ValidatorWithBlsKey.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ValidatorWithBlsKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorWithBlsKey.Merge(m, src)
-}
-func (m *ValidatorWithBlsKey) XXX_Size() int {
-	return m.Size()
-}
-func (m *ValidatorWithBlsKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorWithBlsKey.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ValidatorWithBlsKey proto.InternalMessageInfo
-
-func (m *ValidatorWithBlsKey) GetValidatorAddress() string {
-	if m != nil {
-		return m.ValidatorAddress
-	}
-	return ""
-}
-
-func (m *ValidatorWithBlsKey) GetBlsPubKey() []byte {
-	if m != nil {
-		return m.BlsPubKey
-	}
-	return nil
-}
-
 func init() {
 	proto.RegisterType((*QueryRawCheckpointListRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListRequest")
 	proto.RegisterType((*QueryRawCheckpointListResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListResponse")
@@ -895,82 +945,87 @@ func init() {
 	proto.RegisterType((*QueryRecentEpochStatusCountRequest)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountRequest")
 	proto.RegisterType((*QueryRecentEpochStatusCountResponse)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse")
 	proto.RegisterMapType((map[string]uint64)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse.StatusCountEntry")
+	proto.RegisterType((*QueryConflictingCheckpointsRequest)(nil), "babylon.checkpointing.v1.QueryConflictingCheckpointsRequest")
+	proto.RegisterType((*QueryConflictingCheckpointsResponse)(nil), "babylon.checkpointing.v1.QueryConflictingCheckpointsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.checkpointing.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.checkpointing.v1.QueryParamsResponse")
-	proto.RegisterType((*ValidatorWithBlsKey)(nil), "babylon.checkpointing.v1.ValidatorWithBlsKey")
 }
 
 func init() { proto.RegisterFile("babylon/checkpointing/query.proto", fileDescriptor_a0fdb8f0f85bb51e) }
 
 var fileDescriptor_a0fdb8f0f85bb51e = []byte{
-	// 1071 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x4e, 0x1b, 0x91, 0xe7, 0x34, 0x38, 0xd3, 0x88, 0x04, 0xb7, 0xb8, 0x61, 0xa9,
-	0x4a, 0xd4, 0x92, 0x5d, 0xd9, 0xf9, 0xa9, 0xd0, 0x44, 0xaa, 0xa3, 0x80, 0x50, 0x4a, 0x09, 0x8b,
-	0x28, 0x08, 0x21, 0xac, 0xd9, 0xcd, 0x60, 0xaf, 0xb2, 0xde, 0xdd, 0x78, 0x66, 0x1d, 0xac, 0x2a,
-	0x97, 0xf2, 0x07, 0x80, 0x54, 0x89, 0x7f, 0x82, 0x13, 0x37, 0xce, 0x70, 0x29, 0x12, 0x42, 0x95,
-	0xb8, 0x70, 0x42, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0x33, 0x8e, 0xbd, 0xbb, 0xde, 0xd8, 0x0e, 0xbe,
-	0xf4, 0x66, 0xbf, 0x7d, 0x6f, 0xe6, 0xf3, 0x7d, 0x3b, 0x6f, 0xbe, 0x36, 0xbc, 0x69, 0x62, 0xb3,
-	0xe5, 0x78, 0xae, 0x6e, 0xd5, 0x88, 0x75, 0xe8, 0x7b, 0xb6, 0xcb, 0x6c, 0xb7, 0xaa, 0x1f, 0x05,
-	0xa4, 0xd1, 0xd2, 0xfc, 0x86, 0xc7, 0x3c, 0x34, 0x2f, 0x53, 0xb4, 0x48, 0x8a, 0xd6, 0x2c, 0xe6,
-	0xef, 0x5a, 0x1e, 0xad, 0x7b, 0x54, 0x37, 0x31, 0x25, 0xa2, 0x44, 0x6f, 0x16, 0x4d, 0xc2, 0x70,
-	0x51, 0xf7, 0x71, 0xd5, 0x76, 0x31, 0xb3, 0x3d, 0x57, 0xac, 0x92, 0x9f, 0xad, 0x7a, 0x55, 0x8f,
-	0x7f, 0xd4, 0xc3, 0x4f, 0x32, 0x7a, 0xb3, 0xea, 0x79, 0x55, 0x87, 0xe8, 0xd8, 0xb7, 0x75, 0xec,
-	0xba, 0x1e, 0xe3, 0x25, 0x54, 0x3e, 0x55, 0x7b, 0xc3, 0xf9, 0xb8, 0x81, 0xeb, 0xed, 0x9c, 0x3b,
-	0xbd, 0x73, 0x3a, 0xdf, 0x44, 0x9e, 0xfa, 0xa3, 0x02, 0x6f, 0x7c, 0x1c, 0x22, 0x1a, 0xf8, 0x78,
-	0xe7, 0xfc, 0xe1, 0x43, 0x9b, 0x32, 0x83, 0x1c, 0x05, 0x84, 0x32, 0x54, 0x86, 0x09, 0xca, 0x30,
-	0x0b, 0xe8, 0xbc, 0xb2, 0xa0, 0x2c, 0x4e, 0x97, 0xee, 0x6a, 0x69, 0xc2, 0xb5, 0xce, 0x02, 0x9f,
-	0xf0, 0x0a, 0x43, 0x56, 0xa2, 0xf7, 0x00, 0x3a, 0xca, 0xe7, 0x33, 0x0b, 0xca, 0x62, 0xb6, 0x74,
-	0x47, 0x13, 0x6d, 0xd2, 0xc2, 0x36, 0x69, 0xa2, 0xb3, 0xb2, 0x4d, 0xda, 0x3e, 0xae, 0x12, 0xb9,
-	0xbf, 0xd1, 0x55, 0xa9, 0xfe, 0xaa, 0x40, 0x21, 0x8d, 0x96, 0xfa, 0x9e, 0x4b, 0x09, 0xfa, 0x1c,
-	0x5e, 0x6d, 0xe0, 0xe3, 0x4a, 0x87, 0x2d, 0xe4, 0x1e, 0x5f, 0xcc, 0x96, 0xf4, 0x74, 0xee, 0xc8,
-	0x6a, 0x9f, 0xd9, 0xac, 0xf6, 0x21, 0x61, 0xd8, 0x98, 0x6e, 0x74, 0x87, 0x29, 0x7a, 0xbf, 0x87,
-	0x88, 0xb7, 0xfb, 0x8a, 0x10, 0x58, 0x11, 0x15, 0xcf, 0x14, 0x78, 0x4b, 0xa8, 0x20, 0x16, 0x71,
-	0x59, 0x6a, 0xe7, 0x6f, 0xc3, 0xf4, 0xd7, 0x0d, 0xaf, 0x5e, 0x21, 0xbe, 0x67, 0xd5, 0x2a, 0x6e,
-	0x50, 0xe7, 0x6f, 0xe0, 0x8a, 0x31, 0x15, 0x46, 0x77, 0xc3, 0xe0, 0xa3, 0xa0, 0x3e, 0xb2, 0xde,
-	0xfe, 0xa6, 0xc0, 0xed, 0x8b, 0xa9, 0x5e, 0x9e, 0x0e, 0x6f, 0xc0, 0xeb, 0xc9, 0x63, 0xd2, 0x6e,
-	0xeb, 0x0d, 0x98, 0x8c, 0x77, 0xf4, 0x15, 0x22, 0xbb, 0xa9, 0x32, 0xc8, 0xf7, 0xaa, 0x94, 0xd2,
-	0x1f, 0xc3, 0x74, 0x54, 0x3a, 0xaf, 0xbf, 0x84, 0xf2, 0x6b, 0x11, 0xe5, 0x6a, 0x01, 0x6e, 0xf2,
-	0x5d, 0x1f, 0x62, 0x46, 0x28, 0x4b, 0x20, 0xab, 0x27, 0x72, 0x48, 0x93, 0xcf, 0x25, 0xd8, 0x97,
-	0x30, 0xe3, 0xf0, 0x67, 0x23, 0x60, 0xcb, 0x39, 0xb1, 0x5d, 0xd4, 0x6f, 0x15, 0xc9, 0x57, 0x76,
-	0xe8, 0x7e, 0x60, 0x3a, 0xb6, 0xb5, 0x47, 0x5a, 0xdd, 0x27, 0xf5, 0xa2, 0x96, 0x8e, 0xec, 0x80,
-	0xfe, 0xd1, 0xbe, 0xaa, 0x92, 0x14, 0xb2, 0x0b, 0x07, 0x30, 0xd7, 0xc4, 0x8e, 0x7d, 0x80, 0x99,
-	0xd7, 0xa8, 0x1c, 0xdb, 0xac, 0x56, 0x31, 0x1d, 0x5a, 0x39, 0x24, 0xad, 0xf6, 0x09, 0x5d, 0x4a,
-	0xef, 0xc5, 0xe3, 0x76, 0x61, 0xd8, 0x87, 0xb2, 0x43, 0xf7, 0x48, 0xcb, 0x98, 0x6d, 0x26, 0x83,
-	0x23, 0x3c, 0xa5, 0x6b, 0x30, 0xc7, 0xf5, 0xf0, 0x51, 0x96, 0x37, 0xe6, 0x20, 0x67, 0xf4, 0x2b,
-	0x98, 0x4f, 0xd6, 0xc9, 0x16, 0x8c, 0xe0, 0xb6, 0x56, 0x77, 0x41, 0xed, 0xba, 0x08, 0xba, 0x76,
-	0xd9, 0xf1, 0x82, 0xce, 0x18, 0xdd, 0x82, 0xac, 0x40, 0xb4, 0xc2, 0xa8, 0x84, 0x04, 0x1e, 0xe2,
-	0x79, 0xea, 0x0f, 0x99, 0xc8, 0x35, 0x97, 0x5c, 0x47, 0x22, 0xdf, 0x80, 0x49, 0x66, 0xfb, 0xe2,
-	0x96, 0x6b, 0x6b, 0x65, 0xb6, 0xcf, 0xf3, 0xe3, 0xbb, 0x64, 0xe2, 0xbb, 0xa0, 0x23, 0x98, 0x12,
-	0xd8, 0x32, 0x63, 0x9c, 0xbf, 0xe8, 0x47, 0xe9, 0xb2, 0x07, 0x40, 0xd2, 0xba, 0x62, 0xbb, 0x2e,
-	0x6b, 0xb4, 0x8c, 0x2c, 0xed, 0x44, 0xf2, 0xdb, 0x90, 0x8b, 0x27, 0xa0, 0x1c, 0x8c, 0x1f, 0x92,
-	0x16, 0xc7, 0x9f, 0x34, 0xc2, 0x8f, 0x68, 0x16, 0xae, 0x36, 0xb1, 0x13, 0x10, 0xc9, 0x2c, 0xbe,
-	0x6c, 0x66, 0x36, 0x14, 0x75, 0x16, 0x10, 0x87, 0xd8, 0xe7, 0x86, 0xdd, 0x9e, 0xf1, 0x4f, 0xe1,
-	0x7a, 0x24, 0x2a, 0xbb, 0xb3, 0x0d, 0x13, 0xc2, 0xd8, 0xe5, 0x38, 0x2f, 0xa4, 0x2b, 0x13, 0x95,
-	0xe5, 0x2b, 0xcf, 0xff, 0xbe, 0x35, 0x66, 0xc8, 0x2a, 0xd5, 0x84, 0xeb, 0x3d, 0x8e, 0x36, 0xba,
-	0x07, 0x33, 0x9d, 0x51, 0xc1, 0x07, 0x07, 0x0d, 0x42, 0xa9, 0xa4, 0xcf, 0x9d, 0x3f, 0x78, 0x20,
-	0xe2, 0xa8, 0x00, 0xd9, 0x70, 0x90, 0xfc, 0xc0, 0x0c, 0x87, 0x89, 0x0b, 0x9a, 0x32, 0x26, 0x4d,
-	0x3e, 0x86, 0x7b, 0xa4, 0x55, 0x7a, 0x3a, 0x05, 0x57, 0x39, 0x3b, 0xfa, 0x45, 0x81, 0x99, 0x84,
-	0x73, 0xa0, 0xf5, 0x7e, 0x6f, 0x23, 0xc5, 0x01, 0xf3, 0x1b, 0xc3, 0x17, 0x8a, 0xb6, 0xa9, 0x9b,
-	0x4f, 0xff, 0xfc, 0xf7, 0x59, 0x66, 0x05, 0x95, 0xf4, 0xde, 0x3f, 0x84, 0x9a, 0x45, 0x3d, 0x66,
-	0x62, 0xfa, 0x13, 0xf1, 0x8e, 0x4f, 0xd0, 0x99, 0x02, 0x73, 0x29, 0x26, 0x88, 0xb6, 0x06, 0x3a,
-	0x58, 0xa9, 0x82, 0xb6, 0x2f, 0x5b, 0x2e, 0x65, 0x7d, 0xc0, 0x65, 0xed, 0xa0, 0x07, 0x17, 0xc8,
-	0xe2, 0x4b, 0x54, 0x12, 0xea, 0xa2, 0x3f, 0x25, 0x4e, 0xd0, 0xcf, 0x0a, 0x5c, 0x8b, 0x6c, 0x84,
-	0x96, 0x87, 0xe9, 0x76, 0x5b, 0xd1, 0xca, 0x70, 0x45, 0x52, 0xc7, 0x7d, 0xae, 0x63, 0x0d, 0xad,
-	0x0c, 0xfa, 0x7a, 0xf4, 0x27, 0x51, 0xf4, 0x5c, 0xdc, 0x0a, 0xd1, 0x5a, 0x1f, 0x90, 0x14, 0x6f,
-	0xcd, 0xaf, 0x0f, 0x5d, 0x27, 0x35, 0x2c, 0x73, 0x0d, 0x4b, 0xe8, 0x5e, 0xba, 0x86, 0x84, 0x27,
-	0x87, 0x03, 0x92, 0x8b, 0xfb, 0x57, 0x5f, 0xf4, 0x14, 0xdb, 0xed, 0x8b, 0x9e, 0x66, 0x94, 0xea,
-	0x16, 0x47, 0x5f, 0x47, 0xab, 0xe9, 0xe8, 0x72, 0xe0, 0x1d, 0xdb, 0xe2, 0x06, 0x1a, 0xe9, 0xff,
-	0x4f, 0x0a, 0x64, 0xbb, 0xee, 0x4e, 0x54, 0xec, 0xc3, 0x91, 0x34, 0xb8, 0x7c, 0x69, 0x98, 0x12,
-	0x49, 0xfd, 0x2e, 0xa7, 0x5e, 0x45, 0xcb, 0xe9, 0xd4, 0x1c, 0x32, 0x02, 0xab, 0xcb, 0xbf, 0x20,
-	0xbf, 0x2b, 0xf0, 0x5a, 0xef, 0x5b, 0x1f, 0xdd, 0xbf, 0xa4, 0x59, 0x08, 0x25, 0x5b, 0xff, 0xcb,
-	0x6a, 0xd4, 0x55, 0x2e, 0x4a, 0x47, 0x4b, 0xfd, 0x44, 0x6d, 0x76, 0xdb, 0x1c, 0xfa, 0x4e, 0x81,
-	0x09, 0x71, 0xdf, 0xa3, 0x77, 0xfa, 0x00, 0x44, 0x6c, 0x26, 0xbf, 0x34, 0x60, 0xb6, 0xc4, 0x5b,
-	0xe4, 0x78, 0x2a, 0x5a, 0x48, 0xc7, 0x13, 0x46, 0x53, 0xfe, 0xe8, 0xf9, 0x69, 0x41, 0x79, 0x71,
-	0x5a, 0x50, 0xfe, 0x39, 0x2d, 0x28, 0xdf, 0x9f, 0x15, 0xc6, 0x5e, 0x9c, 0x15, 0xc6, 0xfe, 0x3a,
-	0x2b, 0x8c, 0x7d, 0xb1, 0x5a, 0xb5, 0x59, 0x2d, 0x30, 0x35, 0xcb, 0xab, 0xb7, 0x57, 0xb1, 0x6a,
-	0xd8, 0x76, 0xcf, 0x97, 0xfc, 0x26, 0xb6, 0x28, 0x6b, 0xf9, 0x84, 0x9a, 0x13, 0xfc, 0x1f, 0xea,
-	0xf2, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x64, 0xb6, 0x7b, 0x05, 0x8c, 0x0f, 0x00, 0x00,
+	// 1121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xee, 0xed, 0xb6, 0x8a, 0x9e, 0xac, 0x25, 0xbb, 0x54, 0x6b, 0xc9, 0x46, 0x56, 0xbc, 0x69,
+	0x54, 0x83, 0xda, 0x4a, 0xd2, 0x5f, 0x94, 0xb5, 0x12, 0xa9, 0x0a, 0x42, 0x1b, 0xa3, 0x18, 0x6d,
+	0x20, 0x84, 0x88, 0x1c, 0xf7, 0x2e, 0xb1, 0xea, 0xf8, 0xba, 0xf1, 0x4d, 0x4a, 0x34, 0xf5, 0x05,
+	0xfe, 0x00, 0x90, 0x26, 0xf1, 0x4f, 0xf0, 0xc4, 0x1b, 0xcf, 0x20, 0xa4, 0x21, 0x21, 0x54, 0x09,
+	0x21, 0xf1, 0x84, 0x50, 0xcb, 0x1f, 0x82, 0x7c, 0x7d, 0xbd, 0xd8, 0x71, 0x6e, 0x9c, 0x94, 0xbc,
+	0xf0, 0xe6, 0x1c, 0x9f, 0x73, 0xee, 0xf7, 0x7d, 0xbe, 0xf7, 0x7c, 0xb7, 0x85, 0x57, 0xab, 0x46,
+	0xb5, 0x63, 0x53, 0x47, 0x33, 0xeb, 0xc4, 0x3c, 0x70, 0xa9, 0xe5, 0x30, 0xcb, 0xa9, 0x69, 0x87,
+	0x2d, 0xd2, 0xec, 0xa8, 0x6e, 0x93, 0x32, 0x8a, 0x17, 0x44, 0x8a, 0x1a, 0x4b, 0x51, 0xdb, 0x85,
+	0xdc, 0x1d, 0x93, 0x7a, 0x0d, 0xea, 0x69, 0x55, 0xc3, 0x23, 0x41, 0x89, 0xd6, 0x2e, 0x54, 0x09,
+	0x33, 0x0a, 0x9a, 0x6b, 0xd4, 0x2c, 0xc7, 0x60, 0x16, 0x75, 0x82, 0x2e, 0xb9, 0xb9, 0x1a, 0xad,
+	0x51, 0xfe, 0xa8, 0xf9, 0x4f, 0x22, 0x7a, 0xbd, 0x46, 0x69, 0xcd, 0x26, 0x9a, 0xe1, 0x5a, 0x9a,
+	0xe1, 0x38, 0x94, 0xf1, 0x12, 0x4f, 0xbc, 0x55, 0xfa, 0x83, 0x73, 0x8d, 0xa6, 0xd1, 0x08, 0x73,
+	0x6e, 0xf7, 0xcf, 0xe9, 0xfe, 0x12, 0x79, 0x37, 0xfb, 0xe7, 0x55, 0x6d, 0xaf, 0x72, 0x40, 0x04,
+	0x55, 0xe5, 0x3b, 0x04, 0xaf, 0x7c, 0xe8, 0xf3, 0xd0, 0x8d, 0xa3, 0x9d, 0xe7, 0x79, 0xf7, 0x2d,
+	0x8f, 0xe9, 0xe4, 0xb0, 0x45, 0x3c, 0x86, 0xcb, 0x30, 0xe5, 0x31, 0x83, 0xb5, 0xbc, 0x05, 0xb4,
+	0x88, 0x96, 0x66, 0x8b, 0x77, 0x54, 0x99, 0x3a, 0x6a, 0xb7, 0xc1, 0x47, 0xbc, 0x42, 0x17, 0x95,
+	0xf8, 0x1d, 0x80, 0xae, 0x3c, 0x0b, 0x93, 0x8b, 0x68, 0x29, 0x53, 0xbc, 0xad, 0x06, 0x5a, 0xaa,
+	0xbe, 0x96, 0x6a, 0x20, 0xbf, 0xd0, 0x52, 0xdd, 0x33, 0x6a, 0x44, 0xac, 0xaf, 0x47, 0x2a, 0x95,
+	0x9f, 0x10, 0xe4, 0x65, 0x68, 0x3d, 0x97, 0x3a, 0x1e, 0xc1, 0x9f, 0xc0, 0x8b, 0x4d, 0xe3, 0xa8,
+	0xd2, 0xc5, 0xe6, 0xe3, 0xbe, 0xb0, 0x94, 0x29, 0x6a, 0x72, 0xdc, 0xb1, 0x6e, 0x1f, 0x5b, 0xac,
+	0xfe, 0x3e, 0x61, 0x86, 0x3e, 0xdb, 0x8c, 0x86, 0x3d, 0xfc, 0x6e, 0x1f, 0x12, 0xaf, 0xa5, 0x92,
+	0x08, 0x60, 0xc5, 0x58, 0x3c, 0x45, 0x70, 0x33, 0x60, 0x41, 0x4c, 0xe2, 0x30, 0xa9, 0xf2, 0xb7,
+	0x60, 0xf6, 0x71, 0x93, 0x36, 0x2a, 0xc4, 0xa5, 0x66, 0xbd, 0xe2, 0xb4, 0x1a, 0xfc, 0x0b, 0x5c,
+	0xd4, 0x2f, 0xfb, 0xd1, 0x5d, 0x3f, 0xf8, 0xa0, 0xd5, 0x18, 0x9b, 0xb6, 0xbf, 0x20, 0xb8, 0x35,
+	0x18, 0xd5, 0xff, 0x47, 0xe1, 0x0d, 0x78, 0x39, 0xb9, 0x4d, 0x42, 0x59, 0xaf, 0xc1, 0x74, 0xaf,
+	0xa2, 0x2f, 0x10, 0xa1, 0xa6, 0xc2, 0x20, 0xd7, 0xaf, 0x52, 0x50, 0x7f, 0x04, 0xb3, 0x71, 0xea,
+	0xbc, 0xfe, 0x1c, 0xcc, 0x67, 0x62, 0xcc, 0x95, 0x3c, 0x5c, 0xe7, 0xab, 0xde, 0x37, 0x18, 0xf1,
+	0x58, 0x02, 0xb2, 0x72, 0x2c, 0x0e, 0x69, 0xf2, 0xbd, 0x00, 0xf6, 0x19, 0x5c, 0xb1, 0xf9, 0xbb,
+	0x31, 0x60, 0xcb, 0xda, 0x3d, 0xab, 0x28, 0x5f, 0x21, 0x81, 0xaf, 0x6c, 0x7b, 0x7b, 0xad, 0xaa,
+	0x6d, 0x99, 0xf7, 0x48, 0x27, 0xba, 0x53, 0x07, 0x49, 0x3a, 0xb6, 0x0d, 0xfa, 0x5b, 0x38, 0xaa,
+	0x92, 0x28, 0x84, 0x0a, 0xfb, 0x30, 0xdf, 0x36, 0x6c, 0x6b, 0xdf, 0x60, 0xb4, 0x59, 0x39, 0xb2,
+	0x58, 0xbd, 0x22, 0x86, 0x5d, 0xb8, 0x43, 0x97, 0xe5, 0x5a, 0x3c, 0x0a, 0x0b, 0x7d, 0x1d, 0xca,
+	0xb6, 0x77, 0x8f, 0x74, 0xf4, 0xb9, 0x76, 0x32, 0x38, 0xc6, 0x5d, 0xba, 0x06, 0xf3, 0x9c, 0x0f,
+	0x3f, 0xca, 0x62, 0x62, 0x0e, 0xb3, 0x47, 0x3f, 0x87, 0x85, 0x64, 0x9d, 0x90, 0x60, 0x0c, 0xd3,
+	0x5a, 0xd9, 0x05, 0x25, 0x32, 0x08, 0x22, 0xab, 0xec, 0xd0, 0x56, 0xf7, 0x18, 0xdd, 0x80, 0x4c,
+	0x00, 0xd1, 0xf4, 0xa3, 0x02, 0x24, 0xf0, 0x10, 0xcf, 0x53, 0xbe, 0x9d, 0x8c, 0x8d, 0xb9, 0x64,
+	0x1f, 0x01, 0xf9, 0x1a, 0x4c, 0x33, 0xcb, 0x0d, 0xa6, 0x5c, 0xc8, 0x95, 0x59, 0x2e, 0xcf, 0xef,
+	0x5d, 0x65, 0xb2, 0x77, 0x15, 0x7c, 0x08, 0x97, 0x03, 0xd8, 0x22, 0xe3, 0x02, 0xff, 0xd0, 0x0f,
+	0xe4, 0xb4, 0x87, 0x80, 0xa4, 0x46, 0x62, 0xbb, 0x0e, 0x6b, 0x76, 0xf4, 0x8c, 0xd7, 0x8d, 0xe4,
+	0xb6, 0x21, 0xdb, 0x9b, 0x80, 0xb3, 0x70, 0xe1, 0x80, 0x74, 0x38, 0xfc, 0x69, 0xdd, 0x7f, 0xc4,
+	0x73, 0x70, 0xa9, 0x6d, 0xd8, 0x2d, 0x22, 0x30, 0x07, 0x3f, 0x36, 0x27, 0x37, 0x90, 0x62, 0x0b,
+	0x7d, 0x77, 0xa8, 0xf3, 0xd8, 0xb6, 0x4c, 0x1f, 0x58, 0x64, 0x0a, 0x86, 0xfa, 0xc6, 0x8f, 0x0d,
+	0x3a, 0xf7, 0xb1, 0xf9, 0x39, 0x74, 0x1b, 0xd9, 0x72, 0xe2, 0x33, 0x3c, 0x84, 0x69, 0xd2, 0xb6,
+	0xf6, 0x89, 0x63, 0x92, 0xf0, 0xb8, 0xac, 0x0f, 0xd8, 0x3c, 0xfd, 0x9a, 0xed, 0x8a, 0x7a, 0xbd,
+	0xdb, 0x69, 0x7c, 0xa7, 0x65, 0x0e, 0x30, 0xa7, 0xb1, 0xc7, 0xef, 0x42, 0xe1, 0x64, 0x7c, 0x08,
+	0x2f, 0xc5, 0xa2, 0x82, 0xcc, 0x36, 0x4c, 0x05, 0x77, 0x26, 0x21, 0xdc, 0xa2, 0x9c, 0x49, 0x50,
+	0x59, 0xbe, 0xf8, 0xec, 0xaf, 0x1b, 0x13, 0xba, 0xa8, 0x2a, 0xfe, 0x31, 0x03, 0x97, 0x78, 0x5f,
+	0xfc, 0x23, 0x82, 0x2b, 0x09, 0x2f, 0xc4, 0xeb, 0x69, 0xfb, 0x4b, 0xe2, 0xe9, 0xb9, 0x8d, 0xd1,
+	0x0b, 0x03, 0x4a, 0xca, 0xe6, 0x97, 0xbf, 0xff, 0xf3, 0x74, 0x72, 0x05, 0x17, 0xb5, 0xfe, 0xf7,
+	0xba, 0x76, 0x41, 0xeb, 0xb1, 0x65, 0xed, 0x49, 0xb0, 0x6b, 0x8f, 0xf1, 0x19, 0x82, 0x79, 0x89,
+	0xad, 0xe3, 0xad, 0xa1, 0x8e, 0x8a, 0x94, 0xd0, 0xf6, 0x79, 0xcb, 0x05, 0xad, 0xf7, 0x38, 0xad,
+	0x1d, 0xfc, 0xf6, 0x00, 0x5a, 0xbc, 0x45, 0x25, 0xc1, 0x2e, 0x7e, 0x39, 0x3a, 0xc6, 0x3f, 0x20,
+	0x98, 0x89, 0x2d, 0x84, 0x4b, 0xa3, 0xa8, 0x1d, 0x32, 0x5a, 0x19, 0xad, 0x48, 0xf0, 0xb8, 0xcb,
+	0x79, 0xac, 0xe1, 0x95, 0x61, 0x3f, 0x8f, 0xf6, 0x24, 0x0e, 0x3d, 0xdb, 0x6b, 0xee, 0x78, 0x2d,
+	0x05, 0x88, 0xe4, 0xb6, 0x90, 0x5b, 0x1f, 0xb9, 0x4e, 0x70, 0x28, 0x71, 0x0e, 0xcb, 0xf8, 0x75,
+	0x39, 0x87, 0xc4, 0x2d, 0xc3, 0x3f, 0x20, 0xd9, 0x5e, 0x47, 0x4e, 0x85, 0x2e, 0xb9, 0x48, 0xa4,
+	0x42, 0x97, 0x59, 0xbf, 0xb2, 0xc5, 0xa1, 0xaf, 0xe3, 0x55, 0x39, 0x74, 0xff, 0x2e, 0xe0, 0xf2,
+	0x62, 0x7e, 0x25, 0x88, 0xe9, 0xff, 0x3d, 0x82, 0x4c, 0xc4, 0x0d, 0x70, 0x21, 0x05, 0x47, 0xd2,
+	0xb2, 0x73, 0xc5, 0x51, 0x4a, 0x04, 0xea, 0xb7, 0x38, 0xea, 0x55, 0x5c, 0x92, 0xa3, 0xe6, 0x20,
+	0x63, 0x60, 0x35, 0xf1, 0x47, 0xd5, 0xaf, 0x08, 0xae, 0xf6, 0xf7, 0x31, 0x7c, 0xf7, 0x9c, 0xf6,
+	0x17, 0x30, 0xd9, 0xfa, 0x4f, 0xe6, 0xa9, 0xac, 0x72, 0x52, 0x1a, 0x5e, 0x4e, 0x23, 0xb5, 0x19,
+	0x35, 0x6e, 0x7c, 0x82, 0xe0, 0x6a, 0x7f, 0x8b, 0x4a, 0xa5, 0x33, 0xd0, 0x48, 0x53, 0xe9, 0x0c,
+	0xf6, 0x45, 0xe5, 0x4d, 0x4e, 0xa7, 0x84, 0x0b, 0x72, 0x3a, 0x66, 0xb7, 0x43, 0x74, 0x42, 0xe1,
+	0xaf, 0x11, 0x4c, 0x05, 0xf6, 0x82, 0xdf, 0x48, 0x01, 0x11, 0x73, 0xb5, 0xdc, 0xf2, 0x90, 0xd9,
+	0x02, 0xe2, 0x12, 0x87, 0xa8, 0xe0, 0x45, 0x39, 0xc4, 0xc0, 0xd7, 0xca, 0x1f, 0x3c, 0x3b, 0xcd,
+	0xa3, 0x93, 0xd3, 0x3c, 0xfa, 0xfb, 0x34, 0x8f, 0xbe, 0x39, 0xcb, 0x4f, 0x9c, 0x9c, 0xe5, 0x27,
+	0xfe, 0x3c, 0xcb, 0x4f, 0x7c, 0xba, 0x5a, 0xb3, 0x58, 0xbd, 0x55, 0x55, 0x4d, 0xda, 0x08, 0xbb,
+	0x98, 0x75, 0xc3, 0x72, 0x9e, 0xb7, 0xfc, 0xa2, 0xa7, 0x29, 0xeb, 0xb8, 0xc4, 0xab, 0x4e, 0xf1,
+	0x7f, 0x23, 0x94, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x09, 0xdb, 0x3b, 0x56, 0x11, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -999,6 +1054,8 @@ type QueryClient interface {
 	EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error)
 	// RecentEpochStatusCount queries the number of epochs with each status in recent epochs
 	RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error)
+	// ConflictingCheckpoints queries the evidences of the conflicting checkpoints found on BTC
+	ConflictingCheckpoints(ctx context.Context, in *QueryConflictingCheckpointsRequest, opts ...grpc.CallOption) (*QueryConflictingCheckpointsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1074,6 +1131,15 @@ func (c *queryClient) RecentEpochStatusCount(ctx context.Context, in *QueryRecen
 	return out, nil
 }
 
+func (c *queryClient) ConflictingCheckpoints(ctx context.Context, in *QueryConflictingCheckpointsRequest, opts ...grpc.CallOption) (*QueryConflictingCheckpointsResponse, error) {
+	out := new(QueryConflictingCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/ConflictingCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/Params", in, out, opts...)
@@ -1099,6 +1165,8 @@ type QueryServer interface {
 	EpochStatus(context.Context, *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error)
 	// RecentEpochStatusCount queries the number of epochs with each status in recent epochs
 	RecentEpochStatusCount(context.Context, *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error)
+	// ConflictingCheckpoints queries the evidences of the conflicting checkpoints found on BTC
+	ConflictingCheckpoints(context.Context, *QueryConflictingCheckpointsRequest) (*QueryConflictingCheckpointsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1128,6 +1196,9 @@ func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpoc
 func (*UnimplementedQueryServer) RecentEpochStatusCount(ctx context.Context, req *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecentEpochStatusCount not implemented")
 }
+func (*UnimplementedQueryServer) ConflictingCheckpoints(ctx context.Context, req *QueryConflictingCheckpointsRequest) (*QueryConflictingCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConflictingCheckpoints not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1262,6 +1333,24 @@ func _Query_RecentEpochStatusCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConflictingCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConflictingCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConflictingCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/ConflictingCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConflictingCheckpoints(ctx, req.(*QueryConflictingCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1312,6 +1401,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecentEpochStatusCount",
 			Handler:    _Query_RecentEpochStatusCount_Handler,
 		},
+		{
+			MethodName: "ConflictingCheckpoints",
+			Handler:    _Query_ConflictingCheckpoints_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1843,7 +1936,7 @@ func (m *QueryRecentEpochStatusCountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryConflictingCheckpointsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1853,20 +1946,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConflictingCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConflictingCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryConflictingCheckpointsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1876,30 +1981,46 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConflictingCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConflictingCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorWithBlsKey) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1909,30 +2030,49 @@ func (m *ValidatorWithBlsKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorWithBlsKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorWithBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlsPubKey) > 0 {
-		i -= len(m.BlsPubKey)
-		copy(dAtA[i:], m.BlsPubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -2158,40 +2298,55 @@ func (m *QueryRecentEpochStatusCountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryConflictingCheckpointsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryConflictingCheckpointsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *ValidatorWithBlsKey) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BlsPubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -3575,7 +3730,7 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConflictingCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3598,12 +3753,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConflictingCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConflictingCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3625,7 +3816,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConflictingCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,15 +3839,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConflictingCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConflictingCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3683,7 +3874,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Evidences = append(m.Evidences, &ConflictingCheckpointEvidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3708,7 +3936,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorWithBlsKey) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3731,49 +3959,67 @@ func (m *ValidatorWithBlsKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorWithBlsKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorWithBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3783,24 +4029,23 @@ func (m *ValidatorWithBlsKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlsPubKey == nil {
-				m.BlsPubKey = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/boljen/go-bitmap"
@@ -23,15 +24,55 @@ type BlsSigHash []byte
 
 type RawCkptHash []byte
 
-func NewCheckpoint(epochNum uint64, lch LastCommitHash) *RawCheckpoint {
+// NewCheckpoint creates an empty checkpoint of an epoch whose validator set has
+// numVals validators
+func NewCheckpoint(epochNum uint64, lch LastCommitHash, numVals int) *RawCheckpoint {
 	return &RawCheckpoint{
 		EpochNum:       epochNum,
 		LastCommitHash: &lch,
-		Bitmap:         bitmap.New(104), // 13 bytes, holding 100 validators
+		Bitmap:         bitmap.New(8 * BitmapLength(numVals)),
 		BlsMultiSig:    nil,
 	}
 }
 
+// BitmapLength returns the length in bytes of the bitmaps of the checkpoints of a
+// validator set with numVals validators, holding one bit per validator. Bitmaps
+// have at least txformat.BitMapLength bytes so that the checkpoints of small
+// validator sets can be encoded in all format versions.
+func BitmapLength(numVals int) int {
+	length := (numVals + 7) / 8
+	if length < txformat.BitMapLength {
+		return txformat.BitMapLength
+	}
+	return length
+}
+
+// ValidateValSetSize returns an error if the checkpoints of a validator set with
+// numVals validators need a bitmap longer than maxBitMapLength, i.e., the length of
+// the longest bitmap which can be encoded in the format versions accepted on BTC
+func ValidateValSetSize(numVals int, maxBitMapLength int) error {
+	if BitmapLength(numVals) > maxBitMapLength {
+		return ErrValSetTooLarge.Wrapf("%d validators while at most %d are supported",
+			numVals, 8*maxBitMapLength)
+	}
+	return nil
+}
+
+// ValidateBitmap checks that the bitmap is the bitmap of a checkpoint of a
+// validator set with numVals validators
+func ValidateBitmap(bm bitmap.Bitmap, numVals int) error {
+	if len(bm) != BitmapLength(numVals) {
+		return ErrInvalidRawCheckpoint.Wrapf("bitmap has %d bytes while the validator set requires %d",
+			len(bm), BitmapLength(numVals))
+	}
+	for i := numVals; i < bm.Len(); i++ {
+		if bm.Get(i) {
+			return ErrInvalidRawCheckpoint.Wrapf("bitmap selects validator %d out of %d", i, numVals)
+		}
+	}
+	return nil
+}
+
 func NewCheckpointWithMeta(ckpt *RawCheckpoint, status CheckpointStatus) *RawCheckpointWithMeta {
 	return &RawCheckpointWithMeta{
 		Ckpt:   ckpt,
@@ -44,19 +85,26 @@ func NewCheckpointWithMeta(ckpt *RawCheckpoint, status CheckpointStatus) *RawChe
 // 2. aggregates the BLS public key
 // 3. updates Bitmap
 // 4. accumulates voting power
+// 5. seals the checkpoint once the voting power reaches the quorum threshold
 // it returns True if the checkpoint is updated
 func (cm *RawCheckpointWithMeta) Accumulate(
 	vals epochingtypes.ValidatorSet,
 	signerAddr sdk.ValAddress,
 	signerBlsKey bls12381.PublicKey,
 	sig bls12381.Signature,
-	totalPower int64) (bool, error) {
+	totalPower int64,
+	quorumThreshold Fraction) (bool, error) {
 
 	// the checkpoint should be accumulating
 	if cm.Status != Accumulating {
 		return false, ErrCkptNotAccumulating
 	}
 
+	// the bitmap should be the one of the validator set
+	if err := ValidateBitmap(cm.Ckpt.Bitmap, len(vals)); err != nil {
+		return false, err
+	}
+
 	// get validator and its index
 	val, index, err := vals.FindValidatorWithIndex(signerAddr)
 	if err != nil {
@@ -95,7 +143,7 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 
 	// accumulate voting power and update status when the threshold is reached
 	cm.PowerSum += uint64(val.Power)
-	if int64(cm.PowerSum) > totalPower/3 {
+	if quorumThreshold.IsReachedBy(cm.PowerSum, uint64(totalPower)) {
 		cm.Status = Sealed
 	}
 
@@ -178,6 +226,39 @@ func RawCkptToBytes(cdc codec.BinaryCodec, ckpt *RawCheckpoint) []byte {
 	return cdc.MustMarshal(ckpt)
 }
 
+// FromBTCCkptBytesToRawCkpt decodes the checkpoint data connected from the BTC
+// transactions, as encoded by btctxformatter
+func FromBTCCkptBytesToRawCkpt(btcCkptBytes []byte) (*RawCheckpoint, error) {
+	btcCkpt, err := txformat.DecodeRawCheckpoint(btcCkptBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	lch := LastCommitHash(btcCkpt.LastCommitHash)
+	sig := bls12381.Signature(btcCkpt.BlsSig)
+
+	return &RawCheckpoint{
+		EpochNum:       btcCkpt.Epoch,
+		LastCommitHash: &lch,
+		Bitmap:         btcCkpt.BitMap,
+		BlsMultiSig:    &sig,
+	}, nil
+}
+
+// FromRawCkptToBTCCkptBytes encodes the checkpoint along with the address of its
+// submitter in the layout of the checkpoint data connected from the BTC transactions
+func FromRawCkptToBTCCkptBytes(ckpt *RawCheckpoint, submitterAddress []byte) []byte {
+	btcCkpt := txformat.RawBtcCheckpoint{
+		Epoch:            ckpt.EpochNum,
+		LastCommitHash:   *ckpt.LastCommitHash,
+		BitMap:           ckpt.Bitmap,
+		SubmitterAddress: submitterAddress,
+		BlsSig:           *ckpt.BlsMultiSig,
+	}
+
+	return btcCkpt.Encode()
+}
+
 // ValidateBasic does sanity checks on a raw checkpoint
 func (ckpt RawCheckpoint) ValidateBasic() error {
 	if ckpt.EpochNum == 0 {
@@ -186,6 +267,10 @@ func (ckpt RawCheckpoint) ValidateBasic() error {
 	if ckpt.Bitmap == nil {
 		return ErrInvalidRawCheckpoint.Wrapf("bitmap cannot be empty")
 	}
+	if len(ckpt.Bitmap) < txformat.BitMapLength || len(ckpt.Bitmap) > txformat.MaxSupportedBitMapLength() {
+		return ErrInvalidRawCheckpoint.Wrapf("bitmap should have between %d and %d bytes",
+			txformat.BitMapLength, txformat.MaxSupportedBitMapLength())
+	}
 	err := ckpt.LastCommitHash.ValidateBasic()
 	if err != nil {
 		return ErrInvalidRawCheckpoint.Wrapf(err.Error())
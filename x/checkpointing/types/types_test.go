@@ -1,10 +1,10 @@
 package types_test
 
 import (
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/testutil/datagen"
-	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/boljen/go-bitmap"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -15,20 +15,18 @@ func TestRawCheckpointWithMeta_Accumulate1(t *testing.T) {
 	epochNum := uint64(2)
 	n := 1
 	totalPower := int64(10)
-	ckptkeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
 	lch := datagen.GenRandomLastCommitHash()
 	msg := append(sdk.Uint64ToBigEndian(epochNum), lch...)
 	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
-	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, lch)
-	require.NoError(t, err)
 	valSet := datagen.GenRandomValSet(n)
-	updated, err := ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower)
+	ckpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, n), types.Accumulating)
+	updated, err := ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower, types.DefaultQuorumThreshold)
 	require.NoError(t, err)
 	require.True(t, updated)
 	require.Equal(t, types.Sealed, ckpt.Status)
 
 	// accumulate the same BLS sig
-	updated, err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower)
+	updated, err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower, types.DefaultQuorumThreshold)
 	require.ErrorIs(t, err, types.ErrCkptNotAccumulating)
 	require.False(t, updated)
 	require.Equal(t, types.Sealed, ckpt.Status)
@@ -39,16 +37,13 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 	epochNum := uint64(2)
 	n := 4
 	totalPower := int64(10) * int64(n)
-	ckptkeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil, client.Context{})
 	lch := datagen.GenRandomLastCommitHash()
 	msg := append(sdk.Uint64ToBigEndian(epochNum), lch...)
 	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
-	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, lch)
-	require.NoError(t, err)
 	valSet := datagen.GenRandomValSet(n)
+	ckpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, n), types.Accumulating)
 	for i := 0; i < n; i++ {
-		var updated bool
-		updated, err = ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower)
+		updated, err := ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower, types.DefaultQuorumThreshold)
 		if i == 0 {
 			require.NoError(t, err)
 			require.True(t, updated)
@@ -66,3 +61,74 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 		}
 	}
 }
+
+// 4 validators with a quorum threshold of 2/3
+func TestRawCheckpointWithMeta_AccumulateWithThreshold(t *testing.T) {
+	epochNum := uint64(2)
+	n := 4
+	totalPower := int64(10) * int64(n)
+	threshold := types.Fraction{Numerator: 2, Denominator: 3}
+	lch := datagen.GenRandomLastCommitHash()
+	msg := append(sdk.Uint64ToBigEndian(epochNum), lch...)
+	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
+	valSet := datagen.GenRandomValSet(n)
+	ckpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, n), types.Accumulating)
+	for i := 0; i < n-1; i++ {
+		updated, err := ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower, threshold)
+		require.NoError(t, err)
+		require.True(t, updated)
+		if i < 2 {
+			require.Equal(t, types.Accumulating, ckpt.Status)
+		} else {
+			require.Equal(t, types.Sealed, ckpt.Status)
+		}
+	}
+}
+
+// more validators than the bitmap of the two-part format can hold
+func TestRawCheckpointWithMeta_AccumulateLargeValSet(t *testing.T) {
+	epochNum := uint64(2)
+	n := 200
+	totalPower := int64(10) * int64(n)
+	lch := datagen.GenRandomLastCommitHash()
+	msg := append(sdk.Uint64ToBigEndian(epochNum), lch...)
+	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
+	valSet := datagen.GenRandomValSet(n)
+	ckpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, n), types.Accumulating)
+	require.Len(t, ckpt.Ckpt.Bitmap, 25)
+
+	updated, err := ckpt.Accumulate(valSet, valSet[n-1].Addr, blsPubkeys[n-1], blsSigs[n-1], totalPower, types.DefaultQuorumThreshold)
+	require.NoError(t, err)
+	require.True(t, updated)
+	signers, err := valSet.FindSubset(ckpt.Ckpt.Bitmap)
+	require.NoError(t, err)
+	require.Equal(t, valSet[n-1:], signers)
+
+	// the checkpoint of another validator set cannot be accumulated
+	smallCkpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, lch, 4), types.Accumulating)
+	updated, err = smallCkpt.Accumulate(valSet, valSet[n-1].Addr, blsPubkeys[n-1], blsSigs[n-1], totalPower, types.DefaultQuorumThreshold)
+	require.ErrorIs(t, err, types.ErrInvalidRawCheckpoint)
+	require.False(t, updated)
+	_, err = valSet.FindSubset(smallCkpt.Ckpt.Bitmap)
+	require.Error(t, err)
+}
+
+func TestBitmapLength(t *testing.T) {
+	require.Equal(t, txformat.BitMapLength, types.BitmapLength(1))
+	require.Equal(t, txformat.BitMapLength, types.BitmapLength(8*txformat.BitMapLength))
+	require.Equal(t, txformat.BitMapLength+1, types.BitmapLength(8*txformat.BitMapLength+1))
+
+	// the validator set fits in the bitmaps of the accepted format versions
+	for _, maxBitMapLength := range []int{txformat.BitMapLength, txformat.MaxSupportedBitMapLength()} {
+		maxVals := 8 * maxBitMapLength
+		require.NoError(t, types.ValidateValSetSize(maxVals, maxBitMapLength))
+		require.ErrorIs(t, types.ValidateValSetSize(maxVals+1, maxBitMapLength), types.ErrValSetTooLarge)
+	}
+
+	// bits beyond the validator set cannot be set
+	bm := types.NewCheckpoint(1, datagen.GenRandomLastCommitHash(), 4).Bitmap
+	require.NoError(t, types.ValidateBitmap(bm, 4))
+	bitmap.Set(bm, 4, true)
+	require.Error(t, types.ValidateBitmap(bm, 4))
+	require.NoError(t, types.ValidateBitmap(bm, 5))
+}
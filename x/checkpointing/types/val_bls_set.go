@@ -0,0 +1,51 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/boljen/go-bitmap"
+)
+
+// FindSubsetWithPowerSum returns the validators selected by the bitmap along with
+// the sum of their voting power
+func (ks *ValidatorWithBlsKeySet) FindSubsetWithPowerSum(bm bitmap.Bitmap) (*ValidatorWithBlsKeySet, uint64, error) {
+	if err := ValidateBitmap(bm, len(ks.ValSet)); err != nil {
+		return nil, 0, err
+	}
+
+	var subset []*ValidatorWithBlsKey
+	var sum uint64
+
+	for i := 0; i < bm.Len(); i++ {
+		if bm.Get(i) {
+			if i >= len(ks.ValSet) {
+				return nil, 0, errors.New("invalid validator index")
+			}
+			subset = append(subset, ks.ValSet[i])
+			sum += ks.ValSet[i].VotingPower
+		}
+	}
+
+	return &ValidatorWithBlsKeySet{ValSet: subset}, sum, nil
+}
+
+// GetTotalPower returns the sum of the voting power of all validators of the set
+func (ks *ValidatorWithBlsKeySet) GetTotalPower() uint64 {
+	var total uint64
+	for _, v := range ks.ValSet {
+		total += v.VotingPower
+	}
+	return total
+}
+
+// GetBLSKeySet returns the bls public keys of the validators of the set
+func (ks *ValidatorWithBlsKeySet) GetBLSKeySet() ([]bls12381.PublicKey, error) {
+	keys := make([]bls12381.PublicKey, len(ks.ValSet))
+	for i, v := range ks.ValSet {
+		if err := keys[i].Unmarshal(v.BlsPubKey); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
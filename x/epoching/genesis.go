@@ -1,6 +1,8 @@
 package epoching
 
 import (
+	"fmt"
+
 	"github.com/babylonchain/babylon/x/epoching/keeper"
 	"github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,20 +13,72 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// set params for this module
 	k.SetParams(ctx, genState.Params)
-	// init epoch number
-	k.InitEpoch(ctx)
-	// init msg queue
-	k.InitMsgQueue(ctx)
-	// init validator set
-	k.InitValidatorSet(ctx)
-	// init slashed voting power
-	k.InitSlashedVotingPower(ctx)
+
+	// a genesis state without validator sets is the genesis of a new chain
+	if len(genState.ValidatorSets) == 0 {
+		// init epoch number
+		k.InitEpoch(ctx)
+		// init msg queue
+		k.InitMsgQueue(ctx)
+		// init validator set
+		k.InitValidatorSet(ctx)
+		// init slashed voting power
+		k.InitSlashedVotingPower(ctx)
+		return
+	}
+
+	// otherwise, it is the state of an existing chain in the middle of an epoch
+	k.SetEpochNumber(ctx, genState.EpochNumber)
+	for _, epoch := range genState.Epochs {
+		k.SetEpochInfo(ctx, epoch)
+	}
+	// the chain needs to resume within the current epoch, which is not the case of the
+	// state exported for zero height as the block heights start over
+	epoch := k.GetEpoch(ctx)
+	initialHeight := uint64(ctx.BlockHeight())
+	if initialHeight == 0 {
+		initialHeight = 1
+	}
+	if initialHeight < epoch.FirstBlockHeight || initialHeight > epoch.GetLastBlockHeight()+1 {
+		panic(fmt.Errorf("the initial height %d is not within the current epoch %d, which spans from height %d to %d",
+			initialHeight, epoch.EpochNumber, epoch.FirstBlockHeight, epoch.GetLastBlockHeight()))
+	}
+	for _, queue := range genState.Queues {
+		k.SetEpochMsgs(ctx, queue)
+	}
+	for _, valSet := range genState.ValidatorSets {
+		k.SetValidatorSet(ctx, valSet)
+	}
+	for _, valSet := range genState.SlashedValidatorSets {
+		k.SetSlashedValidatorSet(ctx, valSet)
+	}
+	for _, lc := range genState.ValidatorLifecycles {
+		valAddr, err := sdk.ValAddressFromBech32(lc.ValAddr)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValLifecycle(ctx, valAddr, lc)
+	}
+	for _, lc := range genState.DelegationLifecycles {
+		delAddr, err := sdk.AccAddressFromBech32(lc.DelAddr)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegationLifecycle(ctx, delAddr, lc)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.EpochNumber = k.GetEpoch(ctx).EpochNumber
+	genesis.Epochs = k.GetAllEpochs(ctx)
+	genesis.Queues = k.GetAllEpochMsgs(ctx)
+	genesis.ValidatorSets = k.GetAllValidatorSets(ctx)
+	genesis.SlashedValidatorSets = k.GetAllSlashedValidatorSets(ctx)
+	genesis.ValidatorLifecycles = k.GetAllValLifecycles(ctx)
+	genesis.DelegationLifecycles = k.GetAllDelegationLifecycles(ctx)
 
 	return genesis
 }
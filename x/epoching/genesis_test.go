@@ -2,8 +2,13 @@ package epoching_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/epoching"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -33,3 +38,141 @@ func TestInitGenesis(t *testing.T) {
 	epoching.InitGenesis(ctx, app.EpochingKeeper, genesisState)
 	require.Equal(t, app.EpochingKeeper.GetParams(ctx).EpochInterval, uint64(100))
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	k, ctx := testkeeper.EpochingKeeper(t)
+
+	blockTime := time.Unix(1000000, 0).UTC()
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(datagen.GenRandomByteArray(20)),
+		sdk.ValAddress(datagen.GenRandomByteArray(20)),
+	}
+	delAddr := sdk.AccAddress(datagen.GenRandomByteArray(20))
+	valSet := func(epochNumber uint64) *types.EpochValidatorSet {
+		return &types.EpochValidatorSet{
+			EpochNumber: epochNumber,
+			Validators: []types.ValidatorPower{
+				{ValAddr: valAddrs[0].String(), Power: 10},
+				{ValAddr: valAddrs[1].String(), Power: 20},
+			},
+			TotalVotingPower: 30,
+		}
+	}
+	queue := func(epochNumber uint64, numMsgs int) *types.QueuedMessageList {
+		msgs := []*types.QueuedMessage{}
+		for i := 0; i < numMsgs; i++ {
+			msgs = append(msgs, &types.QueuedMessage{
+				TxId:        datagen.GenRandomByteArray(32),
+				MsgId:       datagen.GenRandomByteArray(32),
+				BlockHeight: epochNumber * 10,
+				BlockTime:   &blockTime,
+				Msg: &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: valAddrs[i%2].String(),
+					Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(i+1)),
+				}},
+			})
+		}
+		return &types.QueuedMessageList{EpochNumber: epochNumber, Msgs: msgs}
+	}
+
+	epochs := []*types.Epoch{}
+	for epochNumber := uint64(0); epochNumber <= 2; epochNumber++ {
+		epoch := types.NewEpoch(epochNumber, types.DefaultEpochInterval)
+		epochs = append(epochs, &epoch)
+	}
+
+	genesisState := types.GenesisState{
+		Params:        types.DefaultParams(),
+		EpochNumber:   2,
+		Epochs:        epochs,
+		Queues:        []*types.QueuedMessageList{queue(0, 0), queue(1, 3), queue(2, 2)},
+		ValidatorSets: []*types.EpochValidatorSet{valSet(0), valSet(1), valSet(2)},
+		SlashedValidatorSets: []*types.EpochValidatorSet{
+			{EpochNumber: 0, Validators: []types.ValidatorPower{}},
+			{EpochNumber: 1, Validators: []types.ValidatorPower{{ValAddr: valAddrs[1].String(), Power: 20}}, TotalVotingPower: 20},
+			{EpochNumber: 2, Validators: []types.ValidatorPower{}},
+		},
+		ValidatorLifecycles: []*types.ValidatorLifecycle{{
+			ValAddr: valAddrs[0].String(),
+			ValLife: []*types.ValStateUpdate{{State: types.BondState_BONDED, BlockHeight: 1, BlockTime: &blockTime}},
+		}},
+		DelegationLifecycles: []*types.DelegationLifecycle{{
+			DelAddr: delAddr.String(),
+			DelLife: []*types.DelegationStateUpdate{{State: types.BondState_BONDED, ValAddr: valAddrs[0].String(), BlockHeight: 1, BlockTime: &blockTime}},
+		}},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	// the chain resumes in the middle of the current epoch
+	ctx = ctx.WithBlockHeight(int64(epochs[2].FirstBlockHeight) + 1)
+	epoching.InitGenesis(ctx, *k, genesisState)
+	require.Equal(t, *epochs[2], k.GetEpoch(ctx))
+	require.Equal(t, uint64(2), k.GetCurrentQueueLength(ctx))
+	require.Equal(t, int64(30), k.GetTotalVotingPower(ctx, 2))
+	require.Equal(t, int64(20), k.GetSlashedVotingPower(ctx, 1))
+
+	exported := epoching.ExportGenesis(ctx, *k)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, genesisState.EpochNumber, exported.EpochNumber)
+	require.Equal(t, genesisState.Epochs, exported.Epochs)
+	require.Equal(t, genesisState.Queues, exported.Queues)
+	require.ElementsMatch(t, genesisState.ValidatorSets[2].Validators, exported.ValidatorSets[2].Validators)
+	require.Len(t, exported.ValidatorSets, len(genesisState.ValidatorSets))
+	require.Equal(t, genesisState.SlashedValidatorSets, exported.SlashedValidatorSets)
+	require.Equal(t, genesisState.ValidatorLifecycles, exported.ValidatorLifecycles)
+	require.Equal(t, genesisState.DelegationLifecycles, exported.DelegationLifecycles)
+}
+
+func TestGenesisRoundTripAcrossEpochBoundary(t *testing.T) {
+	k, ctx := testkeeper.EpochingKeeper(t)
+
+	valSet := func(epochNumber uint64) *types.EpochValidatorSet {
+		return &types.EpochValidatorSet{
+			EpochNumber:      epochNumber,
+			Validators:       []types.ValidatorPower{{ValAddr: sdk.ValAddress(datagen.GenRandomByteArray(20)).String(), Power: 10}},
+			TotalVotingPower: 10,
+		}
+	}
+
+	// epochs 1 and 2 span 5 blocks, and epoch 3 spans 7 blocks from height 11 after
+	// the epoch interval changes
+	k.SetParams(ctx, types.NewParams(5))
+	k.InitEpoch(ctx)
+	for epochNumber := uint64(0); epochNumber <= 3; epochNumber++ {
+		if epochNumber == 3 {
+			k.SetParams(ctx, types.NewParams(7))
+		}
+		if epochNumber > 0 {
+			k.IncEpoch(ctx)
+		}
+		k.SetValidatorSet(ctx, valSet(epochNumber))
+		k.SetSlashedValidatorSet(ctx, &types.EpochValidatorSet{EpochNumber: epochNumber})
+	}
+	epoch := k.GetEpoch(ctx)
+	require.Equal(t, uint64(11), epoch.FirstBlockHeight)
+	require.Equal(t, uint64(17), epoch.GetLastBlockHeight())
+
+	// the state is exported at height 13 and imported at height 14
+	exported := epoching.ExportGenesis(ctx.WithBlockHeight(13), *k)
+	require.NoError(t, exported.Validate())
+
+	newK, newCtx := testkeeper.EpochingKeeper(t)
+	newCtx = newCtx.WithBlockHeight(14)
+	epoching.InitGenesis(newCtx, *newK, *exported)
+	require.Equal(t, epoch, newK.GetEpoch(newCtx))
+
+	// the next epoch begins upon the same height as in the exporting chain
+	for height := int64(14); height <= 18; height++ {
+		newCtx = newCtx.WithBlockHeight(height)
+		require.Equal(t, height == 18, newK.GetEpoch(newCtx).IsFirstBlockOfNextEpoch(newCtx))
+	}
+	nextEpoch := newK.IncEpoch(newCtx)
+	require.Equal(t, uint64(4), nextEpoch.EpochNumber)
+	require.Equal(t, uint64(18), nextEpoch.FirstBlockHeight)
+	require.True(t, nextEpoch.IsLastBlock(newCtx.WithBlockHeight(24)))
+
+	// the state exported for zero height does not resume within the current epoch
+	zeroK, zeroCtx := testkeeper.EpochingKeeper(t)
+	require.Panics(t, func() { epoching.InitGenesis(zeroCtx, *zeroK, *exported) })
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -17,9 +19,17 @@ func (k Keeper) setEpochNumber(ctx sdk.Context, epochNumber uint64) {
 	store.Set(types.EpochNumberKey, epochNumberBytes)
 }
 
+// setEpochInfo stores the metadata of an epoch
+func (k Keeper) setEpochInfo(ctx sdk.Context, epoch *types.Epoch) {
+	epochNumberBytes := sdk.Uint64ToBigEndian(epoch.EpochNumber)
+	k.epochInfoStore(ctx).Set(epochNumberBytes, k.cdc.MustMarshal(epoch))
+}
+
 // InitEpoch sets the zero epoch number to DB
 func (k Keeper) InitEpoch(ctx sdk.Context) {
 	k.setEpochNumber(ctx, 0)
+	epoch := types.NewEpoch(0, k.GetParams(ctx).EpochInterval)
+	k.setEpochInfo(ctx, &epoch)
 }
 
 // GetEpoch fetches the current epoch
@@ -31,15 +41,45 @@ func (k Keeper) GetEpoch(ctx sdk.Context) types.Epoch {
 		panic(types.ErrUnknownEpochNumber)
 	}
 	epochNumber := sdk.BigEndianToUint64(bz)
-	epochInterval := k.GetParams(ctx).EpochInterval
-	return types.NewEpoch(epochNumber, epochInterval)
+	epoch, err := k.GetHistoricalEpoch(ctx, epochNumber)
+	if err != nil {
+		panic(err)
+	}
+	return *epoch
+}
+
+// GetHistoricalEpoch fetches the metadata of a given epoch, including the height of
+// its first block and its interval, which may differ from the current parameters
+func (k Keeper) GetHistoricalEpoch(ctx sdk.Context, epochNumber uint64) (*types.Epoch, error) {
+	bz := k.epochInfoStore(ctx).Get(sdk.Uint64ToBigEndian(epochNumber))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownEpochNumber, "epoch %d", epochNumber)
+	}
+	var epoch types.Epoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return &epoch, nil
 }
 
 // IncEpoch adds epoch number by 1
+// The new epoch starts right after the last block of the current one and
+// lasts for the epoch interval of the current parameters
 func (k Keeper) IncEpoch(ctx sdk.Context) types.Epoch {
-	epochNumber := k.GetEpoch(ctx).EpochNumber
-	incrementedEpochNumber := epochNumber + 1
-	k.setEpochNumber(ctx, incrementedEpochNumber)
-	epochInterval := k.GetParams(ctx).EpochInterval
-	return types.NewEpoch(incrementedEpochNumber, epochInterval)
+	epoch := k.GetEpoch(ctx)
+	incEpoch := types.Epoch{
+		EpochNumber:          epoch.EpochNumber + 1,
+		CurrentEpochInterval: k.GetParams(ctx).EpochInterval,
+		FirstBlockHeight:     epoch.GetLastBlockHeight() + 1,
+	}
+	k.setEpochNumber(ctx, incEpoch.EpochNumber)
+	k.setEpochInfo(ctx, &incEpoch)
+	return incEpoch
+}
+
+// epochInfoStore returns the KVStore of the metadata of each epoch
+// prefix: EpochInfoKey
+// key: epochNumber
+// value: Epoch
+func (k Keeper) epochInfoStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.EpochInfoKey)
 }
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"github.com/babylonchain/babylon/x/epoching/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetEpochNumber sets the number of the current epoch
+// This is called upon initialising the genesis state
+func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber uint64) {
+	k.setEpochNumber(ctx, epochNumber)
+}
+
+// GetAllEpochs returns the metadata of all epochs by the ascending order of epoch number
+func (k Keeper) GetAllEpochs(ctx sdk.Context) []*types.Epoch {
+	epochs := []*types.Epoch{}
+
+	iterator := k.epochInfoStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.Epoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, &epoch)
+	}
+	return epochs
+}
+
+// SetEpochInfo stores the metadata of a given epoch
+// This is called upon initialising the genesis state
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch *types.Epoch) {
+	k.setEpochInfo(ctx, epoch)
+}
+
+// GetAllEpochMsgs returns the queued messages of all epochs by the ascending order of epoch number
+func (k Keeper) GetAllEpochMsgs(ctx sdk.Context) []*types.QueuedMessageList {
+	queues := []*types.QueuedMessageList{}
+
+	iterator := k.msgQueueLengthStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		epochNumber := sdk.BigEndianToUint64(iterator.Key())
+		queues = append(queues, &types.QueuedMessageList{
+			EpochNumber: epochNumber,
+			Msgs:        k.GetEpochMsgs(ctx, epochNumber),
+		})
+	}
+	return queues
+}
+
+// SetEpochMsgs stores the queue of messages of a given epoch along with its length
+// This is called upon initialising the genesis state
+func (k Keeper) SetEpochMsgs(ctx sdk.Context, queue *types.QueuedMessageList) {
+	store := k.msgQueueStore(ctx, queue.EpochNumber)
+	for i, msg := range queue.Msgs {
+		msgBytes, err := k.cdc.Marshal(msg)
+		if err != nil {
+			panic(sdkerrors.Wrap(types.ErrMarshal, err.Error()))
+		}
+		store.Set(sdk.Uint64ToBigEndian(uint64(i)), msgBytes)
+	}
+
+	epochNumberBytes := sdk.Uint64ToBigEndian(queue.EpochNumber)
+	queueLenBytes := sdk.Uint64ToBigEndian(uint64(len(queue.Msgs)))
+	k.msgQueueLengthStore(ctx).Set(epochNumberBytes, queueLenBytes)
+}
+
+// GetAllValidatorSets returns the validator sets of all epochs by the ascending order of epoch number
+func (k Keeper) GetAllValidatorSets(ctx sdk.Context) []*types.EpochValidatorSet {
+	valSets := []*types.EpochValidatorSet{}
+
+	iterator := k.votingPowerStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		epochNumber := sdk.BigEndianToUint64(iterator.Key())
+		valSets = append(valSets, newEpochValidatorSet(
+			epochNumber,
+			k.GetValidatorSet(ctx, epochNumber),
+			k.GetTotalVotingPower(ctx, epochNumber),
+		))
+	}
+	return valSets
+}
+
+// SetValidatorSet stores the validator set of an epoch along with its total voting power
+// This is called upon initialising the genesis state
+func (k Keeper) SetValidatorSet(ctx sdk.Context, valSet *types.EpochValidatorSet) {
+	store := k.valSetStore(ctx, valSet.EpochNumber)
+	for _, val := range valSet.Validators {
+		setValidatorPower(store, val)
+	}
+
+	epochNumberBytes := sdk.Uint64ToBigEndian(valSet.EpochNumber)
+	totalPowerBytes, err := sdk.NewInt(valSet.TotalVotingPower).Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(types.ErrMarshal, err.Error()))
+	}
+	k.votingPowerStore(ctx).Set(epochNumberBytes, totalPowerBytes)
+}
+
+// GetAllSlashedValidatorSets returns the slashed validators of all epochs by the ascending order of epoch number
+func (k Keeper) GetAllSlashedValidatorSets(ctx sdk.Context) []*types.EpochValidatorSet {
+	valSets := []*types.EpochValidatorSet{}
+
+	iterator := k.slashedVotingPowerStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		epochNumber := sdk.BigEndianToUint64(iterator.Key())
+		valSets = append(valSets, newEpochValidatorSet(
+			epochNumber,
+			k.GetSlashedValidators(ctx, epochNumber),
+			k.GetSlashedVotingPower(ctx, epochNumber),
+		))
+	}
+	return valSets
+}
+
+// SetSlashedValidatorSet stores the slashed validators of an epoch along with the slashed voting power
+// This is called upon initialising the genesis state
+func (k Keeper) SetSlashedValidatorSet(ctx sdk.Context, valSet *types.EpochValidatorSet) {
+	store := k.slashedValSetStore(ctx, valSet.EpochNumber)
+	for _, val := range valSet.Validators {
+		setValidatorPower(store, val)
+	}
+	k.setSlashedVotingPower(ctx, valSet.EpochNumber, valSet.TotalVotingPower)
+}
+
+// GetAllValLifecycles returns the lifecycles of all validators
+func (k Keeper) GetAllValLifecycles(ctx sdk.Context) []*types.ValidatorLifecycle {
+	lcs := []*types.ValidatorLifecycle{}
+
+	iterator := k.valLifecycleStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lc types.ValidatorLifecycle
+		k.cdc.MustUnmarshal(iterator.Value(), &lc)
+		lcs = append(lcs, &lc)
+	}
+	return lcs
+}
+
+// GetAllDelegationLifecycles returns the lifecycles of all delegations
+func (k Keeper) GetAllDelegationLifecycles(ctx sdk.Context) []*types.DelegationLifecycle {
+	lcs := []*types.DelegationLifecycle{}
+
+	iterator := k.delegationLifecycleStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lc types.DelegationLifecycle
+		k.cdc.MustUnmarshal(iterator.Value(), &lc)
+		lcs = append(lcs, &lc)
+	}
+	return lcs
+}
+
+func newEpochValidatorSet(epochNumber uint64, vals types.ValidatorSet, totalPower int64) *types.EpochValidatorSet {
+	valSet := &types.EpochValidatorSet{
+		EpochNumber:      epochNumber,
+		Validators:       []types.ValidatorPower{},
+		TotalVotingPower: totalPower,
+	}
+	for _, val := range vals {
+		valSet.Validators = append(valSet.Validators, types.ValidatorPower{
+			ValAddr: val.Addr.String(),
+			Power:   val.Power,
+		})
+	}
+	return valSet
+}
+
+// setValidatorPower stores the voting power of a validator in a validator set store
+// key: string(address)
+// value: voting power (in int64 as per Cosmos SDK)
+func setValidatorPower(store sdk.KVStore, val types.ValidatorPower) {
+	addr, err := sdk.ValAddressFromBech32(val.ValAddr)
+	if err != nil {
+		panic(err)
+	}
+	powerBytes, err := sdk.NewInt(val.Power).Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(types.ErrMarshal, err.Error()))
+	}
+	store.Set(addr, powerBytes)
+}
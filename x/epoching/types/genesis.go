@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default capability global index
@@ -24,6 +30,150 @@ func NewGenesis(params Params) *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// the genesis of a new chain initialises the state of the first epoch from the staking module
+	if len(gs.ValidatorSets) == 0 {
+		if gs.EpochNumber != 0 || len(gs.Queues) > 0 || len(gs.SlashedValidatorSets) > 0 ||
+			len(gs.ValidatorLifecycles) > 0 || len(gs.DelegationLifecycles) > 0 || len(gs.Epochs) > 0 {
+			return fmt.Errorf("the validator sets of the epochs should be provided along with the rest of the epoching state")
+		}
+		return nil
+	}
+
+	epochNumbers := make(map[uint64]bool, len(gs.Epochs))
+	epochs := make(map[uint64]*Epoch, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if epoch == nil {
+			return fmt.Errorf("empty epoch")
+		}
+		if err := gs.validateEpochNumber(epochNumbers, epoch.EpochNumber); err != nil {
+			return fmt.Errorf("invalid epoch: %w", err)
+		}
+		if epoch.EpochNumber > 0 && (epoch.CurrentEpochInterval == 0 || epoch.FirstBlockHeight == 0) {
+			return fmt.Errorf("epoch %d should have a positive first block height and interval", epoch.EpochNumber)
+		}
+		epochs[epoch.EpochNumber] = epoch
+	}
+	if epochs[gs.EpochNumber] == nil {
+		return fmt.Errorf("the current epoch %d is not provided", gs.EpochNumber)
+	}
+	// each epoch starts right after the last block of the previous one
+	for _, epoch := range epochs {
+		if prev, ok := epochs[epoch.EpochNumber-1]; ok && epoch.EpochNumber > 0 && prev.GetLastBlockHeight()+1 != epoch.FirstBlockHeight {
+			return fmt.Errorf("epoch %d starts at height %d instead of right after the last block %d of the previous epoch",
+				epoch.EpochNumber, epoch.FirstBlockHeight, prev.GetLastBlockHeight())
+		}
+	}
+
+	queueEpochs := make(map[uint64]bool, len(gs.Queues))
+	for _, queue := range gs.Queues {
+		if queue == nil {
+			return fmt.Errorf("empty message queue")
+		}
+		if err := gs.validateEpochNumber(queueEpochs, queue.EpochNumber); err != nil {
+			return fmt.Errorf("invalid message queue: %w", err)
+		}
+		for _, msg := range queue.Msgs {
+			if msg == nil || msg.Msg == nil {
+				return fmt.Errorf("empty queued message in epoch %d", queue.EpochNumber)
+			}
+		}
+	}
+
+	valSetEpochs := make(map[uint64]bool, len(gs.ValidatorSets))
+	for _, valSet := range gs.ValidatorSets {
+		if err := gs.validateEpochValidatorSet(valSetEpochs, valSet); err != nil {
+			return fmt.Errorf("invalid validator set: %w", err)
+		}
+	}
+	if !valSetEpochs[gs.EpochNumber] {
+		return fmt.Errorf("the validator set of the current epoch %d is not provided", gs.EpochNumber)
+	}
+
+	slashedEpochs := make(map[uint64]bool, len(gs.SlashedValidatorSets))
+	for _, valSet := range gs.SlashedValidatorSets {
+		if err := gs.validateEpochValidatorSet(slashedEpochs, valSet); err != nil {
+			return fmt.Errorf("invalid slashed validator set: %w", err)
+		}
+	}
+	if !slashedEpochs[gs.EpochNumber] {
+		return fmt.Errorf("the slashed validator set of the current epoch %d is not provided", gs.EpochNumber)
+	}
+
+	valLifecycles := make(map[string]bool, len(gs.ValidatorLifecycles))
+	for _, lc := range gs.ValidatorLifecycles {
+		if lc == nil {
+			return fmt.Errorf("empty validator lifecycle")
+		}
+		valAddr, err := sdk.ValAddressFromBech32(lc.ValAddr)
+		if err != nil {
+			return fmt.Errorf("invalid validator address %s in validator lifecycle: %w", lc.ValAddr, err)
+		}
+		if valLifecycles[valAddr.String()] {
+			return fmt.Errorf("duplicate lifecycle of validator %s", lc.ValAddr)
+		}
+		valLifecycles[valAddr.String()] = true
+	}
+
+	delLifecycles := make(map[string]bool, len(gs.DelegationLifecycles))
+	for _, lc := range gs.DelegationLifecycles {
+		if lc == nil {
+			return fmt.Errorf("empty delegation lifecycle")
+		}
+		delAddr, err := sdk.AccAddressFromBech32(lc.DelAddr)
+		if err != nil {
+			return fmt.Errorf("invalid delegator address %s in delegation lifecycle: %w", lc.DelAddr, err)
+		}
+		if delLifecycles[delAddr.String()] {
+			return fmt.Errorf("duplicate lifecycle of delegator %s", lc.DelAddr)
+		}
+		delLifecycles[delAddr.String()] = true
+	}
+
+	return nil
+}
+
+// validateEpochNumber checks that the epoch number has not been encountered before
+// and that it is not after the current epoch
+func (gs GenesisState) validateEpochNumber(encountered map[uint64]bool, epochNumber uint64) error {
+	if epochNumber > gs.EpochNumber {
+		return fmt.Errorf("epoch %d is after the current epoch %d", epochNumber, gs.EpochNumber)
+	}
+	if encountered[epochNumber] {
+		return fmt.Errorf("duplicate epoch %d", epochNumber)
+	}
+	encountered[epochNumber] = true
+	return nil
+}
+
+// validateEpochValidatorSet checks that the validators of the set are unique
+// and that their voting power sums up to the total voting power of the set
+func (gs GenesisState) validateEpochValidatorSet(encountered map[uint64]bool, valSet *EpochValidatorSet) error {
+	if valSet == nil {
+		return fmt.Errorf("empty validator set")
+	}
+	if err := gs.validateEpochNumber(encountered, valSet.EpochNumber); err != nil {
+		return err
+	}
 
-	return gs.Params.Validate()
+	vals := make(map[string]bool, len(valSet.Validators))
+	totalPower := int64(0)
+	for _, val := range valSet.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValAddr)
+		if err != nil {
+			return fmt.Errorf("invalid validator address %s in epoch %d: %w", val.ValAddr, valSet.EpochNumber, err)
+		}
+		if vals[valAddr.String()] {
+			return fmt.Errorf("duplicate validator %s in epoch %d", val.ValAddr, valSet.EpochNumber)
+		}
+		vals[valAddr.String()] = true
+		totalPower += val.Power
+	}
+	if totalPower != valSet.TotalVotingPower {
+		return fmt.Errorf("total voting power %d of epoch %d does not match the sum %d of the voting power of its validators", valSet.TotalVotingPower, valSet.EpochNumber, totalPower)
+	}
+	return nil
 }
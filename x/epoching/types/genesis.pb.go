@@ -26,6 +26,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the epoching module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// epoch_number is the number of the current epoch
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// queues are the queued messages of all epochs, including the current one
+	Queues []*QueuedMessageList `protobuf:"bytes,3,rep,name=queues,proto3" json:"queues,omitempty"`
+	// validator_sets are the validator sets of all epochs, including the current one.
+	// If empty, the validator set of the current epoch is initialised from the staking module
+	ValidatorSets []*EpochValidatorSet `protobuf:"bytes,4,rep,name=validator_sets,json=validatorSets,proto3" json:"validator_sets,omitempty"`
+	// slashed_validator_sets are the slashed validators of all epochs, including the current one
+	SlashedValidatorSets []*EpochValidatorSet `protobuf:"bytes,5,rep,name=slashed_validator_sets,json=slashedValidatorSets,proto3" json:"slashed_validator_sets,omitempty"`
+	// validator_lifecycles are the lifecycles of all validators
+	ValidatorLifecycles []*ValidatorLifecycle `protobuf:"bytes,6,rep,name=validator_lifecycles,json=validatorLifecycles,proto3" json:"validator_lifecycles,omitempty"`
+	// delegation_lifecycles are the lifecycles of all delegations
+	DelegationLifecycles []*DelegationLifecycle `protobuf:"bytes,7,rep,name=delegation_lifecycles,json=delegationLifecycles,proto3" json:"delegation_lifecycles,omitempty"`
+	// epochs are the metadata of all epochs, including the current one, with the
+	// height of their first block and their interval
+	Epochs []*Epoch `protobuf:"bytes,8,rep,name=epochs,proto3" json:"epochs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,27 +84,212 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *GenesisState) GetQueues() []*QueuedMessageList {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSets() []*EpochValidatorSet {
+	if m != nil {
+		return m.ValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedValidatorSets() []*EpochValidatorSet {
+	if m != nil {
+		return m.SlashedValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorLifecycles() []*ValidatorLifecycle {
+	if m != nil {
+		return m.ValidatorLifecycles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationLifecycles() []*DelegationLifecycle {
+	if m != nil {
+		return m.DelegationLifecycles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochs() []*Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// EpochValidatorSet is a set of validators of an epoch along with their total voting power
+type EpochValidatorSet struct {
+	EpochNumber      uint64           `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Validators       []ValidatorPower `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	TotalVotingPower int64            `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *EpochValidatorSet) Reset()         { *m = EpochValidatorSet{} }
+func (m *EpochValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*EpochValidatorSet) ProtoMessage()    {}
+func (*EpochValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef836361c424501, []int{1}
+}
+func (m *EpochValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochValidatorSet.Merge(m, src)
+}
+func (m *EpochValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochValidatorSet proto.InternalMessageInfo
+
+func (m *EpochValidatorSet) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochValidatorSet) GetValidators() []ValidatorPower {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *EpochValidatorSet) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+// ValidatorPower is a validator along with its voting power
+type ValidatorPower struct {
+	// val_addr is the bech32-encoded address of the validator
+	ValAddr string `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	Power   int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef836361c424501, []int{2}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+func (m *ValidatorPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.epoching.v1.GenesisState")
+	proto.RegisterType((*EpochValidatorSet)(nil), "babylon.epoching.v1.EpochValidatorSet")
+	proto.RegisterType((*ValidatorPower)(nil), "babylon.epoching.v1.ValidatorPower")
 }
 
 func init() { proto.RegisterFile("babylon/epoching/v1/genesis.proto", fileDescriptor_2ef836361c424501) }
 
 var fileDescriptor_2ef836361c424501 = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x2a, 0xd1, 0x83, 0x29, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0xa6,
-	0xe4, 0xc9, 0xc5, 0xe3, 0x0e, 0x31, 0x3d, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x92, 0x8b, 0x0d,
-	0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xad, 0x87, 0xc5, 0x36, 0xbd, 0x00, 0xb0,
-	0x12, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x1a, 0x9c, 0xbc, 0x4e, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0x1f, 0x6a, 0x5c, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x70, 0x60,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x75, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x51, 0x6f, 0x4e, 0x0f, 0x01, 0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x9b, 0xb6, 0xcb, 0x86, 0x3b, 0x26, 0xf0, 0x02, 0x0a, 0x45, 0x0a, 0x59, 0x91, 0x20,
+	0x07, 0x94, 0xb0, 0x72, 0xe2, 0x82, 0xb4, 0x09, 0x84, 0x40, 0x1b, 0x1a, 0x99, 0xd4, 0xc3, 0x04,
+	0x8a, 0x9c, 0xe4, 0x25, 0x8d, 0xe4, 0xc6, 0x21, 0x76, 0x03, 0xfd, 0x16, 0x7c, 0x13, 0xbe, 0x46,
+	0x8f, 0x3b, 0x72, 0x42, 0xa8, 0xfd, 0x22, 0xa8, 0x4e, 0xfa, 0x67, 0x24, 0x4c, 0xda, 0x2d, 0x7e,
+	0xfd, 0xfc, 0x9e, 0xd8, 0xaf, 0x5e, 0xa3, 0x03, 0x9f, 0xf8, 0x13, 0xca, 0x12, 0x07, 0x52, 0x16,
+	0x0c, 0xe3, 0x24, 0x72, 0xf2, 0x43, 0x27, 0x82, 0x04, 0x78, 0xcc, 0xed, 0x34, 0x63, 0x82, 0xe1,
+	0xfd, 0x12, 0xb1, 0x97, 0x88, 0x9d, 0x1f, 0x76, 0xb5, 0x88, 0x45, 0x4c, 0xee, 0x3b, 0x8b, 0xaf,
+	0x02, 0xed, 0x9a, 0x75, 0xb6, 0x94, 0x64, 0x64, 0x54, 0xca, 0xba, 0xbd, 0x3a, 0x62, 0x25, 0x96,
+	0x4c, 0x6f, 0xda, 0x46, 0xbb, 0x6f, 0x8b, 0x23, 0x9c, 0x0b, 0x22, 0x00, 0xbf, 0x44, 0x6a, 0x21,
+	0xd1, 0x15, 0x53, 0xb1, 0x3a, 0xfd, 0x87, 0x76, 0xcd, 0x91, 0xec, 0x33, 0x89, 0x1c, 0xb7, 0xa7,
+	0xbf, 0x1f, 0x35, 0xdc, 0x32, 0x80, 0x0f, 0xd0, 0xae, 0x64, 0xbc, 0x64, 0x3c, 0xf2, 0x21, 0xd3,
+	0x9b, 0xa6, 0x62, 0xb5, 0xdd, 0x8e, 0xac, 0x7d, 0x90, 0x25, 0xfc, 0x0a, 0xa9, 0x5f, 0xc7, 0x30,
+	0x06, 0xae, 0xb7, 0xcc, 0x96, 0xd5, 0xe9, 0x3f, 0xa9, 0xb5, 0x7f, 0x5c, 0x20, 0xe1, 0x29, 0x70,
+	0x4e, 0x22, 0x38, 0x89, 0xb9, 0x70, 0xcb, 0x14, 0x3e, 0x45, 0x7b, 0x39, 0xa1, 0x71, 0x48, 0x04,
+	0xcb, 0x3c, 0x0e, 0x82, 0xeb, 0xed, 0x6b, 0x3c, 0x6f, 0x16, 0xdf, 0x83, 0x25, 0x7f, 0x0e, 0xc2,
+	0xbd, 0x9d, 0x6f, 0xac, 0x38, 0xfe, 0x84, 0xee, 0x73, 0x4a, 0xf8, 0x10, 0x42, 0xef, 0x1f, 0xed,
+	0xd6, 0x8d, 0xb4, 0x5a, 0x69, 0x19, 0x5c, 0xb1, 0x5f, 0x20, 0x6d, 0x6d, 0xa5, 0xf1, 0x17, 0x08,
+	0x26, 0x01, 0x05, 0xae, 0xab, 0xd2, 0xfd, 0xb4, 0xd6, 0xbd, 0x32, 0x9c, 0x2c, 0x79, 0x77, 0x3f,
+	0xaf, 0xd4, 0x38, 0xfe, 0x8c, 0xee, 0x85, 0x40, 0x21, 0x22, 0x22, 0x66, 0xc9, 0xa6, 0x7c, 0x5b,
+	0xca, 0xad, 0x5a, 0xf9, 0xeb, 0x55, 0x62, 0x6d, 0xd7, 0xc2, 0x6a, 0x91, 0xe3, 0x3e, 0x52, 0x65,
+	0x90, 0xeb, 0x3b, 0xd2, 0xd7, 0xfd, 0x7f, 0x23, 0xdc, 0x92, 0xec, 0xfd, 0x54, 0xd0, 0xdd, 0x4a,
+	0x6b, 0x2a, 0x43, 0xa1, 0x54, 0x87, 0xe2, 0x1d, 0x42, 0xab, 0x2b, 0x72, 0xbd, 0x29, 0x7f, 0xf8,
+	0xf8, 0xfa, 0xee, 0x9c, 0xb1, 0x6f, 0x90, 0x95, 0xe3, 0xb7, 0x11, 0xc6, 0xcf, 0x10, 0x16, 0x4c,
+	0x10, 0xea, 0xe5, 0x4c, 0xc4, 0x49, 0xe4, 0xa5, 0x0b, 0x4e, 0x6f, 0x99, 0x8a, 0xd5, 0x72, 0xef,
+	0xc8, 0x9d, 0x81, 0xdc, 0x90, 0xf9, 0xde, 0x11, 0xda, 0xbb, 0x6a, 0xc4, 0x0f, 0xd0, 0x4e, 0x4e,
+	0xa8, 0x47, 0xc2, 0xb0, 0x38, 0xe9, 0x2d, 0x77, 0x3b, 0x27, 0xf4, 0x28, 0x0c, 0x33, 0xac, 0xa1,
+	0xad, 0xc2, 0xd6, 0x94, 0xb6, 0x62, 0x71, 0xfc, 0x7e, 0x3a, 0x33, 0x94, 0xcb, 0x99, 0xa1, 0xfc,
+	0x99, 0x19, 0xca, 0x8f, 0xb9, 0xd1, 0xb8, 0x9c, 0x1b, 0x8d, 0x5f, 0x73, 0xa3, 0x71, 0xf1, 0x3c,
+	0x8a, 0xc5, 0x70, 0xec, 0xdb, 0x01, 0x1b, 0x39, 0xe5, 0x5d, 0x82, 0x21, 0x89, 0x93, 0xe5, 0xc2,
+	0xf9, 0xbe, 0x7e, 0x97, 0x62, 0x92, 0x02, 0xf7, 0x55, 0xf9, 0x24, 0x5f, 0xfc, 0x0d, 0x00, 0x00,
+	0xff, 0xff, 0xe2, 0xc6, 0xfc, 0x18, 0x28, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +312,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DelegationLifecycles) > 0 {
+		for iNdEx := len(m.DelegationLifecycles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationLifecycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorLifecycles) > 0 {
+		for iNdEx := len(m.ValidatorLifecycles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLifecycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SlashedValidatorSets) > 0 {
+		for iNdEx := len(m.SlashedValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for iNdEx := len(m.ValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +414,88 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,6 +515,82 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for _, e := range m.ValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedValidatorSets) > 0 {
+		for _, e := range m.SlashedValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorLifecycles) > 0 {
+		for _, e := range m.ValidatorLifecycles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationLifecycles) > 0 {
+		for _, e := range m.DelegationLifecycles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovGenesis(uint64(m.Power))
+	}
 	return n
 }
 
@@ -214,6 +662,452 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueuedMessageList{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSets = append(m.ValidatorSets, &EpochValidatorSet{})
+			if err := m.ValidatorSets[len(m.ValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidatorSets = append(m.SlashedValidatorSets, &EpochValidatorSet{})
+			if err := m.SlashedValidatorSets[len(m.SlashedValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLifecycles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorLifecycles = append(m.ValidatorLifecycles, &ValidatorLifecycle{})
+			if err := m.ValidatorLifecycles[len(m.ValidatorLifecycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLifecycles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationLifecycles = append(m.DelegationLifecycles, &DelegationLifecycle{})
+			if err := m.DelegationLifecycles[len(m.DelegationLifecycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
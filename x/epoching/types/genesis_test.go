@@ -4,9 +4,11 @@ import (
 	"testing"
 
 	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/testutil/nullify"
 	"github.com/babylonchain/babylon/x/epoching"
 	"github.com/babylonchain/babylon/x/epoching/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -31,6 +33,26 @@ func TestGenesis(t *testing.T) {
 }
 
 func TestGenesisState_Validate(t *testing.T) {
+	valAddr := sdk.ValAddress(datagen.GenRandomByteArray(20)).String()
+	valSet := func(epochNumber uint64, totalPower int64) *types.EpochValidatorSet {
+		return &types.EpochValidatorSet{
+			EpochNumber:      epochNumber,
+			Validators:       []types.ValidatorPower{{ValAddr: valAddr, Power: 10}},
+			TotalVotingPower: totalPower,
+		}
+	}
+	emptySet := func(epochNumber uint64) *types.EpochValidatorSet {
+		return &types.EpochValidatorSet{EpochNumber: epochNumber}
+	}
+	epochs := func(epochNumbers ...uint64) []*types.Epoch {
+		res := []*types.Epoch{}
+		for _, epochNumber := range epochNumbers {
+			epoch := types.NewEpoch(epochNumber, 10)
+			res = append(res, &epoch)
+		}
+		return res
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -50,6 +72,90 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "state of an existing chain",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          1,
+				Epochs:               epochs(0, 1),
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(0, 10), valSet(1, 10)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(0), emptySet(1)},
+			},
+			valid: true,
+		},
+		{
+			desc: "current epoch is missing",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          1,
+				Epochs:               epochs(0),
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(0, 10), valSet(1, 10)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(0), emptySet(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch does not start after the previous one",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          2,
+				Epochs:               []*types.Epoch{epochs(1)[0], {EpochNumber: 2, CurrentEpochInterval: 10, FirstBlockHeight: 12}},
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(1, 10), valSet(2, 10)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(1), emptySet(2)},
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch number without validator sets",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				EpochNumber: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "validator set of the current epoch is missing",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          1,
+				Epochs:               epochs(0, 1),
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(0, 10)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(0), emptySet(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "validator set after the current epoch",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          1,
+				Epochs:               epochs(0, 1),
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(1, 10), valSet(2, 10)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "total voting power does not match the validators",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				EpochNumber:          1,
+				Epochs:               epochs(0, 1),
+				ValidatorSets:        []*types.EpochValidatorSet{valSet(1, 20)},
+				SlashedValidatorSets: []*types.EpochValidatorSet{emptySet(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "slashed voting power of the current epoch is missing",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				EpochNumber:   1,
+				Epochs:        epochs(0, 1),
+				ValidatorSets: []*types.EpochValidatorSet{valSet(1, 10)},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -27,6 +27,7 @@ var (
 	SlashedValidatorSetKey = []byte{0x17} // key prefix for slashed validator set
 	ValidatorLifecycleKey  = []byte{0x18} // key prefix for validator life cycle
 	DelegationLifecycleKey = []byte{0x19} // key prefix for delegation life cycle
+	EpochInfoKey           = []byte{0x1a} // key prefix for the metadata of each epoch
 )
 
 func KeyPrefix(p string) []byte {
@@ -41,7 +41,13 @@ func (vs ValidatorSet) FindValidatorWithIndex(valAddr sdk.ValAddress) (*Validato
 	return &vs[index], index, nil
 }
 
+// FindSubset returns the validators selected by the bitmap
+// an error is returned if the bitmap cannot hold all validators of the set
+// or selects a validator which does not exist in the set
 func (vs ValidatorSet) FindSubset(bitmap bitmap.Bitmap) (ValidatorSet, error) {
+	if bitmap.Len() < len(vs) {
+		return nil, errors.New("bitmap is too short for the validator set")
+	}
 	valSet := make([]Validator, 0)
 	for i := 0; i < bitmap.Len(); i++ {
 		if bitmap.Get(i) {